@@ -7,6 +7,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 )
 
 var DirectoryEntityStateOk = "OK"
@@ -96,6 +98,13 @@ type DirectorySpec struct {
 type DirectoryStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          DirectoryObservation `json:"atProvider,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
+	// Job is the last asynchronous BTP job the provider started for the resource.
+	// +optional
+	Job *providerv1alpha1.AsyncJob `json:"job,omitempty"`
 }
 
 // +kubebuilder:object:root=true
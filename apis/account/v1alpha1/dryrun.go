@@ -0,0 +1,35 @@
+package v1alpha1
+
+import (
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+// SetDryRun sets the result of the dry-run of this Subaccount.
+func (mg *Subaccount) SetDryRun(r *providerv1alpha1.DryRunResult) {
+	mg.Status.DryRun = r
+}
+
+// SetDryRun sets the result of the dry-run of this Directory.
+func (mg *Directory) SetDryRun(r *providerv1alpha1.DryRunResult) {
+	mg.Status.DryRun = r
+}
+
+// SetDryRun sets the result of the dry-run of this Entitlement.
+func (mg *Entitlement) SetDryRun(r *providerv1alpha1.DryRunResult) {
+	mg.Status.DryRun = r
+}
+
+// SetDryRun sets the result of the dry-run of this ServiceInstance.
+func (mg *ServiceInstance) SetDryRun(r *providerv1alpha1.DryRunResult) {
+	mg.Status.DryRun = r
+}
+
+// SetDryRun sets the result of the dry-run of this Subscription.
+func (mg *Subscription) SetDryRun(r *providerv1alpha1.DryRunResult) {
+	mg.Status.DryRun = r
+}
+
+// SetDryRun sets the result of the dry-run of this SubaccountSettings.
+func (mg *SubaccountSettings) SetDryRun(r *providerv1alpha1.DryRunResult) {
+	mg.Status.DryRun = r
+}
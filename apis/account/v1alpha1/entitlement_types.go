@@ -21,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 )
 
 const (
@@ -33,6 +35,7 @@ const (
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.subaccountGuid) || size(oldSelf.subaccountGuid) == 0 || (has(self.subaccountGuid) && self.subaccountGuid == oldSelf.subaccountGuid)",message="subaccountGuid cannot be changed after resolution"
 // +kubebuilder:validation:XValidation:rule="has(self.servicePlanUniqueIdentifier) == has(oldSelf.servicePlanUniqueIdentifier) && (!has(self.servicePlanUniqueIdentifier) || self.servicePlanUniqueIdentifier == oldSelf.servicePlanUniqueIdentifier)",message="servicePlanUniqueIdentifier cannot be changed"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.subaccountGuid) || size(oldSelf.subaccountGuid) == 0 || (has(self.subaccountRef) == has(oldSelf.subaccountRef) && (!has(self.subaccountRef) || self.subaccountRef == oldSelf.subaccountRef))",message="subaccountRef cannot be changed after subaccountGuid is resolved"
+// +kubebuilder:validation:XValidation:rule="!(has(self.amount) && has(self.enable))",message="use either amount or enable, not both"
 type EntitlementParameters struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="servicePlanName cannot be changed"
@@ -234,6 +237,13 @@ type EntitlementSummary struct {
 type EntitlementStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          *EntitlementObservation `json:"atProvider,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
+	// Job is the last asynchronous BTP job the provider started for the resource.
+	// +optional
+	Job *providerv1alpha1.AsyncJob `json:"job,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,45 @@
+package v1alpha1
+
+import (
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+// GetJob returns the last asynchronous BTP job of this Subaccount.
+func (mg *Subaccount) GetJob() *providerv1alpha1.AsyncJob {
+	return mg.Status.Job
+}
+
+// SetJob sets the last asynchronous BTP job of this Subaccount.
+func (mg *Subaccount) SetJob(j *providerv1alpha1.AsyncJob) {
+	mg.Status.Job = j
+}
+
+// GetJob returns the last asynchronous BTP job of this Directory.
+func (mg *Directory) GetJob() *providerv1alpha1.AsyncJob {
+	return mg.Status.Job
+}
+
+// SetJob sets the last asynchronous BTP job of this Directory.
+func (mg *Directory) SetJob(j *providerv1alpha1.AsyncJob) {
+	mg.Status.Job = j
+}
+
+// GetJob returns the last asynchronous BTP job of this Entitlement.
+func (mg *Entitlement) GetJob() *providerv1alpha1.AsyncJob {
+	return mg.Status.Job
+}
+
+// SetJob sets the last asynchronous BTP job of this Entitlement.
+func (mg *Entitlement) SetJob(j *providerv1alpha1.AsyncJob) {
+	mg.Status.Job = j
+}
+
+// GetJob returns the last asynchronous BTP job of this Subscription.
+func (mg *Subscription) GetJob() *providerv1alpha1.AsyncJob {
+	return mg.Status.Job
+}
+
+// SetJob sets the last asynchronous BTP job of this Subscription.
+func (mg *Subscription) SetJob(j *providerv1alpha1.AsyncJob) {
+	mg.Status.Job = j
+}
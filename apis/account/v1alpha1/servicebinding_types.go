@@ -71,13 +71,15 @@ type ServiceBindingParameters struct {
 
 // +kubebuilder:validation:XValidation:rule="!has(self.ttl) || (has(self.frequency) && duration(self.ttl) >= duration(self.frequency))",message="ttl must be greater than or equal to frequency"
 type RotationParameters struct {
-	// Frequency defines how often the active key should be rotated.
+	// Frequency defines how often the active key should be rotated, as a Go duration, e.g. 720h.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="frequency must be a positive duration, e.g. 720h"
 	Frequency *providerv1alpha1.Duration `json:"frequency"`
 
 	// TTL (Time-To-Live) defines the total time a credential is valid for before it is deleted.
 	// Must be >= frequency
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="ttl must be a positive duration, e.g. 2160h"
 	TTL *providerv1alpha1.Duration `json:"ttl,omitempty"`
 }
 
@@ -8,14 +8,18 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 )
 
 // ServiceInstanceParameters are the configurable fields of a ServiceInstance.
+// +kubebuilder:validation:XValidation:rule="!has(self.servicePlanID) || size(self.servicePlanID) == 0 || ((!has(self.offeringName) || size(self.offeringName) == 0) && (!has(self.planName) || size(self.planName) == 0) && (!has(self.dataCenter) || size(self.dataCenter) == 0))",message="use either servicePlanID or offeringName, planName and dataCenter, not both"
 type ServiceInstanceParameters struct {
 	// Name of the service instance in btp, required
 	Name string `json:"name"`
 
 	// Name of the service offering
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="offeringName can't be updated once set"
 	OfferingName string `json:"offeringName,omitempty"`
 
 	// Name of the service plan of that offering
@@ -125,6 +129,10 @@ type ServiceInstanceSpec struct {
 type ServiceInstanceStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          ServiceInstanceObservation `json:"atProvider,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
 }
 
 // +kubebuilder:object:root=true
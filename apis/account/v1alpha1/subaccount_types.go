@@ -7,6 +7,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 )
 
 // SubaccountLabelValueList is a list of values for one Subaccount label key.
@@ -16,6 +18,7 @@ import (
 type SubaccountLabelValueList []string
 
 // SubaccountParameters are the configurable fields of a Subaccount.
+// +kubebuilder:validation:XValidation:rule="!has(self.customProperties) || !has(self.labels) || self.customProperties.all(k, !(k in self.labels))",message="customProperties and labels must not share keys"
 type SubaccountParameters struct {
 	// enable beta services and applications?
 	// +optional
@@ -40,9 +43,18 @@ type SubaccountParameters struct {
 	// +kubebuilder:validation:XValidation:rule="self.all(k, size(k) <= 63)",message="label keys must be at most 63 characters"
 	Labels map[string]SubaccountLabelValueList `json:"labels,omitempty"`
 
+	// CustomProperties are key-value pairs assigned to the subaccount, with a single value per key.
+	// BTP also lists custom properties as labels, so their keys must not be used in labels.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=10
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(k) <= 63)",message="custom property keys must be at most 63 characters"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(self[k]) <= 63)",message="custom property values must be at most 63 characters"
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
 	// Region
 	// Change requires recreation
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region can't be updated once set"
 	Region string `json:"region"`
 
 	// Admins for the subaccount (service account user already included)
@@ -53,6 +65,7 @@ type SubaccountParameters struct {
 
 	// Subdomain
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="subdomain can't be updated once set"
 	Subdomain string `json:"subdomain"`
 
 	// Used for production
@@ -110,6 +123,10 @@ type SubaccountObservation struct {
 	// +optional
 	Labels *map[string][]string `json:"labels,omitempty"`
 
+	// CustomProperties assigned to the subaccount.
+	// +optional
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
 	// Region
 	// Change requires recreation
 	Region *string `json:"region,omitempty"`
@@ -140,6 +157,13 @@ type SubaccountSpec struct {
 type SubaccountStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          SubaccountObservation `json:"atProvider,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
+	// Job is the last asynchronous BTP job the provider started for the resource.
+	// +optional
+	Job *providerv1alpha1.AsyncJob `json:"job,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,107 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+// SubaccountSettingsParameters are the configurable fields of a SubaccountSettings.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.subaccountGuid) || size(oldSelf.subaccountGuid) == 0 || (has(self.subaccountGuid) && self.subaccountGuid == oldSelf.subaccountGuid)",message="subaccountGuid cannot be changed after resolution"
+type SubaccountSettingsParameters struct {
+	// Settings of the subaccount as key-value pairs. Keys are limited to 200 characters and values to 2000 characters.
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=100
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(k) <= 200)",message="setting keys must be at most 200 characters"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(self[k]) <= 2000)",message="setting values must be at most 2000 characters"
+	Settings map[string]string `json:"settings"`
+
+	// RemoveUnmanagedSettings removes the settings of the subaccount whose keys are not in settings.
+	// By default, settings of the subaccount that are not in settings are kept.
+	// +optional
+	RemoveUnmanagedSettings bool `json:"removeUnmanagedSettings,omitempty"`
+
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.Subaccount
+	// +crossplane:generate:reference:refFieldName=SubaccountRef
+	// +crossplane:generate:reference:selectorFieldName=SubaccountSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.SubaccountUuid()
+	SubaccountGuid string `json:"subaccountGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	SubaccountSelector *xpv1.Selector `json:"subaccountSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	SubaccountRef *xpv1.Reference `json:"subaccountRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"Subaccount" reference-apiversion:"v1alpha1"`
+}
+
+// SubaccountSettingsObservation are the observable fields of a SubaccountSettings.
+type SubaccountSettingsObservation struct {
+	// Settings of the subaccount, including the ones that are not in spec.forProvider.settings.
+	// +optional
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// A SubaccountSettingsSpec defines the desired state of a SubaccountSettings.
+type SubaccountSettingsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SubaccountSettingsParameters `json:"forProvider"`
+}
+
+// A SubaccountSettingsStatus represents the observed state of a SubaccountSettings.
+type SubaccountSettingsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SubaccountSettingsObservation `json:"atProvider,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SubaccountSettings is a managed resource that represents the key-value settings of a subaccount in the SAP Business Technology Platform.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Subaccount GUID (UUID format)
+//   - How to find:
+//   - UI: Global Account → Account Explorer → Subaccounts → [Select Subaccount] → Subaccount ID
+//   - CLI: btp list accounts/subaccount (field: guid)
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type SubaccountSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubaccountSettingsSpec   `json:"spec"`
+	Status SubaccountSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubaccountSettingsList contains a list of SubaccountSettings
+type SubaccountSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SubaccountSettings `json:"items"`
+}
+
+// SubaccountSettings type metadata.
+var (
+	SubaccountSettingsKind             = reflect.TypeOf(SubaccountSettings{}).Name()
+	SubaccountSettingsGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SubaccountSettingsKind}.String()
+	SubaccountSettingsKindAPIVersion   = SubaccountSettingsKind + "." + CRDGroupVersion.String()
+	SubaccountSettingsGroupVersionKind = CRDGroupVersion.WithKind(SubaccountSettingsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SubaccountSettings{}, &SubaccountSettingsList{})
+}
@@ -798,6 +798,16 @@ func (in *DirectoryStatus) DeepCopyInto(out *DirectoryStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(apisv1alpha1.DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(apisv1alpha1.AsyncJob)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryStatus.
@@ -998,6 +1008,16 @@ func (in *EntitlementStatus) DeepCopyInto(out *EntitlementStatus) {
 		*out = new(EntitlementObservation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(apisv1alpha1.DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(apisv1alpha1.AsyncJob)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntitlementStatus.
@@ -1614,6 +1634,11 @@ func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(apisv1alpha1.DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceStatus.
@@ -1890,6 +1915,13 @@ func (in *SubaccountObservation) DeepCopyInto(out *SubaccountObservation) {
 			}
 		}
 	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Region != nil {
 		in, out := &in.Region, &out.Region
 		*out = new(string)
@@ -1955,6 +1987,13 @@ func (in *SubaccountParameters) DeepCopyInto(out *SubaccountParameters) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.SubaccountAdmins != nil {
 		in, out := &in.SubaccountAdmins, &out.SubaccountAdmins
 		*out = make([]string, len(*in))
@@ -2952,6 +2991,158 @@ func (in *SubaccountServiceInstanceStatus) DeepCopy() *SubaccountServiceInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountSettings) DeepCopyInto(out *SubaccountSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountSettings.
+func (in *SubaccountSettings) DeepCopy() *SubaccountSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubaccountSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountSettingsList) DeepCopyInto(out *SubaccountSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SubaccountSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountSettingsList.
+func (in *SubaccountSettingsList) DeepCopy() *SubaccountSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubaccountSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountSettingsObservation) DeepCopyInto(out *SubaccountSettingsObservation) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountSettingsObservation.
+func (in *SubaccountSettingsObservation) DeepCopy() *SubaccountSettingsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountSettingsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountSettingsParameters) DeepCopyInto(out *SubaccountSettingsParameters) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SubaccountSelector != nil {
+		in, out := &in.SubaccountSelector, &out.SubaccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubaccountRef != nil {
+		in, out := &in.SubaccountRef, &out.SubaccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountSettingsParameters.
+func (in *SubaccountSettingsParameters) DeepCopy() *SubaccountSettingsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountSettingsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountSettingsSpec) DeepCopyInto(out *SubaccountSettingsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountSettingsSpec.
+func (in *SubaccountSettingsSpec) DeepCopy() *SubaccountSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubaccountSettingsStatus) DeepCopyInto(out *SubaccountSettingsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(apisv1alpha1.DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountSettingsStatus.
+func (in *SubaccountSettingsStatus) DeepCopy() *SubaccountSettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubaccountSettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubaccountSpec) DeepCopyInto(out *SubaccountSpec) {
 	*out = *in
@@ -2974,6 +3165,16 @@ func (in *SubaccountStatus) DeepCopyInto(out *SubaccountStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(apisv1alpha1.DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(apisv1alpha1.AsyncJob)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubaccountStatus.
@@ -3113,6 +3314,16 @@ func (in *SubscriptionStatus) DeepCopyInto(out *SubscriptionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(apisv1alpha1.DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(apisv1alpha1.AsyncJob)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionStatus.
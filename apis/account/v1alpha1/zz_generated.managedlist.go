@@ -119,6 +119,15 @@ func (l *SubaccountServiceInstanceList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this SubaccountSettingsList.
+func (l *SubaccountSettingsList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this SubscriptionList.
 func (l *SubscriptionList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
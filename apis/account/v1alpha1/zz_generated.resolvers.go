@@ -387,6 +387,33 @@ func (mg *SubaccountServiceBroker) ResolveReferences(ctx context.Context, c clie
 	return nil
 }
 
+// ResolveReferences of this SubaccountSettings.
+func (mg *SubaccountSettings) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.SubaccountGuid,
+		Extract:      SubaccountUuid(),
+		Namespace:    mg.GetNamespace(),
+		Reference:    mg.Spec.ForProvider.SubaccountRef,
+		Selector:     mg.Spec.ForProvider.SubaccountSelector,
+		To: reference.To{
+			List:    &SubaccountList{},
+			Managed: &Subaccount{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.SubaccountGuid")
+	}
+	mg.Spec.ForProvider.SubaccountGuid = rsp.ResolvedValue
+	mg.Spec.ForProvider.SubaccountRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this Subscription.
 func (mg *Subscription) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
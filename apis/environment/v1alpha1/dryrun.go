@@ -0,0 +1,10 @@
+package v1alpha1
+
+import (
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+// SetDryRun sets the result of the dry-run of this KymaEnvironment.
+func (mg *KymaEnvironment) SetDryRun(r *providerv1alpha1.DryRunResult) {
+	mg.Status.DryRun = r
+}
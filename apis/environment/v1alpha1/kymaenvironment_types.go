@@ -8,6 +8,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 )
 
 const (
@@ -19,6 +21,7 @@ const (
 
 // KymaEnvironmentParameters are the configurable fields of a KymaEnvironment.
 type KymaEnvironmentParameters struct {
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="planName can't be updated once set"
 	PlanName string `json:"planName"`
 
 	// Can be provided, if empty the client will use metadata.name as the default value.
@@ -104,6 +107,10 @@ type KymaEnvironmentStatus struct {
 	// To disable the circuit breaker, set the annotation "environment.btp.sap.crossplane.io/ignore-circuit-breaker" to any value.
 	// +kubebuilder:validation:Optional
 	RetryStatus *RetryStatus `json:"updateRetryStatus,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
 }
 
 // RetryStatus contains information about retries
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -651,6 +652,11 @@ func (in *KymaEnvironmentStatus) DeepCopyInto(out *KymaEnvironmentStatus) {
 		*out = new(RetryStatus)
 		**out = **in
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(apisv1alpha1.DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentStatus.
@@ -47,6 +47,10 @@ limitations under the License.
 // Inject custom backoff settings on top of generated controllers
 //go:generate ../hack/helpers/ctrl_inject_backoff.sh
 
+// Record the Create, Update and Delete of generated controllers in the audit log,
+// since their requests to BTP are sent by the Terraform provider
+//go:generate ../hack/helpers/ctrl_inject_audit.sh
+
 // Run e2e test generator
 //go:generate go run ../test/e2e/generator/main.go ..
 
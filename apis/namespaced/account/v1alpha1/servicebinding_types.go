@@ -0,0 +1,205 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type ServiceBindingOperationType string
+
+type ServiceBindingOperationState string
+
+const (
+	ServiceBindingLastOperationTypeCreate ServiceBindingOperationType = "create"
+	ServiceBindingLastOperationTypeDelete ServiceBindingOperationType = "delete"
+
+	ServiceBindingLastOperationStatePending   ServiceBindingOperationState = "pending"
+	ServiceBindingLastOperationStateSucceeded ServiceBindingOperationState = "succeeded"
+)
+
+// ServiceBindingParameters are the configurable fields of a ServiceBinding.
+type ServiceBindingParameters struct {
+	// Name of the service instance in btp, required
+	Name string `json:"name"`
+
+	// Parameters in JSON or YAML format, will be merged with yaml parameters and secret parameters, will overwrite duplicated keys from secrets
+	// +kubebuilder:validation:Optional
+	Parameters runtime.RawExtension `json:"parameters,omitempty"`
+
+	// Parameters stored in secret, will be merged with spec parameters
+	// +kubebuilder:validation:Optional
+	ParameterSecretRefs []xpv1.LocalSecretKeySelector `json:"parameterSecretRefs,omitempty"`
+
+	// (String) The ID of the subaccount.
+	// The ID of the subaccount.
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.Subaccount
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.SubaccountUuid()
+	// +crossplane:generate:reference:refFieldName=SubaccountRef
+	// +crossplane:generate:reference:selectorFieldName=SubaccountSelector
+	SubaccountID *string `json:"subaccountId,omitempty" tf:"subaccount_id,omitempty"`
+
+	// Reference to a Subaccount in account to populate subaccountId.
+	// +kubebuilder:validation:Optional
+	SubaccountRef *xpv1.NamespacedReference `json:"subaccountRef,omitempty" tf:"-" reference-group:"account.btp.m.sap.crossplane.io" reference-kind:"Subaccount" reference-apiversion:"v1alpha1"`
+
+	// Selector for a Subaccount in account to populate subaccountId.
+	// +kubebuilder:validation:Optional
+	SubaccountSelector *xpv1.NamespacedSelector `json:"subaccountSelector,omitempty" tf:"-"`
+
+	// (String) The ID of the service instance associated with the binding.
+	// The ID of the service instance associated with the binding.
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.ServiceInstance
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.ServiceInstanceUuid()
+	// +crossplane:generate:reference:refFieldName=ServiceInstanceRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceInstanceSelector
+	// +kubebuilder:validation:Optional
+	ServiceInstanceID *string `json:"serviceInstanceId,omitempty" tf:"service_instance_id,omitempty"`
+
+	// Reference to a ServiceInstance in account to populate serviceInstanceId.
+	// +kubebuilder:validation:Optional
+	ServiceInstanceRef *xpv1.NamespacedReference `json:"serviceInstanceRef,omitempty" tf:"-" reference-group:"account.btp.m.sap.crossplane.io" reference-kind:"ServiceInstance" reference-apiversion:"v1alpha1"`
+
+	// Selector for a ServiceInstance in account to populate serviceInstanceId.
+	// +kubebuilder:validation:Optional
+	ServiceInstanceSelector *xpv1.NamespacedSelector `json:"serviceInstanceSelector,omitempty" tf:"-"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!has(self.ttl) || (has(self.frequency) && duration(self.ttl) >= duration(self.frequency))",message="ttl must be greater than or equal to frequency"
+type RotationParameters struct {
+	// Frequency defines how often the active key should be rotated, as a Go duration, e.g. 720h.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="frequency must be a positive duration, e.g. 720h"
+	Frequency *providerv1alpha1.Duration `json:"frequency"`
+
+	// TTL (Time-To-Live) defines the total time a credential is valid for before it is deleted.
+	// Must be >= frequency
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="ttl must be a positive duration, e.g. 2160h"
+	TTL *providerv1alpha1.Duration `json:"ttl,omitempty"`
+}
+
+// ServiceBindingObservation are the observable fields of a ServiceBinding.
+type ServiceBindingObservation struct {
+	// The ID of the service binding resource
+	ID string `json:"id,omitempty"`
+
+	// The name of the service binding resource
+	Name string `json:"name,omitempty"`
+
+	// Additional TF resource fields from SubaccountServiceBinding for the current active binding
+	// The date and time when the resource was created
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+
+	// The date and time when the resource was last modified
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// Shows whether the service binding is ready
+	Ready *bool `json:"ready,omitempty"`
+
+	// The current state of the service binding (in progress, failed, succeeded)
+	State *string `json:"state,omitempty"`
+
+	// The parameters of the service binding as a valid JSON object
+	Parameters *string `json:"parameters,omitempty"`
+}
+
+// RetiredSBResource contains only the essential tracking information for retired service binding instances
+// +kubebuilder:object:generate=true
+type RetiredSBResource struct {
+	// The ID of the service binding resource
+	ID string `json:"id,omitempty"`
+
+	// The name of the service binding resource
+	Name string `json:"name,omitempty"`
+
+	// The date and time when the resource was created
+	CreatedDate metav1.Time `json:"createdDate"`
+
+	// The date and time when the resource was retired
+	RetiredDate metav1.Time `json:"retiredDate"`
+
+	// The date and time when the resource will be deleted.
+	// May change if the rotation settings change
+	DeletionDate *metav1.Time `json:"deletionDate"`
+}
+
+// A ServiceBindingSpec defines the desired state of a ServiceBinding.
+type ServiceBindingSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	ForProvider ServiceBindingParameters `json:"forProvider"`
+
+	// Rotation defines the parameters for rotating the service credential binding.
+	// +kubebuilder:validation:Optional
+	Rotation *RotationParameters `json:"rotation,omitempty"`
+
+	// SecretFormat controls the format of the connection secret.
+	// When set to "sap-kubernetes", the secret follows the SAP Kubernetes Service Binding specification
+	// with metadata properties (type, label, plan, tags, instance_name, instance_guid) and a .metadata descriptor.
+	// When omitted or empty, only the raw credentials are stored (default, backward-compatible).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum="";"sap-kubernetes"
+	SecretFormat string `json:"secretFormat,omitempty"`
+
+	// SecretKey controls how credentials are stored in the connection secret.
+	// When set, all credential properties are bundled into a single JSON key with this name
+	// instead of being flattened into individual top-level keys.
+	// Combined with secretFormat "sap-kubernetes", the .metadata descriptor marks this key
+	// with "container: true" per the SAP Kubernetes Service Binding specification.
+	// +kubebuilder:validation:Optional
+	SecretKey *string `json:"secretKey,omitempty"`
+}
+
+// A ServiceBindingStatus represents the observed state of a ServiceBinding.
+type ServiceBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceBindingObservation `json:"atProvider,omitempty"`
+
+	// If the binding is rotated, `retiredBindings` stores resources that have been rotated out but are still transitionally retained due to `rotation.ttl` setting
+	// +kubebuilder:validation:Optional
+	RetiredKeys []*RetiredSBResource `json:"retiredKeys,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceBinding allows to manage a binding to a service instance in BTP
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,btp}
+type ServiceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceBindingSpec   `json:"spec"`
+	Status ServiceBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceBindingList contains a list of ServiceBinding
+type ServiceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBinding `json:"items"`
+}
+
+// ServiceBinding type metadata.
+var (
+	ServiceBindingKind             = reflect.TypeOf(ServiceBinding{}).Name()
+	ServiceBindingGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceBindingKind}.String()
+	ServiceBindingKindAPIVersion   = ServiceBindingKind + "." + CRDGroupVersion.String()
+	ServiceBindingGroupVersionKind = CRDGroupVersion.WithKind(ServiceBindingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceBinding{}, &ServiceBindingList{})
+}
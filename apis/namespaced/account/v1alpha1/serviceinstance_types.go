@@ -0,0 +1,184 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+// ServiceInstanceParameters are the configurable fields of a ServiceInstance.
+// +kubebuilder:validation:XValidation:rule="!has(self.servicePlanID) || size(self.servicePlanID) == 0 || ((!has(self.offeringName) || size(self.offeringName) == 0) && (!has(self.planName) || size(self.planName) == 0) && (!has(self.dataCenter) || size(self.dataCenter) == 0))",message="use either servicePlanID or offeringName, planName and dataCenter, not both"
+type ServiceInstanceParameters struct {
+	// Name of the service instance in btp, required
+	Name string `json:"name"`
+
+	// Name of the service offering
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="offeringName can't be updated once set"
+	OfferingName string `json:"offeringName,omitempty"`
+
+	// Name of the service plan of that offering
+	PlanName string `json:"planName,omitempty"`
+
+	// The data center to use when resolving the service plan.
+	// Required when the same service offering exists in multiple data centers
+	// (e.g., SAP HANA Cloud). The value corresponds to the data_center field
+	// in the Service Manager API (e.g., "cf-eu10", "cf-us10").
+	// Mutually exclusive with servicePlanID.
+	// +kubebuilder:validation:Optional
+	DataCenter string `json:"dataCenter,omitempty"`
+
+	// The ID of the service plan (UUID). When set, plan resolution via
+	// offeringName/planName is skipped entirely. Use this as an escape hatch
+	// when name-based resolution is ambiguous or you already have the plan ID.
+	// Mutually exclusive with offeringName, planName, and dataCenter.
+	// +kubebuilder:validation:Optional
+	ServicePlanID string `json:"servicePlanID,omitempty"`
+
+	// Whether the service instance is shared or not
+	// +kubebuilder:validation:Optional
+	Shared *bool `json:"shared,omitempty"`
+
+	// Parameters in JSON or YAML format, will be merged with yaml parameters and secret parameters, will overwrite duplicated keys from secrets
+	// +kubebuilder:validation:Optional
+	Parameters runtime.RawExtension `json:"parameters,omitempty"`
+
+	// Parameters stored in secret, will be merged with spec parameters
+	// +kubebuilder:validation:Optional
+	ParameterSecretRefs []xpv1.LocalSecretKeySelector `json:"parameterSecretRefs,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ServiceManagerSelector *xpv1.NamespacedSelector `json:"serviceManagerSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	ServiceManagerRef *xpv1.NamespacedReference `json:"serviceManagerRef,omitempty" reference-group:"account.btp.m.sap.crossplane.io" reference-kind:"ServiceManager" reference-apiversion:"v1alpha1"`
+
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.ServiceManager
+	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.ServiceManagerSecret()
+	ServiceManagerSecret string `json:"serviceManagerSecret,omitempty"`
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.ServiceManager
+	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.ServiceManagerSecretNamespace()
+	ServiceManagerSecretNamespace string `json:"serviceManagerSecretNamespace,omitempty"`
+
+	// (String) The ID of the subaccount.
+	// The ID of the subaccount.
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.Subaccount
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.SubaccountUuid()
+	// +crossplane:generate:reference:refFieldName=SubaccountRef
+	// +crossplane:generate:reference:selectorFieldName=SubaccountSelector
+	SubaccountID *string `json:"subaccountId,omitempty" tf:"subaccount_id,omitempty"`
+
+	// Reference to a Subaccount in account to populate subaccountId.
+	// +kubebuilder:validation:Optional
+	SubaccountRef *xpv1.NamespacedReference `json:"subaccountRef,omitempty" tf:"-"`
+
+	// Selector for a Subaccount in account to populate subaccountId.
+	// +kubebuilder:validation:Optional
+	SubaccountSelector *xpv1.NamespacedSelector `json:"subaccountSelector,omitempty" tf:"-"`
+
+	// Arbitrary labels to attach to the service instance in BTP Service Manager.
+	// Each key maps to a list of string values. Labels are propagated to the SM API on create and update.
+	// +kubebuilder:validation:Optional
+	Labels map[string][]*string `json:"labels,omitempty"`
+}
+
+// ServiceInstanceObservation are the observable fields of a ServiceInstance.
+type ServiceInstanceObservation struct {
+	ID string `json:"id,omitempty"`
+
+	// The ID of the service plan as resolved by the ServiceManager
+	ServiceplanID string `json:"serviceplanId,omitempty"`
+
+	// The URL of the web-based management UI for the service instance.
+	DashboardURL string `json:"dashboardUrl,omitempty"`
+
+	// The date and time when the resource was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+
+	// The date and time when the resource was last modified.
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// The current state of the service instance.
+	State string `json:"state,omitempty"`
+
+	// Shows whether the service instance is ready.
+	Ready *bool `json:"ready,omitempty"`
+
+	// Shows whether the resource can be used.
+	Usable *bool `json:"usable,omitempty"`
+
+	// The platform ID of the service instance.
+	PlatformID string `json:"platformId,omitempty"`
+}
+
+// A ServiceInstanceSpec defines the desired state of a ServiceInstance.
+type ServiceInstanceSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ServiceInstanceParameters `json:"forProvider"`
+}
+
+// A ServiceInstanceStatus represents the observed state of a ServiceInstance.
+type ServiceInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceInstanceObservation `json:"atProvider,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceInstance allows to manage a ServiceInstance in BTP [Environment: 'Other']
+//
+// External-Name Configuration:
+//   - Follows Standard: no
+//   - Format: ServiceInstance GUID (UUID format)
+//   - Note: spec.ForProvider.SubaccountRef, spec.ForProvider.SubaccountSelector, or spec.ForProvider.SubaccountID must be set for adoption to work
+//   - How to find:
+//   - UI: Subaccount → Services → Instances → [Select Instance] → Instance ID
+//   - CLI: btp list services/instance --subaccount `<subaccount-guid>` (field: id)
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,btp}
+type ServiceInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceInstanceSpec   `json:"spec"`
+	Status ServiceInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceInstanceList contains a list of ServiceInstance
+type ServiceInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceInstance `json:"items"`
+}
+
+// ServiceInstance type metadata.
+var (
+	ServiceInstanceKind             = reflect.TypeOf(ServiceInstance{}).Name()
+	ServiceInstanceGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceInstanceKind}.String()
+	ServiceInstanceKindAPIVersion   = ServiceInstanceKind + "." + CRDGroupVersion.String()
+	ServiceInstanceGroupVersionKind = CRDGroupVersion.WithKind(ServiceInstanceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceInstance{}, &ServiceInstanceList{})
+}
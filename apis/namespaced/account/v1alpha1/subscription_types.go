@@ -0,0 +1,134 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+const (
+	SubscriptionStateInProcess              = "IN_PROCESS"
+	SubscriptionStateSubscribed             = "SUBSCRIBED"
+	SubscriptionStateSubscribeFailed        = "SUBSCRIBE_FAILED"
+	SubscriptionStateUnsubscribeFailed      = "UNSUBSCRIBE_FAILED"
+	SubscriptionStateUpdateFailed           = "UPDATE_FAILED"
+	SubscriptionStateUpdateParametersFailed = "UPDATE_PARAMETERS_FAILED"
+	SubscriptionStateNotSubscribed          = "NOT_SUBSCRIBED"
+)
+
+// SubscriptionParameters are the configurable fields of a Subscription.
+type SubscriptionParameters struct {
+	// AppName of the app to subscribe to
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="appName can't be updated once set"
+	AppName string `json:"appName"`
+	// PlanName to subscribe to, empty plannames are shown as "default" in cockpit, use "" instead
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="planName can't be updated once set"
+	PlanName string `json:"planName"`
+	// Subscription parameters allows you to add additional parameters
+	// +kubebuilder:validation:Optional
+	SubscriptionParameters runtime.RawExtension `json:"parameters"`
+}
+
+// SubscriptionObservation are the observable fields of a Subscription.
+type SubscriptionObservation struct {
+	// State as received from the API instance
+	// +optional
+	State *string `json:"state,omitempty"`
+}
+
+// A SubscriptionSpec defines the desired state of a Subscription.
+type SubscriptionSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              SubscriptionParameters `json:"forProvider"`
+
+	// +kubebuilder:validation:Optional
+	CloudManagementSelector *xpv1.NamespacedSelector `json:"cloudManagementSelector,omitempty"`
+	// Reference to CloudManagement instance of plan type "local" used for authentication
+	// +kubebuilder:validation:Optional
+	CloudManagementRef *xpv1.NamespacedReference `json:"cloudManagementRef,omitempty" reference-group:"account.btp.m.sap.crossplane.io" reference-kind:"CloudManagement" reference-apiversion:"v1alpha1"`
+
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.CloudManagement
+	// +crossplane:generate:reference:refFieldName=CloudManagementRef
+	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.CloudManagementSecret()
+	CloudManagementSecret string `json:"cloudManagementSecret,omitempty"`
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.CloudManagement
+	// +crossplane:generate:reference:refFieldName=CloudManagementRef
+	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1.CloudManagementSecretNamespace()
+	CloudManagementSecretNamespace string `json:"cloudManagementSecretNamespace,omitempty"`
+
+	// RecreateOnSubscriptionFailure indicates whether the
+	// creation of the resources shall be retried when creating a
+	// subscription fails by getting into "SUBSCRIBE_FAILED"
+	// state.
+	// +kubebuilder:validation:Optional
+	RecreateOnSubscriptionFailure bool `json:"recreateOnSubscriptionFailure,omitempty"`
+}
+
+// A SubscriptionStatus represents the observed state of a Subscription.
+type SubscriptionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SubscriptionObservation `json:"atProvider,omitempty"`
+	// DryRun is what the provider would send to BTP, set while the resource has the
+	// btp.sap.crossplane.io/dry-run annotation.
+	// +optional
+	DryRun *providerv1alpha1.DryRunResult `json:"dryRun,omitempty"`
+	// Job is the last asynchronous BTP job the provider started for the resource.
+	// +optional
+	Job *providerv1alpha1.AsyncJob `json:"job,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Subscription encodes a subscription of a subaccount to a service
+// It requires a references CloudManagement instance of plan type "local" to authenticate and map to subaccount.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: `<appName>/<planName>`
+//   - How to find:
+//   - UI: BTP Cockpit → Subaccounts → [Select Subaccount] → Instances and Subscriptions → [Select Subscription] → Application Technical Name and Plan
+//   - CLI: `btp list accounts/subscription` fields `app name` and `plan name`
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,btp}
+type Subscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubscriptionSpec   `json:"spec"`
+	Status SubscriptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubscriptionList contains a list of Subscription
+type SubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subscription `json:"items"`
+}
+
+// Subscription type metadata.
+var (
+	SubscriptionKind             = reflect.TypeOf(Subscription{}).Name()
+	SubscriptionGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SubscriptionKind}.String()
+	SubscriptionKindAPIVersion   = SubscriptionKind + "." + CRDGroupVersion.String()
+	SubscriptionGroupVersionKind = CRDGroupVersion.WithKind(SubscriptionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Subscription{}, &SubscriptionList{})
+}
@@ -0,0 +1,39 @@
+// Package v1alpha1 contains the namespaced v1alpha1 group environment resources of the btp provider.
+// +kubebuilder:object:generate=true
+// +groupName=environment.btp.m.sap.crossplane.io
+// +versionName=v1alpha1
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Package type metadata.
+const (
+	Group   = "environment.btp.m.sap.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&CloudFoundryEnvironment{}, &CloudFoundryEnvironmentList{},
+		&KymaModule{}, &KymaModuleList{},
+		&KymaEnvironment{}, &KymaEnvironmentList{},
+		&KymaEnvironmentBinding{}, &KymaEnvironmentBindingList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
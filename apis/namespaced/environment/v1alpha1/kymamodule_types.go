@@ -0,0 +1,118 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+const (
+	KymaEnvironmentBindingKey           = "kubeconfig"
+	KymaEnvironmentBindingExpirationKey = "expires_at"
+	ModuleStateDeleting                 = "Deleting"
+)
+
+// KymaModuleParameters are the configurable fields of a KymaModule.
+type KymaModuleParameters struct {
+
+	// The name of the standard module to be activated.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The channel of the module to be activated. Note: this is activated on module level and overrides the channel of the KymaEnvironment.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="regular"
+	Channel *string `json:"channel,omitempty"`
+
+	// The custom resource policy of the module to be activated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="CreateAndDelete"
+	CustomResourcePolicy *string `json:"customResourcePolicy,omitempty"`
+}
+
+// A KymaModuleSpec defines the desired state of a KymaModule.
+type KymaModuleSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              KymaModuleParameters `json:"forProvider"`
+	// Deprecated: This id is not required anymore, will be ignored
+	// +kubebuilder:validation:Optional
+	KymaEnvironmentBindingId string `json:"kymaEnvironmentBindingId,omitempty"`
+	// +kubebuilder:validation:Optional
+	KymaEnvironmentBindingSelector *xpv1.NamespacedSelector `json:"kymaEnvironmentBindingSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	KymaEnvironmentBindingRef *xpv1.NamespacedReference `json:"kymaEnvironmentBindingRef,omitempty" reference-group:"environment.btp.m.sap.crossplane.io" reference-kind:"KymaEnvironmentBinding" reference-apiversion:"v1alpha1"`
+
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/environment/v1alpha1.KymaEnvironmentBinding
+	// +crossplane:generate:reference:refFieldName=KymaEnvironmentBindingRef
+	// +crossplane:generate:reference:selectorFieldName=KymaEnvironmentBindingSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/environment/v1alpha1.KymaEnvironmentBindingSecret()
+	KymaEnvironmentBindingSecret string `json:"kymaEnvironmentBindingSecret,omitempty"`
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/namespaced/environment/v1alpha1.KymaEnvironmentBinding
+	// +crossplane:generate:reference:refFieldName=KymaEnvironmentBindingRef
+	// +crossplane:generate:reference:selectorFieldName=KymaEnvironmentBindingSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/namespaced/environment/v1alpha1.KymaEnvironmentBindingSecretNamespace()
+	KymaEnvironmentBindingSecretNamespace string `json:"kymaEnvironmentBindingSecretNamespace,omitempty"`
+}
+
+// A KymaModuleStatus represents the observed state of a KymaModule.
+type KymaModuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ModuleStatus `json:"atProvider,omitempty"`
+}
+
+// ref https://github.com/kyma-project/cli/blob/838d9b9e8506489da336bf790e4814fbe1caba0b/internal/kube/kyma/types.go#L125
+type ModuleStatus struct {
+	Name     string               `json:"name"`
+	Channel  string               `json:"channel,omitempty"`
+	Version  string               `json:"version,omitempty"`
+	State    string               `json:"state,omitempty"`
+	Template runtime.RawExtension `json:"template,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KymaModule is an object to manage the configuration for a specific Module in the Kyma cluster.
+//
+// External-Name Configuration:
+//   - Follows Standard: yes
+//   - Format: Kyma module name (e.g. "keda", "serverless")
+//   - How to find:
+//   - UI: Kyma Dashboard → Modules → [Module Name]
+//   - CLI: `kubectl get kyma default -n kyma-system -o jsonpath='{.spec.modules[*].name}'`
+//
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="MODULE-NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,btp}
+type KymaModule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KymaModuleSpec   `json:"spec"`
+	Status KymaModuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KymaModuleList contains a list of KymaModules
+type KymaModuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KymaModule `json:"items"`
+}
+
+// KymaModule type metadata.
+var (
+	KymaModuleKind             = reflect.TypeOf(KymaModule{}).Name()
+	KymaModuleGroupKind        = schema.GroupKind{Group: Group, Kind: KymaModuleKind}.String()
+	KymaModuleKindAPIVersion   = KymaModuleKind + "." + SchemeGroupVersion.String()
+	KymaModuleGroupVersionKind = SchemeGroupVersion.WithKind(KymaModuleKind)
+)
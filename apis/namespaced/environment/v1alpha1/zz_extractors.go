@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// KymaEnvironmentBindingSecret extracts the Reference of some Kyma credentials to a secret name
+func KymaEnvironmentBindingSecret() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		sg, ok := mg.(*KymaEnvironmentBinding)
+		if !ok {
+			return ""
+		}
+		if sg.Spec.WriteConnectionSecretToReference == nil {
+			return ""
+		}
+		return sg.Spec.WriteConnectionSecretToReference.Name
+	}
+}
+
+// KymaEnvironmentBindingSecretNamespace extracts the Reference of some Kyma credentials to the namespace of secret
+func KymaEnvironmentBindingSecretNamespace() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		sg, ok := mg.(*KymaEnvironmentBinding)
+		if !ok {
+			return ""
+		}
+		if sg.Spec.WriteConnectionSecretToReference == nil {
+			return ""
+		}
+		// Connection secrets of namespaced resources always live in the namespace of the resource.
+		return sg.GetNamespace()
+	}
+}
+
+// KymaInstanceId is a function that extracts the ID of the KymaEnvironment instance from the managed resource.
+func KymaInstanceId() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		sg, ok := mg.(*KymaEnvironment)
+		if !ok {
+			return ""
+		}
+		if sg.Status.AtProvider.ID == nil {
+			return ""
+		}
+		return *sg.Status.AtProvider.ID
+	}
+}
@@ -0,0 +1,30 @@
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+// XSUAACredentialsReference contains the credentials needed to access the xsuaa api.
+// Other than its cluster scoped counterpart it cannot reference a SubaccountApiCredential,
+// because there is no namespaced variant of that resource.
+type XSUAACredentialsReference struct {
+	// xsuaa api credentials used to manage the assignment.
+	// Only credentials from a secret in the namespace of the resource are supported.
+	// +kubebuilder:validation:Optional
+	APICredentials APICredentials `json:"apiCredentials"`
+
+	// Name of a secret in the namespace of the resource, holding the credentials of a SubaccountApiCredential
+	// +kubebuilder:validation:Optional
+	SubaccountApiCredentialSecret string `json:"subaccountApiCredentialSecret,omitempty"`
+}
+
+// APICredentials are the credentials to authenticate against the xsuaa api. Other than its cluster scoped
+// counterpart it cannot read credentials from the environment or filesystem of the provider, which belong
+// to the cluster administrator.
+type APICredentials struct {
+	// Source of the credentials.
+	// +kubebuilder:validation:Enum=None;Secret;""
+	Source xpv1.CredentialsSource `json:"source"`
+
+	// A SecretRef is a reference to a secret key in the namespace of the resource that contains the credentials.
+	// +optional
+	SecretRef *xpv1.LocalSecretKeySelector `json:"secretRef,omitempty"`
+}
@@ -5,6 +5,10 @@ import (
 	accountv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	accountv1beta1 "github.com/sap/crossplane-provider-btp/apis/account/v1beta1"
 	environmentv1alpha1 "github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	namespacedaccountv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1"
+	namespacedenvironmentv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/environment/v1alpha1"
+	namespacedsecurityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/security/v1alpha1"
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/v1alpha1"
 	oidcv1alpha1 "github.com/sap/crossplane-provider-btp/apis/oidc/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 )
@@ -18,5 +22,9 @@ func init() {
 		environmentv1alpha1.SchemeBuilder.AddToScheme,
 		oidcv1alpha1.SchemeBuilder.AddToScheme,
 		accountv1beta1.SchemeBuilder.AddToScheme,
+		namespacedv1alpha1.SchemeBuilder.AddToScheme,
+		namespacedaccountv1alpha1.SchemeBuilder.AddToScheme,
+		namespacedenvironmentv1alpha1.SchemeBuilder.AddToScheme,
+		namespacedsecurityv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
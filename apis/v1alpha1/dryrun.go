@@ -0,0 +1,52 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DryRunOperation is the operation the provider would perform on an external resource.
+type DryRunOperation string
+
+// Operations reported in a DryRunResult.
+const (
+	// DryRunCreate means the external resource does not exist and would be created.
+	DryRunCreate DryRunOperation = "Create"
+	// DryRunUpdate means the external resource differs from the spec and would be updated.
+	DryRunUpdate DryRunOperation = "Update"
+	// DryRunNone means the external resource is up to date and nothing would be sent.
+	DryRunNone DryRunOperation = "None"
+)
+
+// DryRunResult is what the provider would send to BTP for a managed resource with the
+// btp.sap.crossplane.io/dry-run annotation, instead of sending it.
+type DryRunResult struct {
+	// Operation is the operation that would be performed on the external resource.
+	// +kubebuilder:validation:Enum=Create;Update;None
+	Operation DryRunOperation `json:"operation"`
+
+	// Changes are the fields of the request that differ from the external resource.
+	// For Create, these are all fields of the request.
+	// +optional
+	Changes []DryRunChange `json:"changes,omitempty"`
+
+	// Payload is the request body that would be sent.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Payload *runtime.RawExtension `json:"payload,omitempty"`
+}
+
+// DryRunChange is a field of a request that differs from the external resource.
+type DryRunChange struct {
+	// Path of the field in the payload, e.g. labels.team or assignmentInfo[0].amount.
+	Path string `json:"path"`
+
+	// Observed is the current value of the field, empty if the field is not set.
+	// Values other than strings are JSON encoded.
+	// +optional
+	Observed string `json:"observed,omitempty"`
+
+	// Desired is the value of the field that would be sent, empty if the field would be removed.
+	// Values other than strings are JSON encoded.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+}
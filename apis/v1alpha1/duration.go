@@ -9,9 +9,10 @@ import (
 //
 // Unlike metav1.Duration, it does not canonicalize values such as "720h" to "720h0m0s",
 // avoiding the managed-fields conflicts reported in issue #841. Issue #892 specifies the
-// fields that use this type.
+// fields that use this type. Its schema only accepts well-formed Go durations, such as "720h" or "1h30m".
 //
 // +kubebuilder:validation:Type=string
+// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
 type Duration struct {
 	time.Duration
 
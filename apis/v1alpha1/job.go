@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobOperation is the operation an asynchronous BTP job performs on an external resource.
+type JobOperation string
+
+// Operations of an AsyncJob.
+const (
+	JobCreate JobOperation = "Create"
+	JobUpdate JobOperation = "Update"
+	JobDelete JobOperation = "Delete"
+)
+
+// JobState is the state of an asynchronous BTP job, as reported by the Job Management API.
+type JobState string
+
+// States of an AsyncJob.
+const (
+	JobInProgress JobState = "IN_PROGRESS"
+	JobCompleted  JobState = "COMPLETED"
+	JobFailed     JobState = "FAILED"
+	// JobUnknown means the job is no longer known to BTP, e.g. because it expired.
+	JobUnknown JobState = "UNKNOWN"
+)
+
+// AsyncJob is an asynchronous BTP job the provider started for a managed resource.
+type AsyncJob struct {
+	// ID of the job, as returned in the Location header of the request that started it.
+	ID string `json:"id"`
+
+	// Operation the job performs on the external resource.
+	// +kubebuilder:validation:Enum=Create;Update;Delete
+	Operation JobOperation `json:"operation"`
+
+	// State of the job, e.g. IN_PROGRESS, COMPLETED or FAILED.
+	// +optional
+	State JobState `json:"state,omitempty"`
+
+	// Description of the job's progress, as reported by BTP.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Error is the reason the job failed.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// Generation of the managed resource the job was started for.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// StartTime is when the provider started the job.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// Finished returns whether the job completed, failed or is no longer known to BTP.
+func (j *AsyncJob) Finished() bool {
+	return j.State == JobCompleted || j.State == JobFailed || j.State == JobUnknown
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -15,6 +16,7 @@ type ProviderConfigSpec struct {
 	// Reference to a secret containing the CIS Accounts service credentials.
 	// The Cloud Management (CIS) instance must be of plan `central`.
 	// The Service Binding should be created with the following parameters `{"grantType": "clientCredentials"}`
+	// Bindings with a client certificate (`"credential-type": "x509"`) are supported as well.
 	// See [Setup](https://pages.github.tools.sap/cloud-orchestration/docs/sap-services/btp-services/account-managment/provider) for more details
 	CISSecret ProviderCredentials `json:"cisCredentials"`
 
@@ -37,18 +39,104 @@ type ProviderConfigSpec struct {
 	GlobalAccount string `json:"globalAccount,omitempty"`
 }
 
+// CredentialsSourceOIDCTokenExchange indicates that the CIS binding in the secret is used without
+// its client secret, and the provider authenticates by exchanging an OIDC token with the jwt-bearer grant.
+const CredentialsSourceOIDCTokenExchange xpv1.CredentialsSource = "OIDCTokenExchange"
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// OIDCTokenExchange is only supported for the cisCredentials. With OIDCTokenExchange, the secretRef
+	// references the CIS binding, which does not need to contain the client secret.
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;OIDCTokenExchange
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// OIDCTokenExchange configures the OIDC token, which is exchanged at the XSUAA of the CIS binding
+	// for an access token with the jwt-bearer grant. Required if the source is OIDCTokenExchange.
+	// +optional
+	OIDCTokenExchange *OIDCTokenExchange `json:"oidcTokenExchange,omitempty"`
+}
+
+// OIDCTokenExchange configures the source of the OIDC token that is exchanged for a CIS access token.
+// Exactly one of serviceAccountTokenPath and certBasedOIDCLoginRef must be set.
+type OIDCTokenExchange struct {
+	// ServiceAccountTokenPath is the path of a projected service account token in the provider pod,
+	// whose issuer is trusted by the XSUAA of the CIS binding.
+	// +optional
+	ServiceAccountTokenPath string `json:"serviceAccountTokenPath,omitempty"`
+
+	// CertBasedOIDCLoginRef references a CertBasedOIDCLogin, whose ID token is exchanged.
+	// +optional
+	CertBasedOIDCLoginRef *xpv1.Reference `json:"certBasedOIDCLoginRef,omitempty"`
+}
+
+// ProviderConfigObservation is the state of the BTP global account as seen with the credentials of a ProviderConfig.
+// It is filled by the periodic check of the ProviderConfig.
+type ProviderConfigObservation struct {
+	// GlobalAccountGUID is the GUID of the global account of the CIS credentials.
+	GlobalAccountGUID string `json:"globalAccountGuid,omitempty"`
+
+	// Region is the region of the CIS accounts service, e.g. eu10.
+	Region string `json:"region,omitempty"`
+
+	// TokenExpiry is the time the access token of the CIS credentials expires.
+	TokenExpiry *metav1.Time `json:"tokenExpiry,omitempty"`
 }
 
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// +optional
+	AtProvider ProviderConfigObservation `json:"atProvider,omitempty"`
+}
+
+// Conditions of the periodic check of a ProviderConfig. Each check is only run if the previous one succeeded,
+// otherwise its condition is Unknown.
+const (
+	// CredentialsValidCondition reports whether the CIS and service account secrets can be read and parsed.
+	CredentialsValidCondition xpv1.ConditionType = "CredentialsValid"
+	// AuthenticatedCondition reports whether an access token could be fetched with the CIS credentials.
+	AuthenticatedCondition xpv1.ConditionType = "Authenticated"
+	// GlobalAccountVerifiedCondition reports whether the global account of the CIS credentials could be
+	// read and matches spec.globalAccount.
+	GlobalAccountVerifiedCondition xpv1.ConditionType = "GlobalAccountVerified"
+
+	CredentialsValidReason       xpv1.ConditionReason = "CredentialsValid"
+	InvalidCredentialsReason     xpv1.ConditionReason = "InvalidCredentials"
+	TokenIssuedReason            xpv1.ConditionReason = "TokenIssued"
+	TokenRequestFailedReason     xpv1.ConditionReason = "TokenRequestFailed"
+	GlobalAccountMatchedReason   xpv1.ConditionReason = "GlobalAccountMatched"
+	GlobalAccountMismatchReason  xpv1.ConditionReason = "GlobalAccountMismatch"
+	CannotGetGlobalAccountReason xpv1.ConditionReason = "CannotGetGlobalAccount"
+	CheckSkippedReason           xpv1.ConditionReason = "PreviousCheckFailed"
+)
+
+// CheckSucceeded returns a condition of type t with status True.
+func CheckSucceeded(t xpv1.ConditionType, r xpv1.ConditionReason, message string) xpv1.Condition {
+	return checkCondition(t, corev1.ConditionTrue, r, message)
+}
+
+// CheckFailed returns a condition of type t with status False and the error as message.
+func CheckFailed(t xpv1.ConditionType, r xpv1.ConditionReason, err error) xpv1.Condition {
+	return checkCondition(t, corev1.ConditionFalse, r, err.Error())
+}
+
+// CheckSkipped returns a condition of type t with status Unknown, as an earlier check failed.
+func CheckSkipped(t xpv1.ConditionType) xpv1.Condition {
+	return checkCondition(t, corev1.ConditionUnknown, CheckSkippedReason, "")
+}
+
+func checkCondition(t xpv1.ConditionType, s corev1.ConditionStatus, r xpv1.ConditionReason, message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               t,
+		Status:             s,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            message,
+	}
 }
 
 // +kubebuilder:object:root=true
@@ -57,6 +145,8 @@ type ProviderConfigStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="VERIFIED",type="string",JSONPath=".status.conditions[?(@.type=='GlobalAccountVerified')].status"
+// +kubebuilder:printcolumn:name="GLOBAL-ACCOUNT",type="string",JSONPath=".status.atProvider.globalAccountGuid",priority=1
 // +kubebuilder:resource:scope=Cluster
 type ProviderConfig struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -21,9 +21,69 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AsyncJob) DeepCopyInto(out *AsyncJob) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsyncJob.
+func (in *AsyncJob) DeepCopy() *AsyncJob {
+	if in == nil {
+		return nil
+	}
+	out := new(AsyncJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunChange) DeepCopyInto(out *DryRunChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunChange.
+func (in *DryRunChange) DeepCopy() *DryRunChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunResult) DeepCopyInto(out *DryRunResult) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]DryRunChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunResult.
+func (in *DryRunResult) DeepCopy() *DryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Duration) DeepCopyInto(out *Duration) {
 	*out = *in
@@ -39,6 +99,26 @@ func (in *Duration) DeepCopy() *Duration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCTokenExchange) DeepCopyInto(out *OIDCTokenExchange) {
+	*out = *in
+	if in.CertBasedOIDCLoginRef != nil {
+		in, out := &in.CertBasedOIDCLoginRef, &out.CertBasedOIDCLoginRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCTokenExchange.
+func (in *OIDCTokenExchange) DeepCopy() *OIDCTokenExchange {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCTokenExchange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
@@ -98,6 +178,25 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigObservation) DeepCopyInto(out *ProviderConfigObservation) {
+	*out = *in
+	if in.TokenExpiry != nil {
+		in, out := &in.TokenExpiry, &out.TokenExpiry
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigObservation.
+func (in *ProviderConfigObservation) DeepCopy() *ProviderConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
@@ -119,6 +218,7 @@ func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
 func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
 	*out = *in
 	in.ProviderConfigStatus.DeepCopyInto(&out.ProviderConfigStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
@@ -193,6 +293,11 @@ func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
 func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
 	*out = *in
 	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
+	if in.OIDCTokenExchange != nil {
+		in, out := &in.OIDCTokenExchange, &out.OIDCTokenExchange
+		*out = new(OIDCTokenExchange)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderCredentials.
@@ -0,0 +1,87 @@
+package btp
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sap/crossplane-provider-btp/internal/audit"
+)
+
+// HeaderCorrelationID is the header the BTP APIs correlate the requests and responses of an operation with.
+const HeaderCorrelationID = "X-Correlation-Id"
+
+// RoundTripAudit writes an audit record of every mutating request sent through its base RoundTripper
+// to the sink set with audit.SetSink, and attributes it to the managed resource in the context of the request.
+type RoundTripAudit struct {
+	base http.RoundTripper
+	api  string
+}
+
+// NewRoundTripAudit wraps base, or the default transport if base is nil, in a RoundTripAudit for the given API.
+func NewRoundTripAudit(api string, base http.RoundTripper) *RoundTripAudit {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RoundTripAudit{base: base, api: api}
+}
+
+// RoundTrip calls the base RoundTripper and records mutating requests. Requests without a correlation ID get one,
+// so that the record can be matched with the logs of BTP.
+func (r *RoundTripAudit) RoundTrip(req *http.Request) (*http.Response, error) {
+	sink := audit.CurrentSink()
+	if sink == nil || !mutating(req) {
+		return r.base.RoundTrip(req)
+	}
+
+	record := audit.Record{
+		Timestamp:     time.Now().UTC(),
+		API:           r.api,
+		Method:        req.Method,
+		Endpoint:      req.URL.Redacted(),
+		CorrelationID: req.Header.Get(HeaderCorrelationID),
+	}
+	if res, ok := audit.ResourceFrom(req.Context()); ok {
+		record.Resource = &res
+	}
+	// a RoundTripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	if record.CorrelationID == "" {
+		record.CorrelationID = uuid.NewString()
+		req.Header.Set(HeaderCorrelationID, record.CorrelationID)
+	}
+	var body io.ReadCloser
+	var err error
+	body, req.Body, err = drainBody(req.Body)
+	if err == nil {
+		raw, _ := io.ReadAll(body)
+		record.RequestBody = string(redactJwtTokensFromBody(redactSensitiveBodyBasedOnKeywords(raw)))
+	}
+
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if resp != nil {
+		record.Status = resp.StatusCode
+		if id := resp.Header.Get(HeaderCorrelationID); id != "" {
+			record.CorrelationID = id
+		}
+	}
+	if werr := sink.Write(req.Context(), record); werr != nil {
+		log.Info("Cannot write audit record", "error", werr, "method", record.Method, "endpoint", record.Endpoint)
+	}
+	return resp, err
+}
+
+// mutating returns whether req changes a resource in BTP. Token requests are not considered mutating.
+func mutating(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return !strings.HasSuffix(req.URL.Path, "/oauth/token")
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package btp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sap/crossplane-provider-btp/internal/audit"
+)
+
+type recordingSink struct {
+	mu      sync.Mutex
+	records []audit.Record
+}
+
+func (s *recordingSink) Write(_ context.Context, r audit.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, r)
+	return nil
+}
+
+func TestRoundTripAudit(t *testing.T) {
+	SetLogger(logging.NewNopLogger())
+
+	var gotCorrelationID, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotCorrelationID = r.Header.Get(HeaderCorrelationID)
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		if r.Header.Get("X-Respond-Correlation") != "" {
+			w.Header().Set(HeaderCorrelationID, "from-btp")
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	res := audit.Resource{GroupVersionKind: "account.btp.sap.crossplane.io/v1alpha1, Kind=Subaccount", Name: "my-subaccount", UID: "uid-1"}
+
+	tests := map[string]struct {
+		method     string
+		path       string
+		body       string
+		header     http.Header
+		noResource bool
+		want       *audit.Record
+	}{
+		"Create": {
+			method: http.MethodPost,
+			path:   "/accounts/v1/subaccounts",
+			body:   `{"displayName":"my-subaccount"}`,
+			want: &audit.Record{
+				Resource:    &res,
+				API:         APIAccounts,
+				Method:      http.MethodPost,
+				Endpoint:    server.URL + "/accounts/v1/subaccounts",
+				RequestBody: `{"displayName":"my-subaccount"}`,
+				Status:      http.StatusAccepted,
+			},
+		},
+		"RedactedBody": {
+			method: http.MethodPut,
+			path:   "/accounts/v1/subaccounts/guid",
+			body:   `{"clientSecret":"s3cr3t"}`,
+			header: http.Header{HeaderCorrelationID: {"given"}},
+			want: &audit.Record{
+				Resource:      &res,
+				API:           APIAccounts,
+				Method:        http.MethodPut,
+				Endpoint:      server.URL + "/accounts/v1/subaccounts/guid",
+				RequestBody:   "<BODY REDACTED>",
+				Status:        http.StatusAccepted,
+				CorrelationID: "given",
+			},
+		},
+		"CorrelationIDOfResponse": {
+			method:     http.MethodDelete,
+			path:       "/accounts/v1/subaccounts/guid",
+			header:     http.Header{"X-Respond-Correlation": {"true"}},
+			noResource: true,
+			want: &audit.Record{
+				API:           APIAccounts,
+				Method:        http.MethodDelete,
+				Endpoint:      server.URL + "/accounts/v1/subaccounts/guid",
+				Status:        http.StatusAccepted,
+				CorrelationID: "from-btp",
+			},
+		},
+		"Read": {
+			method: http.MethodGet,
+			path:   "/accounts/v1/subaccounts",
+		},
+		"TokenRequest": {
+			method: http.MethodPost,
+			path:   "/oauth/token",
+			body:   "grant_type=client_credentials",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sink := &recordingSink{}
+			audit.SetSink(sink)
+			defer audit.SetSink(nil)
+
+			ctx := context.Background()
+			if !tc.noResource {
+				ctx = audit.WithResource(ctx, res)
+			}
+			req, err := http.NewRequestWithContext(ctx, tc.method, server.URL+tc.path, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			for k, v := range tc.header {
+				req.Header[k] = v
+			}
+			resp, err := (&http.Client{Transport: NewRoundTripAudit(APIAccounts, nil)}).Do(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, tc.body, gotBody, "the body must be sent unchanged")
+
+			if tc.want == nil {
+				assert.Empty(t, sink.records)
+				return
+			}
+			require.Len(t, sink.records, 1)
+			got := sink.records[0]
+			assert.NotZero(t, got.Timestamp)
+			assert.NotEmpty(t, gotCorrelationID)
+			if tc.want.CorrelationID == "" {
+				tc.want.CorrelationID = gotCorrelationID
+			}
+			got.Timestamp = tc.want.Timestamp
+			assert.Equal(t, *tc.want, got)
+		})
+	}
+}
+
+func TestRoundTripAuditDoesNotModifyRequest(t *testing.T) {
+	SetLogger(logging.NewNopLogger())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	audit.SetSink(&recordingSink{})
+	defer audit.SetSink(nil)
+
+	for name, header := range map[string]http.Header{
+		"WithCorrelationID":    {HeaderCorrelationID: {"given"}},
+		"WithoutCorrelationID": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/accounts/v1/subaccounts", strings.NewReader(`{"displayName":"my-subaccount"}`))
+			require.NoError(t, err)
+			req.Header = header.Clone()
+			body := req.Body
+
+			resp, err := NewRoundTripAudit(APIAccounts, nil).RoundTrip(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.True(t, body == req.Body, "the body of the request must not be replaced")
+			assert.Equal(t, header, req.Header)
+		})
+	}
+}
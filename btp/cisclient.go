@@ -2,12 +2,16 @@ package btp
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/go-openapi/runtime"
@@ -21,10 +25,12 @@ import (
 )
 
 const (
-	errCouldNotParseCISSecret      = "CIS Secret seems malformed"
-	errCouldNotParseUserCredential = "error while parsing sa-provider-secret JSON"
-	errCISBindingCredentialIsNil        = "CIS binding credential is nil"
-	errCISBindingMissingRequiredFields  = "CIS binding is missing required fields: %s"
+	errCouldNotParseCISSecret          = "CIS Secret seems malformed"
+	errCouldNotParseUserCredential     = "error while parsing sa-provider-secret JSON"
+	errCISBindingCredentialIsNil       = "CIS binding credential is nil"
+	errCISBindingMissingRequiredFields = "CIS binding is missing required fields: %s"
+	errCISBindingInvalidCertificate    = "CIS binding contains an invalid client certificate or key"
+	errClientWithoutTokens             = "client was not created from CIS credentials"
 )
 
 type InstanceParameters = map[string]interface{}
@@ -36,9 +42,14 @@ type EnvironmentType struct {
 type Client struct {
 	AccountsServiceClient     *accountsserviceclient.APIClient
 	EntitlementsServiceClient *entitlementsserviceclient.ManageAssignedEntitlementsAPIService
+	EntitlementsJobsClient    *entitlementsserviceclient.JobManagementAPIService
 	ProvisioningServiceClient provisioningclient.EnvironmentsAPI
 	AuthInfo                  runtime.ClientAuthInfoWriter
 	Credential                *Credentials
+
+	tokens *tokenCache
+	// key is the credentialCacheKey of the credential the client was created from.
+	key string
 }
 type Credentials struct {
 	UserCredential *UserCredential
@@ -60,13 +71,27 @@ type CISCredential struct {
 		SaasRegistryServiceUrl string `json:"saas_registry_service_url"`
 	} `json:"endpoints"`
 	GrantType string `json:"grant_type"`
-	Uaa       struct {
+	// JWTBearerAssertion is not part of the binding. It is set by the provider to the OIDC token, which is
+	// exchanged for the access tokens with the jwt-bearer grant instead of using the client secret.
+	JWTBearerAssertion string `json:"jwt_bearer_assertion,omitempty"`
+	Uaa                struct {
 		Clientid     string `json:"clientid"`
 		Clientsecret string `json:"clientsecret"`
 		Url          string `json:"url"`
+		// CredentialType is x509 for bindings that authenticate with a client certificate instead of the client secret.
+		CredentialType string `json:"credential-type"`
+		Certificate    string `json:"certificate"`
+		Key            string `json:"key"`
+		// Certurl is the URL of the authentication server that accepts the client certificate.
+		Certurl string `json:"certurl"`
 	} `json:"uaa"`
 }
 
+// IsX509 returns true if the binding authenticates with a client certificate over mTLS instead of the client secret.
+func (c *CISCredential) IsX509() bool {
+	return c.Uaa.CredentialType == CredentialTypeX509 || c.Uaa.Certificate != ""
+}
+
 func validateCISCredential(c *CISCredential) error {
 	if c == nil {
 		return errors.New(errCISBindingCredentialIsNil)
@@ -76,7 +101,17 @@ func validateCISCredential(c *CISCredential) error {
 	if c.Uaa.Clientid == "" {
 		missing = append(missing, "uaa.clientid")
 	}
-	if c.Uaa.Clientsecret == "" {
+	if c.IsX509() {
+		if c.Uaa.Certificate == "" {
+			missing = append(missing, "uaa.certificate")
+		}
+		if c.Uaa.Key == "" {
+			missing = append(missing, "uaa.key")
+		}
+		if c.Uaa.Certurl == "" {
+			missing = append(missing, "uaa.certurl")
+		}
+	} else if c.Uaa.Clientsecret == "" && c.JWTBearerAssertion == "" {
 		missing = append(missing, "uaa.clientsecret")
 	}
 	if c.Uaa.Url == "" {
@@ -94,6 +129,11 @@ func validateCISCredential(c *CISCredential) error {
 	if len(missing) > 0 {
 		return fmt.Errorf(errCISBindingMissingRequiredFields, strings.Join(missing, ", "))
 	}
+	if c.IsX509() {
+		if _, err := tls.X509KeyPair([]byte(c.Uaa.Certificate), []byte(c.Uaa.Key)); err != nil {
+			return errors.Wrap(err, errCISBindingInvalidCertificate)
+		}
+	}
 	return nil
 }
 
@@ -103,7 +143,13 @@ const (
 	KymaenvironmentParameterInstanceName = "name"
 	grantTypeClientCredentials           = "client_credentials"
 	grantTypePassword                    = "password"
+	grantTypeJWTBearer                   = "urn:ietf:params:oauth:grant-type:jwt-bearer"
 	tokenURL                             = "/oauth/token"
+	tokenRefreshMargin                   = time.Minute
+	// CredentialTypeX509 is the credential-type of bindings with a client certificate.
+	CredentialTypeX509 = "x509"
+	// JWTBearerAssertionKey is the key of the JWTBearerAssertion in the JSON of a CISCredential.
+	JWTBearerAssertionKey = "jwt_bearer_assertion"
 )
 
 func NewServiceClientWithCisCredential(credential *Credentials) Client {
@@ -113,6 +159,10 @@ func NewServiceClientWithCisCredential(credential *Credentials) Client {
 	// reconcile, producing ~1.7 token POSTs per Observe.
 	key := credentialCacheKey(credential)
 	if cached, ok := clientCache.Load(key); ok {
+		// the OIDC token is rotated independently of the binding, so the cached client continues with the latest one
+		if assertion := credential.CISCredential.JWTBearerAssertion; assertion != "" {
+			cached.(Client).tokens.setAssertion(assertion)
+		}
 		return cached.(Client)
 	}
 
@@ -125,15 +175,64 @@ func NewServiceClientWithCisCredential(credential *Credentials) Client {
 }
 
 // clientCache: process-wide btp.Client cache keyed by credential hash.
-// Credential rotation produces a new key automatically; old entries leak
-// until process restart. Add a TTL/LRU if rotation churn becomes an issue.
+// Credential rotation produces a new key automatically; old entries stay
+// until EvictClients is called for them, which the ProviderConfig controller
+// does when the secrets of a ProviderConfig change.
 var clientCache sync.Map
 
+var (
+	evictHooksMu sync.RWMutex
+	evictHooks   []func(cacheKey string)
+)
+
+// CacheKey identifies the credential the client was created from, without revealing it.
+// Packages that cache data per credential use it as part of their keys, and drop the
+// data when EvictClients is called for it. It is empty for clients not created from a credential.
+func (c Client) CacheKey() string {
+	if c.key == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(c.key))
+	return hex.EncodeToString(sum[:])
+}
+
+// OnEvict registers a function that drops the data cached for the credential with the given
+// CacheKey. It is called by EvictClients and must be registered before the controllers are started.
+func OnEvict(hook func(cacheKey string)) {
+	evictHooksMu.Lock()
+	defer evictHooksMu.Unlock()
+	evictHooks = append(evictHooks, hook)
+}
+
+// EvictClients drops the cached client of the credential with the given CacheKey, including its
+// token and rate limiters, and the data that other packages cached for it. The next client
+// created for the credential fetches a new token.
+func EvictClients(cacheKey string) {
+	if cacheKey == "" {
+		return
+	}
+	clientCache.Range(func(k, v any) bool {
+		if client := v.(Client); client.CacheKey() == cacheKey {
+			clientCache.Delete(k)
+			deleteLimiters(client.key)
+		}
+		return true
+	})
+
+	evictHooksMu.RLock()
+	defer evictHooksMu.RUnlock()
+	for _, hook := range evictHooks {
+		hook(cacheKey)
+	}
+}
+
 // credentialCacheKey builds a stable string key from the credential bundle.
 // We need ALL credential fields to differentiate cache entries (including the
 // secret, so that a credential rotation produces a new entry), but we do not
 // need cryptographic hashing — this is an in-process map key, not a password
 // verifier. NUL separator can't appear in any of the input strings.
+// The JWTBearerAssertion is left out, as it rotates on its own and is updated
+// in the cached client instead.
 func credentialCacheKey(c *Credentials) string {
 	var parts []string
 	if c.CISCredential != nil {
@@ -141,6 +240,9 @@ func credentialCacheKey(c *Credentials) string {
 			c.CISCredential.Uaa.Clientid,
 			c.CISCredential.Uaa.Clientsecret,
 			c.CISCredential.Uaa.Url,
+			c.CISCredential.Uaa.Certificate,
+			c.CISCredential.Uaa.Key,
+			c.CISCredential.Uaa.Certurl,
 			c.CISCredential.Endpoints.AccountsServiceUrl,
 			c.CISCredential.Endpoints.EntitlementsServiceUrl,
 			c.CISCredential.Endpoints.ProvisioningServiceUrl,
@@ -160,10 +262,17 @@ func credentialCacheKey(c *Credentials) string {
 
 func authenticationParams(credential *Credentials) url.Values {
 	params := url.Values{}
+	if credential.CISCredential.JWTBearerAssertion != "" {
+		// the assertion itself is added by the tokenCache, which always uses the latest one
+		params.Add("grant_type", grantTypeJWTBearer)
+		return params
+	}
 	if hasClientCredentials(credential) {
 		if isGrantTypeClientCredentials(credential) {
 			params.Add("username", credential.CISCredential.Uaa.Clientid)
-			params.Add("password", credential.CISCredential.Uaa.Clientsecret)
+			if !credential.CISCredential.IsX509() {
+				params.Add("password", credential.CISCredential.Uaa.Clientsecret)
+			}
 			params.Add("grant_type", grantTypeClientCredentials)
 		} else {
 			params.Add("username", credential.UserCredential.Email)
@@ -188,38 +297,138 @@ func hasClientCredentials(credential *Credentials) bool {
 }
 
 func createClient(credential *Credentials, config *clientcredentials.Config) Client {
-	// One shared oauth2 *http.Client across all 3 sub-clients so the token
-	// cache is shared. Without this, each createXxxServiceClient builds its
-	// own *http.Client → 3 independent token caches → extra token POSTs per
-	// Observe.
-	sharedHTTPClient := sharedOAuthClient(config)
+	// One shared token cache across all 3 sub-clients. Without this, each
+	// createXxxServiceClient would build its own token source → 3 independent
+	// token caches → extra token POSTs per Observe.
+	tokens := &tokenCache{config: config, assertion: credential.CISCredential.JWTBearerAssertion}
+	if credential.CISCredential.IsX509() {
+		tokens.transport, tokens.err = NewMTLSTransport(credential.CISCredential.Uaa.Certificate, credential.CISCredential.Uaa.Key)
+	}
+	key := credentialCacheKey(credential)
 	client := Client{
-		AccountsServiceClient:     createAccountsServiceClient(credential, sharedHTTPClient),
-		EntitlementsServiceClient: createEntitlementsServiceClient(credential, sharedHTTPClient),
-		ProvisioningServiceClient: createProvisioningServiceClient(credential, sharedHTTPClient),
+		AccountsServiceClient:     createAccountsServiceClient(credential, oauthClient(APIAccounts, key, tokens)),
+		ProvisioningServiceClient: createProvisioningServiceClient(credential, oauthClient(APIProvisioning, key, tokens)),
 		AuthInfo:                  GetBasicAuth(credential),
 		Credential:                credential,
+		tokens:                    tokens,
+		key:                       key,
+	}
+	if entitlements := createEntitlementsServiceClient(credential, oauthClient(APIEntitlements, key, tokens)); entitlements != nil {
+		client.EntitlementsServiceClient = entitlements.ManageAssignedEntitlementsAPI
+		client.EntitlementsJobsClient = entitlements.JobManagementAPI
 	}
 	return client
 }
 
-// sharedOAuthClient builds a single *http.Client backed by the debug-aware
-// HTTP transport and the oauth2 transport with a reused token source.
-// Sharing this client across the 3 sub-clients collapses 3 token caches into 1.
-func sharedOAuthClient(config *clientcredentials.Config) *http.Client {
-	baseHTTPClient := DebugPrintHTTPClient()
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, baseHTTPClient)
-	// config.TokenSource already wraps in oauth2.ReuseTokenSource internally.
+// oauthClient builds a *http.Client for the given API, which authenticates with
+// the shared token cache, records the metrics and traces of the API requests and
+// applies the rate limit of the API to the credential identified by key.
+func oauthClient(api, key string, tokens *tokenCache) *http.Client {
 	return &http.Client{
-		Transport: &oauth2.Transport{
-			Source: config.TokenSource(ctx),
-			Base:   baseHTTPClient.Transport,
+		Transport: &tokenTransport{
+			tokens: tokens,
+			base:   DebugPrintHTTPClient(WithAPI(api), WithRateLimit(key)).Transport,
 		},
 	}
 }
 
+// Token returns the access token the client authorizes its requests with, fetching a new one if necessary.
+func (c Client) Token(ctx context.Context) (*oauth2.Token, error) {
+	if c.tokens == nil {
+		return nil, errors.New(errClientWithoutTokens)
+	}
+	return c.tokens.Token(ctx)
+}
+
+// tokenCache caches the token of a client credentials config. Unlike an oauth2.TokenSource,
+// it fetches new tokens with the context of the request that needs them, so that the token
+// request becomes part of the trace of that request.
+type tokenCache struct {
+	config *clientcredentials.Config
+	// transport sends the token requests, the default transport if nil. X.509 bindings use an mTLS transport.
+	transport http.RoundTripper
+	// err is returned instead of a token if the transport could not be built.
+	err error
+	// assertion is the OIDC token that is exchanged with the jwt-bearer grant, if set.
+	assertion string
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// Token returns the cached token, or fetches a new one if the cached token is missing or expired.
+func (c *tokenCache) Token(ctx context.Context) (*oauth2.Token, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	if fresh(c.token) {
+		return c.token, nil
+	}
+	config := c.config
+	if c.assertion != "" {
+		config = jwtBearerConfig(c.config, c.assertion)
+	}
+	httpClient := DebugPrintHTTPClient(WithHttpClient(&http.Client{Transport: c.transport}), WithAPI(APIToken))
+	token, err := config.Token(context.WithValue(ctx, oauth2.HTTPClient, httpClient))
+	if err != nil {
+		return nil, err
+	}
+	c.token = token
+	return token, nil
+}
+
+// setAssertion replaces the OIDC token used for the next token requests.
+func (c *tokenCache) setAssertion(assertion string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.assertion = assertion
+}
+
+// fresh returns true if the token is valid for at least the tokenRefreshMargin, so that it is
+// refreshed before it expires during a reconcile.
+func fresh(token *oauth2.Token) bool {
+	return token.Valid() && (token.Expiry.IsZero() || time.Until(token.Expiry) > tokenRefreshMargin)
+}
+
+// jwtBearerConfig returns a copy of config that requests tokens with the jwt-bearer grant for the given assertion.
+func jwtBearerConfig(config *clientcredentials.Config, assertion string) *clientcredentials.Config {
+	params := url.Values{}
+	for k, v := range config.EndpointParams {
+		params[k] = v
+	}
+	params.Set("grant_type", grantTypeJWTBearer)
+	params.Set("assertion", assertion)
+	jwtConfig := *config
+	jwtConfig.EndpointParams = params
+	return &jwtConfig
+}
+
+// tokenTransport authorizes requests with the tokens of a tokenCache, like oauth2.Transport.
+type tokenTransport struct {
+	tokens *tokenCache
+	base   http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokens.Token(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	authorized := req.Clone(req.Context())
+	token.SetAuthHeader(authorized)
+	return t.base.RoundTrip(authorized)
+}
+
 func createProvisioningServiceClient(
-	credential *Credentials, sharedHTTPClient *http.Client,
+	credential *Credentials, httpClient *http.Client,
 ) provisioningclient.EnvironmentsAPI {
 	provisioningServiceUrl, err := url.Parse(credential.CISCredential.Endpoints.ProvisioningServiceUrl)
 	if err != nil {
@@ -228,7 +437,7 @@ func createProvisioningServiceClient(
 
 	c := provisioningclient.NewConfiguration()
 
-	c.HTTPClient = sharedHTTPClient
+	c.HTTPClient = httpClient
 	c.Servers = []provisioningclient.ServerConfiguration{{URL: provisioningServiceUrl.String()}}
 
 	client := provisioningclient.NewAPIClient(c)
@@ -238,18 +447,31 @@ func createProvisioningServiceClient(
 
 func createConfig(credential *Credentials, tokenURL string, endPointParams url.Values) *clientcredentials.Config {
 	uaa := credential.CISCredential.Uaa
+	if credential.CISCredential.IsX509() {
+		// the client authenticates with its certificate, so only the client id is sent with the token request
+		return &clientcredentials.Config{
+			ClientID:       uaa.Clientid,
+			TokenURL:       uaa.Certurl + tokenURL,
+			EndpointParams: endPointParams,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}
+	}
 	config := &clientcredentials.Config{
 		ClientID:       uaa.Clientid,
 		ClientSecret:   uaa.Clientsecret,
 		TokenURL:       uaa.Url + tokenURL,
 		EndpointParams: endPointParams,
 	}
+	if uaa.Clientsecret == "" {
+		// without client secret, e.g. for the jwt-bearer grant, the client is identified by its client id only
+		config.AuthStyle = oauth2.AuthStyleInParams
+	}
 	return config
 }
 
 func createEntitlementsServiceClient(
-	cisCredential *Credentials, sharedHTTPClient *http.Client,
-) *entitlementsserviceclient.ManageAssignedEntitlementsAPIService {
+	cisCredential *Credentials, httpClient *http.Client,
+) *entitlementsserviceclient.APIClient {
 	entitlementsServiceUrl, err := url.Parse(cisCredential.CISCredential.Endpoints.EntitlementsServiceUrl)
 	if err != nil {
 		return nil
@@ -257,16 +479,14 @@ func createEntitlementsServiceClient(
 
 	c := entitlementsserviceclient.NewConfiguration()
 
-	c.HTTPClient = sharedHTTPClient
+	c.HTTPClient = httpClient
 	c.Servers = []entitlementsserviceclient.ServerConfiguration{{URL: entitlementsServiceUrl.String()}}
 
-	client := entitlementsserviceclient.NewAPIClient(c)
-
-	return client.ManageAssignedEntitlementsAPI
+	return entitlementsserviceclient.NewAPIClient(c)
 }
 
 func createAccountsServiceClient(
-	cisCredential *Credentials, sharedHTTPClient *http.Client,
+	cisCredential *Credentials, httpClient *http.Client,
 ) *accountsserviceclient.APIClient {
 	accountServiceUrl, err := url.Parse(cisCredential.CISCredential.Endpoints.AccountsServiceUrl)
 	if err != nil {
@@ -275,7 +495,7 @@ func createAccountsServiceClient(
 
 	c := accountsserviceclient.NewConfiguration()
 
-	c.HTTPClient = sharedHTTPClient
+	c.HTTPClient = httpClient
 	c.Servers = []accountsserviceclient.ServerConfiguration{{URL: accountServiceUrl.String()}}
 
 	client := accountsserviceclient.NewAPIClient(c)
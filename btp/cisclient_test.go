@@ -1,10 +1,26 @@
 package btp
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/sap/crossplane-provider-btp/internal"
 	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
@@ -102,9 +118,13 @@ func Test_authenticationParams(t *testing.T) {
 					CISCredential: &CISCredential{
 						GrantType: "user_token",
 						Uaa: struct {
-							Clientid     string `json:"clientid"`
-							Clientsecret string `json:"clientsecret"`
-							Url          string `json:"url"`
+							Clientid       string `json:"clientid"`
+							Clientsecret   string `json:"clientsecret"`
+							Url            string `json:"url"`
+							CredentialType string `json:"credential-type"`
+							Certificate    string `json:"certificate"`
+							Key            string `json:"key"`
+							Certurl        string `json:"certurl"`
 						}{
 							Clientid: "myclientid",
 						},
@@ -122,9 +142,13 @@ func Test_authenticationParams(t *testing.T) {
 					CISCredential: &CISCredential{
 						GrantType: "client_credentials",
 						Uaa: struct {
-							Clientid     string `json:"clientid"`
-							Clientsecret string `json:"clientsecret"`
-							Url          string `json:"url"`
+							Clientid       string `json:"clientid"`
+							Clientsecret   string `json:"clientsecret"`
+							Url            string `json:"url"`
+							CredentialType string `json:"credential-type"`
+							Certificate    string `json:"certificate"`
+							Key            string `json:"key"`
+							Certurl        string `json:"certurl"`
 						}{
 							Clientid:     "myclientid",
 							Clientsecret: "myclientsecret",
@@ -144,9 +168,13 @@ func Test_authenticationParams(t *testing.T) {
 					CISCredential: &CISCredential{
 						GrantType: "user_token",
 						Uaa: struct {
-							Clientid     string `json:"clientid"`
-							Clientsecret string `json:"clientsecret"`
-							Url          string `json:"url"`
+							Clientid       string `json:"clientid"`
+							Clientsecret   string `json:"clientsecret"`
+							Url            string `json:"url"`
+							CredentialType string `json:"credential-type"`
+							Certificate    string `json:"certificate"`
+							Key            string `json:"key"`
+							Certurl        string `json:"certurl"`
 						}{
 							Clientid: "",
 						},
@@ -246,13 +274,25 @@ func TestCloudFoundryOrgByLabel(t *testing.T) {
 }
 
 func TestValidateCISCredential(t *testing.T) {
+	certificate, key := testClientCertificate(t)
+	useX509 := func(c *CISCredential) {
+		c.Uaa.Clientsecret = ""
+		c.Uaa.CredentialType = CredentialTypeX509
+		c.Uaa.Certificate = certificate
+		c.Uaa.Key = key
+		c.Uaa.Certurl = "https://my-tenant.authentication.cert.eu10.hana.ondemand.com"
+	}
 	validCredential := func() *CISCredential {
 		return &CISCredential{
 			GrantType: "client_credentials",
 			Uaa: struct {
-				Clientid     string `json:"clientid"`
-				Clientsecret string `json:"clientsecret"`
-				Url          string `json:"url"`
+				Clientid       string `json:"clientid"`
+				Clientsecret   string `json:"clientsecret"`
+				Url            string `json:"url"`
+				CredentialType string `json:"credential-type"`
+				Certificate    string `json:"certificate"`
+				Key            string `json:"key"`
+				Certurl        string `json:"certurl"`
 			}{
 				Clientid:     "my-client-id",
 				Clientsecret: "my-client-secret",
@@ -334,6 +374,24 @@ func TestValidateCISCredential(t *testing.T) {
 			wantErr: true,
 			wantMsg: "uaa.clientid",
 		},
+		{
+			name:    "valid x509 credential without client secret",
+			mutate:  useX509,
+			wantErr: false,
+		},
+		{
+			name:     "x509 credential missing key and certurl",
+			mutate:   func(c *CISCredential) { useX509(c); c.Uaa.Key = ""; c.Uaa.Certurl = "" },
+			wantErr:  true,
+			wantMsg:  "uaa.key",
+			wantMsg2: "uaa.certurl",
+		},
+		{
+			name:    "x509 credential with invalid certificate",
+			mutate:  func(c *CISCredential) { useX509(c); c.Uaa.Certificate = "not a certificate" },
+			wantErr: true,
+			wantMsg: errCISBindingInvalidCertificate,
+		},
 		{
 			name:    "nil credential",
 			useNil:  true,
@@ -366,3 +424,125 @@ func TestValidateCISCredential(t *testing.T) {
 		})
 	}
 }
+
+func TestX509TokenRequest(t *testing.T) {
+	SetLogger(logging.NewNopLogger())
+	certificate, key := testClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/token" || len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("client_id") != "my-client-id" || r.PostForm.Has("client_secret") || r.PostForm.Has("password") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	credential := &Credentials{CISCredential: &CISCredential{GrantType: grantTypeClientCredentials}}
+	credential.CISCredential.Uaa.Clientid = "my-client-id"
+	credential.CISCredential.Uaa.Url = "https://my-tenant.authentication.eu10.hana.ondemand.com"
+	credential.CISCredential.Uaa.CredentialType = CredentialTypeX509
+	credential.CISCredential.Uaa.Certificate = certificate
+	credential.CISCredential.Uaa.Key = key
+	credential.CISCredential.Uaa.Certurl = server.URL
+
+	transport, err := NewMTLSTransport(certificate, key)
+	require.NoError(t, err)
+	transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+	transport.TLSClientConfig.RootCAs.AddCert(server.Certificate())
+
+	tokens := &tokenCache{config: createConfig(credential, tokenURL, authenticationParams(credential)), transport: transport}
+	token, err := tokens.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+}
+
+// testClientCertificate returns a PEM encoded self-signed client certificate and its key.
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "my-client-id"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestJWTBearerTokenExchange(t *testing.T) {
+	SetLogger(logging.NewNopLogger())
+	var assertions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != grantTypeJWTBearer ||
+			r.PostForm.Get("client_id") != "my-client-id" || r.PostForm.Has("client_secret") || r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assertions = append(assertions, r.PostForm.Get("assertion"))
+		w.Header().Set("Content-Type", "application/json")
+		// expires within the refresh margin, so every Token call requests a new token
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":30}`))
+	}))
+	defer server.Close()
+
+	binding := func(assertion string) []byte {
+		return []byte(`{"endpoints":{"accounts_service_url":"` + server.URL + `","entitlements_service_url":"` + server.URL + `","provisioning_service_url":"` + server.URL + `"},` +
+			`"grant_type":"client_credentials","uaa":{"clientid":"my-client-id","url":"` + server.URL + `"},"jwt_bearer_assertion":"` + assertion + `"}`)
+	}
+
+	first, err := ServiceClientFromSecret(binding("first-token"), []byte(`{}`))
+	require.NoError(t, err)
+	_, err = first.tokens.Token(context.Background())
+	require.NoError(t, err)
+
+	// a rotated OIDC token reuses the cached client, which exchanges the new token from now on
+	second, err := ServiceClientFromSecret(binding("second-token"), []byte(`{}`))
+	require.NoError(t, err)
+	assert.Same(t, first.tokens, second.tokens)
+	_, err = second.tokens.Token(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"first-token", "second-token"}, assertions)
+}
+
+func TestEvictClients(t *testing.T) {
+	SetLogger(logging.NewNopLogger())
+	binding := func(secret string) []byte {
+		return []byte(`{"endpoints":{"accounts_service_url":"https://accounts","entitlements_service_url":"https://entitlements","provisioning_service_url":"https://provisioning"},` +
+			`"grant_type":"client_credentials","uaa":{"clientid":"evict-client","clientsecret":"` + secret + `","url":"https://uaa"}}`)
+	}
+	var evicted []string
+	OnEvict(func(cacheKey string) { evicted = append(evicted, cacheKey) })
+
+	old, err := ServiceClientFromSecret(binding("old"), []byte(`{}`))
+	require.NoError(t, err)
+	rotated, err := ServiceClientFromSecret(binding("rotated"), []byte(`{}`))
+	require.NoError(t, err)
+	require.NotEmpty(t, old.CacheKey())
+	assert.NotEqual(t, old.CacheKey(), rotated.CacheKey())
+
+	EvictClients(old.CacheKey())
+	assert.Equal(t, []string{old.CacheKey()}, evicted)
+
+	again, err := ServiceClientFromSecret(binding("old"), []byte(`{}`))
+	require.NoError(t, err)
+	assert.NotSame(t, old.tokens, again.tokens)
+	stillCached, err := ServiceClientFromSecret(binding("rotated"), []byte(`{}`))
+	require.NoError(t, err)
+	assert.Same(t, rotated.tokens, stillCached.tokens)
+}
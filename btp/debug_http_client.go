@@ -30,28 +30,42 @@ func SetDebug(debugFlag bool) {
 }
 
 // NewBackgroundContextWithDebugPrintHTTPClient creates a new context with a HTTP client that logs the request and response in the RoundTrip.
+// If an API is set with the WithAPI option, the HTTP client also records the metrics and traces of the requests, regardless of the debug flag.
 func NewBackgroundContextWithDebugPrintHTTPClient(opts ...Option) context.Context {
-	if debug {
-		return context.WithValue(context.Background(), oauth2.HTTPClient, DebugPrintHTTPClient(opts...))
-	} else {
-		return context.Background()
-	}
+	return AddDebugPrintHTTPClientToContext(context.Background(), opts...)
 }
 
 // AddDebugPrintHTTPClientToContext adds a HTTP client that logs the request and response in the RoundTrip to the context with the oauth2.HTTPClient key.
+// If an API is set with the WithAPI option, the HTTP client also records the metrics and traces of the requests, regardless of the debug flag.
 func AddDebugPrintHTTPClientToContext(ctx context.Context, opts ...Option) context.Context {
 	if debug {
 		return context.WithValue(ctx, oauth2.HTTPClient, DebugPrintHTTPClient(opts...))
-	} else {
-		return ctx
 	}
+	if c := newDebugHttpClient(opts...); c.api != "" {
+		c.client.Transport = c.instrument(c.client.Transport)
+		return context.WithValue(ctx, oauth2.HTTPClient, c.client)
+	}
+	return ctx
 }
 
 type Option func(*debugHttpClient)
 
 // DebugHttpClient wraps a http.Client to allow passing a http.Client as an option to DebugPrintHTTPClient
 type debugHttpClient struct {
-	client *http.Client
+	client       *http.Client
+	api          string
+	rateLimitKey string
+}
+
+func newDebugHttpClient(opts ...Option) *debugHttpClient {
+	debugClient := &debugHttpClient{
+		client: &http.Client{},
+	}
+
+	for _, applyOpt := range opts {
+		applyOpt(debugClient)
+	}
+	return debugClient
 }
 
 // WithHttpClient sets the http.Client to use for the debug client. For debugging, the Transport RoundTripper is wrapped with the RoundTripDebugger that calls the original RoundTripper and logs the request and response.
@@ -61,16 +75,35 @@ func WithHttpClient(client *http.Client) Option {
 	}
 }
 
-// DebugPrintHTTPClient returns a new http.Client that logs the request and response in the RoundTrip.
-// The debug client uses the default http.Client if no client is set with the WithHttpClient option, otherwise the set client is used and the Transport RoundTripper wrapped.
-func DebugPrintHTTPClient(opts ...Option) *http.Client {
-	debugClient := &debugHttpClient{
-		client: &http.Client{},
+// WithAPI sets the BTP API the client talks to. The Transport RoundTripper is additionally wrapped with the RoundTripMetrics and the RoundTripTracing that record the metrics and traces of the requests for this API.
+func WithAPI(api string) Option {
+	return func(d *debugHttpClient) {
+		d.api = api
 	}
+}
 
-	for _, applyOpt := range opts {
-		applyOpt(debugClient)
+// WithRateLimit limits the requests of the client to the API set with WithAPI. The limit is shared by all clients with the same key,
+// which is usually built from the credentials with RateLimitKey. 429 responses are returned as ThrottledError.
+func WithRateLimit(key string) Option {
+	return func(d *debugHttpClient) {
+		d.rateLimitKey = key
 	}
+}
+
+// instrument wraps base in the RoundTripMetrics and the RoundTripTracing of the API,
+// and in the RoundTripRateLimit if a rate limit key is set.
+func (d *debugHttpClient) instrument(base http.RoundTripper) http.RoundTripper {
+	rt := instrument(d.api, base)
+	if d.rateLimitKey != "" {
+		rt = NewRoundTripRateLimit(d.api, d.rateLimitKey, rt)
+	}
+	return rt
+}
+
+// DebugPrintHTTPClient returns a new http.Client that logs the request and response in the RoundTrip.
+// The debug client uses the default http.Client if no client is set with the WithHttpClient option, otherwise the set client is used and the Transport RoundTripper wrapped.
+func DebugPrintHTTPClient(opts ...Option) *http.Client {
+	debugClient := newDebugHttpClient(opts...)
 
 	//Set Own RoundTripper Interceptor with RoundTripper from client in case it is set
 
@@ -82,6 +115,10 @@ func DebugPrintHTTPClient(opts ...Option) *http.Client {
 		debugClient.client.Transport = &RoundTripDebugger{base: debugClient.client.Transport}
 	}
 
+	if debugClient.api != "" {
+		debugClient.client.Transport = debugClient.instrument(debugClient.client.Transport)
+	}
+
 	return debugClient.client
 }
 
@@ -41,8 +41,20 @@ func (c *Client) GetKymaEnvironment(
 }
 
 func (c *Client) CreateKymaEnvironment(ctx context.Context, instanceName string, planeName string, parameters InstanceParameters, resourceUID string, serviceAccountEmail string, landscapeLabel *string) (string, error) {
+	payload := NewKymaEnvironmentCreatePayload(instanceName, planeName, parameters, serviceAccountEmail, landscapeLabel)
+	obj, _, err := c.ProvisioningServiceClient.CreateEnvironmentInstance(ctx).CreateEnvironmentInstanceRequestPayload(payload).Execute()
+
+	if err != nil {
+		return "", specifyAPIError(err)
+	}
+
+	return *obj.Id, nil
+}
+
+// NewKymaEnvironmentCreatePayload returns the request CreateKymaEnvironment sends.
+func NewKymaEnvironmentCreatePayload(instanceName string, planeName string, parameters InstanceParameters, serviceAccountEmail string, landscapeLabel *string) provisioningclient.CreateEnvironmentInstanceRequestPayload {
 	envType := KymaEnvironmentType()
-	payload := provisioningclient.CreateEnvironmentInstanceRequestPayload{
+	return provisioningclient.CreateEnvironmentInstanceRequestPayload{
 		Description:     internal.Ptr("created via crossplane-provider-btp-account"),
 		EnvironmentType: envType.Identifier,
 		LandscapeLabel:  landscapeLabel,
@@ -54,20 +66,10 @@ func (c *Client) CreateKymaEnvironment(ctx context.Context, instanceName string,
 		TechnicalKey:    nil,
 		User:            &serviceAccountEmail,
 	}
-	obj, _, err := c.ProvisioningServiceClient.CreateEnvironmentInstance(ctx).CreateEnvironmentInstanceRequestPayload(payload).Execute()
-
-	if err != nil {
-		return "", specifyAPIError(err)
-	}
-
-	return *obj.Id, nil
 }
 
 func (c *Client) UpdateKymaEnvironment(ctx context.Context, environmentInstanceId string, planeName string, instanceParameters InstanceParameters, resourceUID string) error {
-	payload := provisioningclient.UpdateEnvironmentInstanceRequestPayload{
-		Parameters: instanceParameters,
-		PlanName:   planeName,
-	}
+	payload := NewKymaEnvironmentUpdatePayload(planeName, instanceParameters)
 
 	_, _, err := c.ProvisioningServiceClient.UpdateEnvironmentInstance(ctx, environmentInstanceId).UpdateEnvironmentInstanceRequestPayload(payload).Execute()
 	if err != nil {
@@ -77,6 +79,14 @@ func (c *Client) UpdateKymaEnvironment(ctx context.Context, environmentInstanceI
 	return nil
 }
 
+// NewKymaEnvironmentUpdatePayload returns the request UpdateKymaEnvironment sends.
+func NewKymaEnvironmentUpdatePayload(planeName string, instanceParameters InstanceParameters) provisioningclient.UpdateEnvironmentInstanceRequestPayload {
+	return provisioningclient.UpdateEnvironmentInstanceRequestPayload{
+		Parameters: instanceParameters,
+		PlanName:   planeName,
+	}
+}
+
 // GetEnvironmentByNameAndType retrieves environment using its name and type. It performs a list and filters client-side.
 // Deprecated: use GetEnvironmentsByID instead.
 func (c *Client) GetEnvironmentByNameAndType(
@@ -0,0 +1,132 @@
+package btp
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Names of the BTP APIs used as label of the API metrics.
+const (
+	APIAccounts       = "accounts"
+	APIEntitlements   = "entitlements"
+	APIProvisioning   = "provisioning"
+	APISaaS           = "saas"
+	APIServiceManager = "servicemanager"
+	APIXsuaa          = "xsuaa"
+	APIToken          = "token"
+)
+
+const idPlaceholder = "{id}"
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "btp_api_requests_total",
+		Help: "Number of requests sent to BTP APIs, partitioned by API, operation and status code class.",
+	}, []string{"api", "operation", "code"})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "btp_api_request_duration_seconds",
+		Help:    "Latency of requests sent to BTP APIs, partitioned by API and operation.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"api", "operation"})
+
+	apiThrottledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "btp_api_throttled_requests_total",
+		Help: "Number of requests rejected by BTP APIs with status 429 Too Many Requests, partitioned by API and operation.",
+	}, []string{"api", "operation"})
+)
+
+func init() {
+	// Register on the controller-runtime registry, so that the metrics are served by the metrics endpoint of the manager.
+	metrics.Registry.MustRegister(apiRequests, apiRequestDuration, apiThrottledRequests)
+}
+
+// RoundTripMetrics records request counts, latencies and status codes of all requests sent through its base RoundTripper.
+type RoundTripMetrics struct {
+	base http.RoundTripper
+	api  string
+}
+
+// NewRoundTripMetrics wraps base, or the default transport if base is nil, in a RoundTripMetrics for the given API.
+func NewRoundTripMetrics(api string, base http.RoundTripper) *RoundTripMetrics {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RoundTripMetrics{base: base, api: api}
+}
+
+// RoundTrip calls the base RoundTripper and records the metrics of the request.
+func (r *RoundTripMetrics) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := operation(req)
+	start := time.Now()
+	resp, err := r.base.RoundTrip(req)
+	apiRequestDuration.WithLabelValues(r.api, op).Observe(time.Since(start).Seconds())
+
+	code := "error"
+	if err == nil && resp != nil {
+		code = strconv.Itoa(resp.StatusCode/100) + "xx"
+		if resp.StatusCode == http.StatusTooManyRequests {
+			apiThrottledRequests.WithLabelValues(r.api, op).Inc()
+		}
+	}
+	apiRequests.WithLabelValues(r.api, op, code).Inc()
+	return resp, err
+}
+
+// operation returns the method and the path of the request, with all path segments
+// that identify a single resource replaced, to keep the cardinality of the metrics low.
+func operation(req *http.Request) string {
+	if req == nil || req.URL == nil {
+		return ""
+	}
+	return req.Method + " " + normalizePath(req.URL.Path)
+}
+
+// normalizePath replaces the IDs and names in a REST path with a placeholder. A segment is treated
+// as identifier if it follows a collection, like "subaccounts" or "rolecollections", or if it
+// contains other characters than letters, dashes and underscores.
+func normalizePath(path string) string {
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" {
+		return "/"
+	}
+	segments := strings.Split(trimmed, "/")
+	previousIsCollection := false
+	for i, s := range segments {
+		switch {
+		case isVersionSegment(s):
+			previousIsCollection = false
+		case previousIsCollection || !isStaticSegment(s):
+			segments[i] = idPlaceholder
+			previousIsCollection = false
+		default:
+			previousIsCollection = strings.HasSuffix(s, "s")
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isVersionSegment(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(s[1:])
+	return err == nil
+}
+
+func isStaticSegment(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && c != '-' && c != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package btp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// gathered returns the counter value, or the histogram sample count, of the metric with the given labels.
+func gathered(t *testing.T, name string, labels map[string]string) float64 {
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+	metric:
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if labels[l.GetName()] != l.GetValue() {
+					continue metric
+				}
+			}
+			if h := m.GetHistogram(); h != nil {
+				return float64(h.GetSampleCount())
+			}
+			return m.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestNormalizePath(t *testing.T) {
+	tests := map[string]struct {
+		path string
+		want string
+	}{
+		"Empty":            {path: "", want: "/"},
+		"Collection":       {path: "/accounts/v1/subaccounts", want: "/accounts/v1/subaccounts"},
+		"GUID":             {path: "/accounts/v1/subaccounts/6c3c6fa4-6f12-4a36-9bd1-0f6b5e2a4c11", want: "/accounts/v1/subaccounts/{id}"},
+		"SubResource":      {path: "/accounts/v1/subaccounts/6c3c6fa4-6f12-4a36-9bd1-0f6b5e2a4c11/serviceManagementBinding", want: "/accounts/v1/subaccounts/{id}/serviceManagementBinding"},
+		"NameAfterCollect": {path: "/sap/rest/authorization/v2/rolecollections/Subaccount Viewer", want: "/sap/rest/authorization/v2/rolecollections/{id}"},
+		"AppName":          {path: "/saas-manager/v1/applications/auditlog-viewer/subscription", want: "/saas-manager/v1/applications/{id}/subscription"},
+		"Underscores":      {path: "/v1/service_instances/abc/parameters", want: "/v1/service_instances/{id}/parameters"},
+		"Token":            {path: "/oauth/token", want: "/oauth/token"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, normalizePath(tc.path))
+		})
+	}
+}
+
+func TestRoundTripMetrics(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRoundTripMetrics("test", nil)}
+	op := "GET /accounts/v1/subaccounts/{id}"
+
+	resp, err := client.Get(server.URL + "/accounts/v1/subaccounts/1234")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	status = http.StatusTooManyRequests
+	resp, err = client.Get(server.URL + "/accounts/v1/subaccounts/5678")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, 1.0, gathered(t, "btp_api_requests_total", map[string]string{"api": "test", "operation": op, "code": "2xx"}))
+	assert.Equal(t, 1.0, gathered(t, "btp_api_requests_total", map[string]string{"api": "test", "operation": op, "code": "4xx"}))
+	assert.Equal(t, 1.0, gathered(t, "btp_api_throttled_requests_total", map[string]string{"api": "test", "operation": op}))
+	assert.Equal(t, 2.0, gathered(t, "btp_api_request_duration_seconds", map[string]string{"api": "test", "operation": op}))
+
+	_, err = (&http.Client{Transport: NewRoundTripMetrics("test-error", nil)}).Get("http://127.0.0.1:0/oauth/token")
+	assert.Error(t, err)
+	assert.Equal(t, 1.0, gathered(t, "btp_api_requests_total", map[string]string{"api": "test-error", "operation": "GET /oauth/token", "code": "error"}))
+}
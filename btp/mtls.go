@@ -0,0 +1,25 @@
+package btp
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const errInvalidClientCertificate = "cannot parse client certificate and key"
+
+// NewMTLSTransport returns a copy of the default transport that authenticates with the given PEM encoded
+// client certificate and private key, as required by the certurl of X.509 bindings.
+func NewMTLSTransport(certificate, key string) (*http.Transport, error) {
+	cert, err := tls.X509KeyPair([]byte(certificate), []byte(key))
+	if err != nil {
+		return nil, errors.Wrap(err, errInvalidClientCertificate)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return transport, nil
+}
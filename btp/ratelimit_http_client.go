@@ -0,0 +1,206 @@
+package btp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit configures the token bucket that limits the requests to a BTP API per credential.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled. Requests are not limited if it is 0 or less.
+	RequestsPerSecond float64
+	// Burst is the size of the bucket, at least 1.
+	Burst int
+}
+
+var (
+	rateLimitsMu sync.RWMutex
+	rateLimits   = map[string]RateLimit{}
+
+	// limiters holds the limiter per API and credential, shared by all clients of the credential.
+	limiters sync.Map
+	// lastIdleSweep is the time in unix nanoseconds idle limiters were last dropped.
+	lastIdleSweep atomic.Int64
+)
+
+// idleLimiterTTL is the time after which unused limiters of expiringAPIs are dropped.
+const idleLimiterTTL = time.Hour
+
+// expiringAPIs are the APIs whose clients are created for every reconcile from credentials of managed
+// resources. Their limiters are not dropped by EvictClients, so they expire once they are no longer used.
+var expiringAPIs = map[string]bool{APIXsuaa: true}
+
+// SetRateLimit sets the rate limit of the given API. It applies to limiters created afterward,
+// so it must be called before the controllers are started.
+func SetRateLimit(api string, limit RateLimit) {
+	rateLimitsMu.Lock()
+	defer rateLimitsMu.Unlock()
+	rateLimits[api] = limit
+}
+
+func rateLimitOf(api string) RateLimit {
+	rateLimitsMu.RLock()
+	defer rateLimitsMu.RUnlock()
+	return rateLimits[api]
+}
+
+// RateLimitKey builds the key of the limiter from the parts of a credential, e.g. client ID, client secret and URLs.
+// The key is a hash of the parts, so that the credential is not kept in memory by its limiter.
+func RateLimitKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ThrottledError is returned for requests that BTP rejected with 429 Too Many Requests,
+// or that were not sent because an earlier request of the same credential was rejected and its Retry-After has not passed yet.
+type ThrottledError struct {
+	API string
+	// RetryAfter is the time to wait before sending the next request, 0 if BTP did not specify it.
+	RetryAfter time.Duration
+}
+
+func (e *ThrottledError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("requests to the BTP %s API are throttled, retry after %s", e.API, e.RetryAfter)
+	}
+	return fmt.Sprintf("requests to the BTP %s API are throttled", e.API)
+}
+
+type limiter struct {
+	tokens *rate.Limiter
+	// lastUsed is the time in unix nanoseconds a client was last created with the limiter.
+	lastUsed atomic.Int64
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+}
+
+func limiterFor(api, key string) *limiter {
+	now := time.Now()
+	if expiringAPIs[api] {
+		deleteIdleLimiters(now)
+	}
+	actual, ok := limiters.Load(api + "\x00" + key)
+	if !ok {
+		limit := rateLimitOf(api)
+		l := &limiter{tokens: rate.NewLimiter(rate.Inf, 1)}
+		if limit.RequestsPerSecond > 0 {
+			l.tokens = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), max(limit.Burst, 1))
+		}
+		actual, _ = limiters.LoadOrStore(api+"\x00"+key, l)
+	}
+	l := actual.(*limiter)
+	l.lastUsed.Store(now.UnixNano())
+	return l
+}
+
+// deleteIdleLimiters drops the limiters of expiringAPIs that were not used for idleLimiterTTL.
+// It does nothing if it already ran within the last idleLimiterTTL.
+func deleteIdleLimiters(now time.Time) {
+	last := lastIdleSweep.Load()
+	if now.Sub(time.Unix(0, last)) < idleLimiterTTL || !lastIdleSweep.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	limiters.Range(func(k, v any) bool {
+		api, _, _ := strings.Cut(k.(string), "\x00")
+		if expiringAPIs[api] && now.Sub(time.Unix(0, v.(*limiter).lastUsed.Load())) >= idleLimiterTTL {
+			limiters.Delete(k)
+		}
+		return true
+	})
+}
+
+// deleteLimiters drops the limiters of all APIs for the given key.
+func deleteLimiters(key string) {
+	limiters.Range(func(k, _ any) bool {
+		if _, limiterKey, _ := strings.Cut(k.(string), "\x00"); limiterKey == key {
+			limiters.Delete(k)
+		}
+		return true
+	})
+}
+
+func (l *limiter) block(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+func (l *limiter) blocked() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return time.Until(l.blockedUntil)
+}
+
+// RoundTripRateLimit waits for the limiter of its API and credential before sending a request through its base RoundTripper.
+// It turns 429 responses into a ThrottledError and holds back further requests of the credential until the Retry-After has passed.
+type RoundTripRateLimit struct {
+	base    http.RoundTripper
+	api     string
+	limiter *limiter
+}
+
+// NewRoundTripRateLimit wraps base, or the default transport if base is nil, in a RoundTripRateLimit
+// that shares its limiter with all other RoundTripRateLimits of the same API and key.
+func NewRoundTripRateLimit(api, key string, base http.RoundTripper) *RoundTripRateLimit {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RoundTripRateLimit{base: base, api: api, limiter: limiterFor(api, key)}
+}
+
+// RoundTrip calls the base RoundTripper once the limiter allows it.
+func (r *RoundTripRateLimit) RoundTrip(req *http.Request) (*http.Response, error) {
+	if wait := r.limiter.blocked(); wait > 0 {
+		closeBody(req)
+		return nil, &ThrottledError{API: r.api, RetryAfter: wait}
+	}
+	if err := r.limiter.tokens.Wait(req.Context()); err != nil {
+		closeBody(req)
+		return nil, err
+	}
+
+	resp, err := r.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+		return resp, err
+	}
+
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	r.limiter.block(retryAfter)
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return nil, &ThrottledError{API: r.api, RetryAfter: retryAfter}
+}
+
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+// It returns 0 if the value is missing, invalid or in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+	return 0
+}
@@ -0,0 +1,126 @@
+package btp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := map[string]struct {
+		value string
+		want  time.Duration
+	}{
+		"Empty":       {value: "", want: 0},
+		"Seconds":     {value: "120", want: 2 * time.Minute},
+		"Negative":    {value: "-5", want: 0},
+		"HTTPDate":    {value: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second},
+		"DateInPast":  {value: now.Add(-time.Hour).Format(http.TimeFormat), want: 0},
+		"Unparseable": {value: "soon", want: 0},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseRetryAfter(tc.value, now))
+		})
+	}
+}
+
+func TestRoundTripRateLimit(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/throttled" {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	get := func(client *http.Client, path string) error {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	client := &http.Client{Transport: NewRoundTripRateLimit(APIAccounts, t.Name(), nil)}
+	require.NoError(t, get(client, "/ok"))
+
+	err := get(client, "/throttled")
+	var throttled *ThrottledError
+	require.True(t, errors.As(err, &throttled), "got %v", err)
+	assert.Equal(t, APIAccounts, throttled.API)
+	assert.Equal(t, time.Minute, throttled.RetryAfter)
+
+	// A new client of the same credential shares the limiter and is held back without sending a request.
+	other := &http.Client{Transport: NewRoundTripRateLimit(APIAccounts, t.Name(), nil)}
+	err = get(other, "/ok")
+	require.True(t, errors.As(err, &throttled), "got %v", err)
+	assert.Greater(t, throttled.RetryAfter, 59*time.Second)
+	assert.Equal(t, 2, requests)
+
+	// Other credentials and other APIs are not affected.
+	require.NoError(t, get(&http.Client{Transport: NewRoundTripRateLimit(APIAccounts, t.Name()+"-other", nil)}, "/ok"))
+	require.NoError(t, get(&http.Client{Transport: NewRoundTripRateLimit(APIEntitlements, t.Name(), nil)}, "/ok"))
+	assert.Equal(t, 4, requests)
+}
+
+func TestRoundTripRateLimitWaitsForToken(t *testing.T) {
+	SetRateLimit(APIProvisioning, RateLimit{RequestsPerSecond: 1, Burst: 1})
+	defer SetRateLimit(APIProvisioning, RateLimit{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	client := &http.Client{Transport: NewRoundTripRateLimit(APIProvisioning, t.Name(), nil)}
+
+	send := func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	require.NoError(t, send(context.Background()))
+
+	// The bucket is empty, so the next request has to wait longer than the deadline allows.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, send(ctx))
+}
+
+func TestRateLimitKeyHidesCredential(t *testing.T) {
+	key := RateLimitKey("client-id", "client-secret", "https://token.url")
+	assert.NotContains(t, key, "client-secret")
+	assert.Equal(t, key, RateLimitKey("client-id", "client-secret", "https://token.url"))
+	assert.NotEqual(t, key, RateLimitKey("client-id", "rotated-secret", "https://token.url"))
+}
+
+func TestDeleteIdleLimiters(t *testing.T) {
+	now := time.Now()
+	idle := limiterFor(APIXsuaa, t.Name()+"-idle")
+	idle.lastUsed.Store(now.Add(-2 * idleLimiterTTL).UnixNano())
+	used := limiterFor(APIXsuaa, t.Name()+"-used")
+	cached := limiterFor(APIAccounts, t.Name())
+	cached.lastUsed.Store(now.Add(-2 * idleLimiterTTL).UnixNano())
+
+	lastIdleSweep.Store(0)
+	deleteIdleLimiters(now)
+
+	_, ok := limiters.Load(APIXsuaa + "\x00" + t.Name() + "-idle")
+	assert.False(t, ok, "idle limiter of an expiring API must be dropped")
+	assert.Same(t, used, limiterFor(APIXsuaa, t.Name()+"-used"))
+	assert.Same(t, cached, limiterFor(APIAccounts, t.Name()), "limiters of cached clients are dropped by EvictClients only")
+}
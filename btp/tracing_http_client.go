@@ -0,0 +1,59 @@
+package btp
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/sap/crossplane-provider-btp/btp"
+
+// RoundTripTracing opens a client span for every request sent through its base RoundTripper,
+// as child of the span in the context of the request. Spans are discarded unless tracing has been set up.
+type RoundTripTracing struct {
+	base http.RoundTripper
+	api  string
+}
+
+// NewRoundTripTracing wraps base, or the default transport if base is nil, in a RoundTripTracing for the given API.
+func NewRoundTripTracing(api string, base http.RoundTripper) *RoundTripTracing {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RoundTripTracing{base: base, api: api}
+}
+
+// RoundTrip calls the base RoundTripper within a span of the request.
+func (r *RoundTripTracing) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracerName).Start(req.Context(), operation(req),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("btp.api", r.api),
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			semconv.URLFull(req.URL.Redacted()),
+		),
+	)
+	defer span.End()
+
+	resp, err := r.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
+
+// instrument wraps base in the RoundTripMetrics, the RoundTripTracing and the RoundTripAudit of the given API.
+func instrument(api string, base http.RoundTripper) http.RoundTripper {
+	return NewRoundTripMetrics(api, NewRoundTripTracing(api, NewRoundTripAudit(api, base)))
+}
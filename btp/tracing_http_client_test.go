@@ -0,0 +1,69 @@
+package btp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func TestTokenRequestIsChildOfAPIRequest(t *testing.T) {
+	SetLogger(logging.NewNopLogger())
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			tokenRequests++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`))
+		default:
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}
+	}))
+	defer server.Close()
+
+	tokens := &tokenCache{config: &clientcredentials.Config{ClientID: "id", ClientSecret: "secret", TokenURL: server.URL + "/oauth/token"}}
+	client := oauthClient(APIAccounts, "", tokens)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "Observe Subaccount")
+	for range 2 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/accounts/v1/subaccounts", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	parent.End()
+
+	assert.Equal(t, 1, tokenRequests, "token must be cached")
+
+	names := map[string]int{}
+	for _, s := range recorder.Ended() {
+		if s.Name() == "Observe Subaccount" {
+			continue
+		}
+		names[s.Name()]++
+		assert.Equal(t, parent.SpanContext().SpanID(), s.Parent().SpanID(), s.Name())
+	}
+	assert.Equal(t, map[string]int{"POST /oauth/token": 1, "GET /accounts/v1/subaccounts": 2}, names)
+}
@@ -0,0 +1,195 @@
+package btpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	kymaclient "github.com/sap/crossplane-provider-btp/internal/clients/kymamodule"
+	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+)
+
+// ErrNotSupported is returned for resources that cannot be read using the credentials of a CIS service binding.
+var ErrNotSupported = errors.New("not supported by the BTP API backend, use the BTP CLI backend instead")
+
+// BtpApi reads BTP resources using the REST API clients of the provider,
+// authenticated with a CIS service binding, as configured in a ProviderConfig.
+//
+// The provisioning and SaaS provisioning APIs are scoped to the subaccount of the CIS service binding,
+// so environment instances and subscriptions of other subaccounts are not returned.
+type BtpApi struct {
+	btp  *btp.Client
+	saas saas_client.SubscriptionOperationsForAppConsumersAPI
+}
+
+var _ btpcli.Client = &BtpApi{}
+
+// NewClient creates a new BTP API client from the CIS secret and the optional service account secret,
+// which have the same format as the secrets referenced by a ProviderConfig.
+func NewClient(ctx context.Context, cisSecret []byte, userSecret []byte) (*BtpApi, error) {
+	if len(userSecret) == 0 {
+		// Not needed for CIS service bindings with client credentials grant type.
+		userSecret = []byte("{}")
+	}
+
+	btpClient, err := btp.NewBTPClient(cisSecret, userSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BtpApi{
+		btp:  btpClient,
+		saas: newSaasClient(ctx, btpClient.Credential.CISCredential),
+	}, nil
+}
+
+func newSaasClient(ctx context.Context, cis *btp.CISCredential) saas_client.SubscriptionOperationsForAppConsumersAPI {
+	if cis.Endpoints.SaasRegistryServiceUrl == "" {
+		return nil
+	}
+
+	config := &clientcredentials.Config{
+		ClientID:     cis.Uaa.Clientid,
+		ClientSecret: cis.Uaa.Clientsecret,
+		TokenURL:     cis.Uaa.Url + "/oauth/token",
+	}
+
+	c := saas_client.NewConfiguration()
+	c.HTTPClient = config.Client(ctx)
+	c.Servers = []saas_client.ServerConfiguration{{URL: cis.Endpoints.SaasRegistryServiceUrl}}
+
+	return saas_client.NewAPIClient(c).SubscriptionOperationsForAppConsumersAPI
+}
+
+// ListSubaccounts retrieves all subaccounts of the global account.
+func (c *BtpApi) ListSubaccounts(ctx context.Context) ([]btpcli.Subaccount, error) {
+	response, _, err := c.btp.AccountsServiceClient.SubaccountOperationsAPI.GetSubaccounts(ctx).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subaccounts: %w", err)
+	}
+
+	subaccounts := make([]btpcli.Subaccount, 0, len(response.Value))
+	for _, sa := range response.Value {
+		subaccounts = append(subaccounts, convertSubaccount(&sa))
+	}
+	return subaccounts, nil
+}
+
+// ListDirectories retrieves all directories of the global account.
+// Directories are returned parent-first, i.e. a directory is always listed after its parent directory.
+func (c *BtpApi) ListDirectories(ctx context.Context) ([]btpcli.Directory, error) {
+	ga, _, err := c.btp.AccountsServiceClient.GlobalAccountOperationsAPI.GetGlobalAccount(ctx).Expand(true).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get global account hierarchy: %w", err)
+	}
+
+	return btpcli.FlattenDirectories(convertDirectories(ga.Children)), nil
+}
+
+// ListServiceAssignments retrieves information about services assigned to a subaccount.
+func (c *BtpApi) ListServiceAssignments(ctx context.Context, subaccountID string) ([]btpcli.AssignedService, error) {
+	response, _, err := c.btp.EntitlementsServiceClient.GetDirectoryAssignments(ctx).SubaccountGUID(subaccountID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entitlements: %w", err)
+	}
+
+	services := make([]btpcli.AssignedService, 0, len(response.AssignedServices))
+	for _, s := range response.AssignedServices {
+		services = append(services, convertAssignedService(&s))
+	}
+	return services, nil
+}
+
+// ListEnvironmentInstances retrieves the environment instances of a subaccount.
+// Only the subaccount of the CIS service binding is accessible.
+func (c *BtpApi) ListEnvironmentInstances(ctx context.Context, subaccountID string) ([]btpcli.EnvironmentInstance, error) {
+	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
+	response, _, err := c.btp.ProvisioningServiceClient.GetEnvironmentInstances(ctx).Authorization("").Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list environment instances: %w", err)
+	}
+
+	var instances []btpcli.EnvironmentInstance
+	for _, e := range response.EnvironmentInstances {
+		if e.GetSubaccountGUID() != subaccountID {
+			continue
+		}
+		instance, err := convertEnvironmentInstance(&e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert environment instance %s: %w", e.GetId(), err)
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+// ListSubscriptions retrieves the subscribed applications of a subaccount.
+// Only the subaccount of the CIS service binding is accessible.
+func (c *BtpApi) ListSubscriptions(ctx context.Context, subaccountID string) ([]btpcli.Subscription, error) {
+	if c.saas == nil {
+		return nil, fmt.Errorf("%w: SaaS registry service URL is missing in CIS secret", ErrNotSupported)
+	}
+
+	response, _, err := c.saas.GetEntitledApplications(ctx).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	var subscriptions []btpcli.Subscription
+	for _, app := range response.Applications {
+		if app.GetSubscribedSubaccountId() != subaccountID {
+			continue
+		}
+		subscriptions = append(subscriptions, convertSubscription(&app))
+	}
+	return subscriptions, nil
+}
+
+// ListServiceInstances is not supported, as the service manager requires credentials of the subaccount.
+func (c *BtpApi) ListServiceInstances(_ context.Context, _ string) ([]btpcli.ServiceInstance, error) {
+	return nil, fmt.Errorf("service instances: %w", ErrNotSupported)
+}
+
+// ListServiceBindings is not supported, as the service manager requires credentials of the subaccount.
+func (c *BtpApi) ListServiceBindings(_ context.Context, _ string) ([]btpcli.ServiceBinding, error) {
+	return nil, fmt.Errorf("service bindings: %w", ErrNotSupported)
+}
+
+// ListServicePlans is not supported, as the service manager requires credentials of the subaccount.
+func (c *BtpApi) ListServicePlans(_ context.Context, _ string) ([]btpcli.ServicePlan, error) {
+	return nil, fmt.Errorf("service plans: %w", ErrNotSupported)
+}
+
+// GetDirectoryRoleCollection is not supported, as the XSUAA API requires credentials of the directory.
+func (c *BtpApi) GetDirectoryRoleCollection(_ context.Context, _ string, _ string) (*btpcli.RoleCollection, error) {
+	return nil, fmt.Errorf("role collections: %w", ErrNotSupported)
+}
+
+// ListSubaccountRoleCollections is not supported, as the XSUAA API requires credentials of the subaccount.
+func (c *BtpApi) ListSubaccountRoleCollections(_ context.Context, _ string) ([]btpcli.RoleCollection, error) {
+	return nil, fmt.Errorf("role collections: %w", ErrNotSupported)
+}
+
+// GetSubaccountRoleCollection is not supported, as the XSUAA API requires credentials of the subaccount.
+func (c *BtpApi) GetSubaccountRoleCollection(_ context.Context, _ string, _ string) (*btpcli.RoleCollection, error) {
+	return nil, fmt.Errorf("role collections: %w", ErrNotSupported)
+}
+
+// ListKymaModules retrieves the modules enabled in the default Kyma CR of the Kyma runtime of an environment instance.
+func (c *BtpApi) ListKymaModules(ctx context.Context, env *btpcli.EnvironmentInstance) ([]kymaclient.Module, error) {
+	return btpcli.ReadKymaModules(ctx, env)
+}
+
+// unmarshalString fills a btp CLI type, that is encoded as a JSON string, e.g. environment parameters.
+func unmarshalString(s string, v json.Unmarshaler) error {
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalJSON(quoted)
+}
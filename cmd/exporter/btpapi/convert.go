@@ -0,0 +1,204 @@
+package btpapi
+
+import (
+	"strconv"
+
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	accountsserviceclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	entitlementsserviceclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+)
+
+// The converters map the API response objects to the types returned by the BTP CLI,
+// so that the resource kinds do not depend on the backend.
+
+func convertSubaccount(sa *accountsserviceclient.SubaccountResponseObject) btpcli.Subaccount {
+	subaccount := btpcli.Subaccount{
+		GUID:              sa.Guid,
+		TechnicalName:     sa.TechnicalName,
+		DisplayName:       sa.DisplayName,
+		GlobalAccountGUID: sa.GlobalAccountGUID,
+		ParentGUID:        sa.ParentGUID,
+		Region:            sa.Region,
+		Subdomain:         sa.Subdomain,
+		BetaEnabled:       sa.BetaEnabled,
+		UsedForProduction: sa.UsedForProduction,
+		Description:       sa.Description,
+		State:             sa.State,
+		StateMessage:      sa.GetStateMessage(),
+		Labels:            sa.GetLabels(),
+		CustomProperties:  convertCustomProperties(sa.CustomProperties),
+		CreatedDate:       formatMillis(sa.CreatedDate),
+		CreatedBy:         sa.GetCreatedBy(),
+	}
+	if sa.ModifiedDate != nil {
+		subaccount.ModifiedDate = formatMillis(*sa.ModifiedDate)
+	}
+	return subaccount
+}
+
+func convertCustomProperties(properties []accountsserviceclient.PropertyResponseObject) []btpcli.CustomProperty {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	converted := make([]btpcli.CustomProperty, 0, len(properties))
+	for _, p := range properties {
+		converted = append(converted, btpcli.CustomProperty{
+			AccountGUID: p.AccountGUID,
+			Key:         p.Key,
+			Value:       p.Value,
+		})
+	}
+	return converted
+}
+
+func convertDirectories(children []accountsserviceclient.DirectoryResponseObject) []btpcli.Directory {
+	if len(children) == 0 {
+		return nil
+	}
+
+	directories := make([]btpcli.Directory, 0, len(children))
+	for _, d := range children {
+		directories = append(directories, btpcli.Directory{
+			GUID:              d.Guid,
+			ParentGUID:        d.ParentGUID,
+			GlobalAccountGUID: d.GlobalAccountGUID,
+			DisplayName:       d.DisplayName,
+			Description:       d.GetDescription(),
+			Subdomain:         d.GetSubdomain(),
+			DirectoryFeatures: d.DirectoryFeatures,
+			EntityState:       d.GetEntityState(),
+			StateMessage:      d.GetStateMessage(),
+			Labels:            d.GetLabels(),
+			CreatedBy:         d.GetCreatedBy(),
+			Children:          convertDirectories(d.Children),
+		})
+	}
+	return directories
+}
+
+func convertAssignedService(s *entitlementsserviceclient.AssignedServiceResponseObject) btpcli.AssignedService {
+	service := btpcli.AssignedService{
+		Name:        s.GetName(),
+		DisplayName: s.GetDisplayName(),
+		IconBase64:  s.GetIconBase64(),
+		OwnerType:   s.GetOwnerType(),
+	}
+
+	for _, p := range s.ServicePlans {
+		plan := btpcli.AssignedServicePlan{
+			Name:             p.GetName(),
+			DisplayName:      p.GetDisplayName(),
+			UniqueIdentifier: p.GetUniqueIdentifier(),
+			Category:         p.GetCategory(),
+			Beta:             p.GetBeta(),
+			Unlimited:        p.GetUnlimited(),
+		}
+		if p.MaxAllowedSubaccountQuota != nil {
+			quota := float64(*p.MaxAllowedSubaccountQuota)
+			plan.MaxAllowedSubaccountQuota = &quota
+		}
+
+		for _, a := range p.AssignmentInfo {
+			plan.AssignmentInfo = append(plan.AssignmentInfo, convertAssignmentInfo(&a))
+		}
+		service.ServicePlans = append(service.ServicePlans, plan)
+	}
+
+	return service
+}
+
+func convertAssignmentInfo(a *entitlementsserviceclient.AssignedServicePlanSubaccountDTO) btpcli.AssignmentInfo {
+	info := btpcli.AssignmentInfo{
+		EntityID:                a.GetEntityId(),
+		EntityType:              a.GetEntityType(),
+		Amount:                  float64(a.GetAmount()),
+		EntityState:             a.GetEntityState(),
+		StateMessage:            a.GetStateMessage(),
+		AutoAssign:              a.GetAutoAssign(),
+		CreatedDate:             int64(a.GetCreatedDate()),
+		ModifiedDate:            int64(a.GetModifiedDate()),
+		UnlimitedAmountAssigned: a.GetUnlimitedAmountAssigned(),
+		ParentID:                a.GetParentId(),
+		ParentType:              a.GetParentType(),
+		ParentAmount:            float64(a.GetParentAmount()),
+		AutoAssigned:            a.GetAutoAssigned(),
+	}
+	if a.RequestedAmount != nil {
+		amount := float64(*a.RequestedAmount)
+		info.RequestedAmount = &amount
+	}
+	if a.AutoDistributeAmount != nil {
+		amount := float64(*a.AutoDistributeAmount)
+		info.AutoDistributeAmount = &amount
+	}
+	if a.ParentRemainingAmount != nil {
+		amount := float64(*a.ParentRemainingAmount)
+		info.ParentRemainingAmount = &amount
+	}
+	return info
+}
+
+func convertEnvironmentInstance(e *provisioningclient.BusinessEnvironmentInstanceResponseObject) (btpcli.EnvironmentInstance, error) {
+	instance := btpcli.EnvironmentInstance{
+		ID:                e.GetId(),
+		Name:              e.GetName(),
+		BrokerID:          e.GetBrokerId(),
+		GlobalAccountGUID: e.GetGlobalAccountGUID(),
+		SubaccountGUID:    e.GetSubaccountGUID(),
+		TenantID:          e.GetTenantId(),
+		ServiceID:         e.GetServiceId(),
+		PlanID:            e.GetPlanId(),
+		Operation:         e.GetOperation(),
+		Type:              e.GetType(),
+		EnvironmentType:   e.GetEnvironmentType(),
+		LandscapeLabel:    e.GetLandscapeLabel(),
+		PlatformID:        e.GetPlatformId(),
+		State:             e.GetState(),
+		StateMessage:      e.GetStateMessage(),
+		ServiceName:       e.GetServiceName(),
+		PlanName:          e.GetPlanName(),
+	}
+	if e.CreatedDate != nil {
+		instance.CreatedDate = formatMillis(int64(*e.CreatedDate))
+	}
+	if e.ModifiedDate != nil {
+		instance.ModifiedDate = formatMillis(int64(*e.ModifiedDate))
+	}
+
+	// Parameters and labels are JSON objects encoded as strings, the same way the BTP CLI returns them.
+	if e.GetParameters() != "" {
+		if err := unmarshalString(e.GetParameters(), &instance.Parameters); err != nil {
+			return instance, err
+		}
+	}
+	if e.GetLabels() != "" {
+		if err := unmarshalString(e.GetLabels(), &instance.Labels); err != nil {
+			return instance, err
+		}
+	}
+
+	return instance, nil
+}
+
+func convertSubscription(app *saas_client.EntitledApplicationsResponseObject) btpcli.Subscription {
+	return btpcli.Subscription{
+		AppID:                  app.GetAppId(),
+		AppName:                app.GetAppName(),
+		PlanName:               app.GetPlanName(),
+		DisplayName:            app.GetDisplayName(),
+		State:                  app.GetState(),
+		SubscriptionGUID:       app.GetSubscriptionGUID(),
+		SubscribedSubaccountID: app.GetSubscribedSubaccountId(),
+		SubscribedTenantID:     app.GetSubscribedTenantId(),
+		GlobalAccountID:        app.GetGlobalAccountId(),
+		TenantID:               app.GetTenantId(),
+		SubscriptionURL:        app.GetSubscriptionUrl(),
+	}
+}
+
+func formatMillis(millis int64) string {
+	return strconv.FormatInt(millis, 10)
+}
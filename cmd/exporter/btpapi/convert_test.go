@@ -0,0 +1,211 @@
+package btpapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	accountsserviceclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	entitlementsserviceclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+func TestConvertSubaccountCustomProperties(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	sa := &accountsserviceclient.SubaccountResponseObject{
+		Guid:        "sa-1",
+		DisplayName: "dev",
+		Labels:      &map[string][]string{"costCenter": {"4711"}, "team": {"a"}},
+		CustomProperties: []accountsserviceclient.PropertyResponseObject{
+			{AccountGUID: "sa-1", Key: "costCenter", Value: "4711"},
+			{AccountGUID: "sa-1", Key: "team", Value: "a"},
+		},
+	}
+
+	got := convertSubaccount(sa)
+	r.Equal([]btpcli.CustomProperty{
+		{AccountGUID: "sa-1", Key: "costCenter", Value: "4711"},
+		{AccountGUID: "sa-1", Key: "team", Value: "a"},
+	}, got.CustomProperties)
+	r.Equal(map[string][]string{"costCenter": {"4711"}, "team": {"a"}}, got.Labels)
+
+	r.Nil(convertSubaccount(&accountsserviceclient.SubaccountResponseObject{Guid: "sa-2"}).CustomProperties)
+}
+
+func TestConvertDirectories(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	gaID := "ga-12345678"
+	description := "parent directory"
+	labels := map[string][]string{"team": {"a"}}
+
+	children := []accountsserviceclient.DirectoryResponseObject{
+		{
+			Guid:              "dir-1",
+			ParentGUID:        gaID,
+			GlobalAccountGUID: gaID,
+			DisplayName:       "parent",
+			Description:       &description,
+			Labels:            &labels,
+			Children: []accountsserviceclient.DirectoryResponseObject{
+				{
+					Guid:              "dir-2",
+					ParentGUID:        "dir-1",
+					GlobalAccountGUID: gaID,
+					DisplayName:       "child",
+				},
+			},
+		},
+	}
+
+	want := []btpcli.Directory{
+		{
+			GUID:              "dir-1",
+			ParentGUID:        gaID,
+			GlobalAccountGUID: gaID,
+			DisplayName:       "parent",
+			Description:       description,
+			Labels:            labels,
+		},
+		{
+			GUID:              "dir-2",
+			ParentGUID:        "dir-1",
+			GlobalAccountGUID: gaID,
+			DisplayName:       "child",
+		},
+	}
+
+	r.Equal(want, btpcli.FlattenDirectories(convertDirectories(children)))
+}
+
+func TestConvertAssignedService(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	name := "postgresql-db"
+	planName := "trial"
+	entityID := "sa-1"
+	entityType := "SUBACCOUNT"
+	var amount float32 = 2
+	var quota int32 = 5
+	var modified float64 = 1700000000000
+
+	service := &entitlementsserviceclient.AssignedServiceResponseObject{
+		Name: &name,
+		ServicePlans: []entitlementsserviceclient.AssignedServicePlanResponseObject{
+			{
+				Name:                      &planName,
+				MaxAllowedSubaccountQuota: &quota,
+				AssignmentInfo: []entitlementsserviceclient.AssignedServicePlanSubaccountDTO{
+					{
+						EntityId:        &entityID,
+						EntityType:      &entityType,
+						Amount:          &amount,
+						RequestedAmount: &amount,
+						ModifiedDate:    &modified,
+					},
+				},
+			},
+		},
+	}
+
+	wantQuota := float64(quota)
+	wantAmount := float64(amount)
+	want := btpcli.AssignedService{
+		Name: name,
+		ServicePlans: []btpcli.AssignedServicePlan{
+			{
+				Name:                      planName,
+				MaxAllowedSubaccountQuota: &wantQuota,
+				AssignmentInfo: []btpcli.AssignmentInfo{
+					{
+						EntityID:        entityID,
+						EntityType:      entityType,
+						Amount:          wantAmount,
+						RequestedAmount: &wantAmount,
+						ModifiedDate:    int64(modified),
+					},
+				},
+			},
+		},
+	}
+
+	r.Equal(want, convertAssignedService(service))
+}
+
+func TestConvertEnvironmentInstance(t *testing.T) {
+	t.Parallel()
+
+	id := "env-1"
+	subaccountID := "sa-1"
+	parameters := `{"instance_name":"my-org","status":"active"}`
+	labels := `{"Org Name":"my-org","Org ID":"org-1"}`
+	invalid := `{`
+
+	tests := []struct {
+		name    string
+		env     *provisioningclient.BusinessEnvironmentInstanceResponseObject
+		want    btpcli.EnvironmentInstance
+		wantErr bool
+	}{
+		{
+			name: "parameters and labels are decoded",
+			env: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+				Id:             &id,
+				SubaccountGUID: &subaccountID,
+				Parameters:     &parameters,
+				Labels:         &labels,
+			},
+			want: btpcli.EnvironmentInstance{
+				ID:             id,
+				SubaccountGUID: subaccountID,
+				Parameters: btpcli.Parameters{
+					InstanceName: "my-org",
+					Status:       "active",
+					Raw:          []byte(parameters),
+				},
+				Labels: btpcli.Labels{
+					OrgName: "my-org",
+					OrgID:   "org-1",
+				},
+			},
+		},
+		{
+			name: "no parameters and labels",
+			env: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+				Id:             &id,
+				SubaccountGUID: &subaccountID,
+			},
+			want: btpcli.EnvironmentInstance{
+				ID:             id,
+				SubaccountGUID: subaccountID,
+			},
+		},
+		{
+			name: "invalid parameters",
+			env: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+				Id:         &id,
+				Parameters: &invalid,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			got, err := convertEnvironmentInstance(tt.env)
+			if tt.wantErr {
+				r.Error(err)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package btpcli
+
+import (
+	"context"
+
+	kymaclient "github.com/sap/crossplane-provider-btp/internal/clients/kymamodule"
+)
+
+// Client provides read access to the BTP resources the exporter supports.
+// BtpCli implements it by running the btp CLI, package btpapi by calling the BTP REST APIs.
+type Client interface {
+	ListSubaccounts(ctx context.Context) ([]Subaccount, error)
+	ListDirectories(ctx context.Context) ([]Directory, error)
+	ListServiceAssignments(ctx context.Context, subaccountID string) ([]AssignedService, error)
+	ListEnvironmentInstances(ctx context.Context, subaccountID string) ([]EnvironmentInstance, error)
+	ListSubscriptions(ctx context.Context, subaccountID string) ([]Subscription, error)
+	ListServiceInstances(ctx context.Context, subaccountID string) ([]ServiceInstance, error)
+	ListServiceBindings(ctx context.Context, subaccountID string) ([]ServiceBinding, error)
+	ListServicePlans(ctx context.Context, subaccountID string) ([]ServicePlan, error)
+	GetDirectoryRoleCollection(ctx context.Context, directoryID string, name string) (*RoleCollection, error)
+	ListSubaccountRoleCollections(ctx context.Context, subaccountID string) ([]RoleCollection, error)
+	GetSubaccountRoleCollection(ctx context.Context, subaccountID string, name string) (*RoleCollection, error)
+	ListKymaModules(ctx context.Context, env *EnvironmentInstance) ([]kymaclient.Module, error)
+}
+
+var _ Client = &BtpCli{}
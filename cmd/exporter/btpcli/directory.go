@@ -0,0 +1,63 @@
+package btpcli
+
+import "context"
+
+// GetGlobalAccountHierarchy retrieves the global account together with its complete hierarchy of directories.
+func (c *BtpCli) GetGlobalAccountHierarchy(ctx context.Context) (*GlobalAccount, error) {
+	var response GlobalAccount
+
+	err := c.ExecuteJSON(ctx, &response, "get", "accounts/global-account", "--show-hierarchy")
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ListDirectories retrieves all directories of the current global account.
+// Directories are returned parent-first, i.e. a directory is always listed after its parent directory.
+func (c *BtpCli) ListDirectories(ctx context.Context) ([]Directory, error) {
+	ga, err := c.GetGlobalAccountHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FlattenDirectories(ga.Children), nil
+}
+
+// FlattenDirectories walks the directory tree depth-first and returns the directories in pre-order.
+func FlattenDirectories(children []Directory) []Directory {
+	var result []Directory
+	for _, d := range children {
+		nested := d.Children
+		d.Children = nil
+		result = append(result, d)
+		result = append(result, FlattenDirectories(nested)...)
+	}
+	return result
+}
+
+type GlobalAccount struct {
+	GUID         string      `json:"guid,omitempty"`
+	DisplayName  string      `json:"displayName,omitempty"`
+	Subdomain    string      `json:"subdomain,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	EntityState  string      `json:"entityState,omitempty"`
+	StateMessage string      `json:"stateMessage,omitempty"`
+	Children     []Directory `json:"children,omitempty"`
+}
+
+type Directory struct {
+	GUID              string              `json:"guid,omitempty"`
+	ParentGUID        string              `json:"parentGUID,omitempty"`
+	GlobalAccountGUID string              `json:"globalAccountGUID,omitempty"`
+	DisplayName       string              `json:"displayName,omitempty"`
+	Description       string              `json:"description,omitempty"`
+	Subdomain         string              `json:"subdomain,omitempty"`
+	DirectoryFeatures []string            `json:"directoryFeatures,omitempty"`
+	EntityState       string              `json:"entityState,omitempty"`
+	StateMessage      string              `json:"stateMessage,omitempty"`
+	Labels            map[string][]string `json:"labels,omitempty"`
+	CreatedBy         string              `json:"createdBy,omitempty"`
+	Children          []Directory         `json:"children,omitempty"`
+}
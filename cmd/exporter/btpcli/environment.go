@@ -50,6 +50,9 @@ type EnvironmentInstance struct {
 type Parameters struct {
 	InstanceName string `json:"instance_name,omitempty"`
 	Status       string `json:"status,omitempty"`
+
+	// Raw holds the complete parameters JSON object as returned by BTP CLI.
+	Raw []byte `json:"-"`
 }
 
 func (p *Parameters) UnmarshalJSON(data []byte) error {
@@ -57,15 +60,30 @@ func (p *Parameters) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
+	p.Raw = []byte(s)
 	type params Parameters
 	return json.Unmarshal([]byte(s), (*params)(p))
 }
 
+// MarshalJSON encodes the parameters as a JSON string, the same way the BTP CLI returns them.
+func (p Parameters) MarshalJSON() ([]byte, error) {
+	raw := p.Raw
+	if raw == nil {
+		type params Parameters
+		var err error
+		if raw, err = json.Marshal(params(p)); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(string(raw))
+}
+
 type Labels struct {
 	APIEndpoint    string `json:"API Endpoint,omitempty"`
 	OrgName        string `json:"Org Name,omitempty"`
 	OrgID          string `json:"Org ID,omitempty"`
 	OrgMemoryLimit string `json:"Org Memory Limit,omitempty"`
+	KubeconfigURL  string `json:"KubeconfigURL,omitempty"`
 }
 
 func (l *Labels) UnmarshalJSON(data []byte) error {
@@ -76,3 +94,13 @@ func (l *Labels) UnmarshalJSON(data []byte) error {
 	type labels Labels
 	return json.Unmarshal([]byte(s), (*labels)(l))
 }
+
+// MarshalJSON encodes the labels as a JSON string, the same way the BTP CLI returns them.
+func (l Labels) MarshalJSON() ([]byte, error) {
+	type labels Labels
+	raw, err := json.Marshal(labels(l))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(raw))
+}
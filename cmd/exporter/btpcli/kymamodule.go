@@ -0,0 +1,57 @@
+package btpcli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	kymaclient "github.com/sap/crossplane-provider-btp/internal/clients/kymamodule"
+)
+
+// ListKymaModules retrieves the modules enabled in the default Kyma CR of the Kyma runtime of an environment instance
+func (c *BtpCli) ListKymaModules(ctx context.Context, env *EnvironmentInstance) ([]kymaclient.Module, error) {
+	return ReadKymaModules(ctx, env)
+}
+
+// ReadKymaModules reads the modules from the default Kyma CR of the Kyma runtime,
+// using the kubeconfig published in the labels of the Kyma environment instance.
+// The Kyma runtime is accessed directly, independent of the backend used to read BTP resources.
+func ReadKymaModules(ctx context.Context, env *EnvironmentInstance) ([]kymaclient.Module, error) {
+	kubeconfig, err := readKubeconfig(ctx, env.Labels.KubeconfigURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kymaclient.NewKymaModuleClient(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ListModules(ctx)
+}
+
+func readKubeconfig(ctx context.Context, url string) ([]byte, error) {
+	if url == "" {
+		return nil, fmt.Errorf("kubeconfig URL is missing in Kyma environment labels")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't load kubeconfig file: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't load kubeconfig file: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("couldn't load kubeconfig file: unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,107 @@
+package btpcli
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+
+	kymaclient "github.com/sap/crossplane-provider-btp/internal/clients/kymamodule"
+)
+
+// RateLimitedClient delays the calls of the wrapped client, so that it does not exceed a number of requests per second.
+type RateLimitedClient struct {
+	client  Client
+	limiter *rate.Limiter
+}
+
+var _ Client = &RateLimitedClient{}
+
+// NewRateLimitedClient wraps the client with a rate limit of requests per second, allowing bursts of one request per worker.
+func NewRateLimitedClient(client Client, requestsPerSecond float64, burst int) *RateLimitedClient {
+	return &RateLimitedClient{
+		client:  client,
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1)),
+	}
+}
+
+func (c *RateLimitedClient) ListSubaccounts(ctx context.Context) ([]Subaccount, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListSubaccounts(ctx)
+}
+
+func (c *RateLimitedClient) ListDirectories(ctx context.Context) ([]Directory, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListDirectories(ctx)
+}
+
+func (c *RateLimitedClient) ListServiceAssignments(ctx context.Context, subaccountID string) ([]AssignedService, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListServiceAssignments(ctx, subaccountID)
+}
+
+func (c *RateLimitedClient) ListEnvironmentInstances(ctx context.Context, subaccountID string) ([]EnvironmentInstance, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListEnvironmentInstances(ctx, subaccountID)
+}
+
+func (c *RateLimitedClient) ListSubscriptions(ctx context.Context, subaccountID string) ([]Subscription, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListSubscriptions(ctx, subaccountID)
+}
+
+func (c *RateLimitedClient) ListServiceInstances(ctx context.Context, subaccountID string) ([]ServiceInstance, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListServiceInstances(ctx, subaccountID)
+}
+
+func (c *RateLimitedClient) ListServiceBindings(ctx context.Context, subaccountID string) ([]ServiceBinding, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListServiceBindings(ctx, subaccountID)
+}
+
+func (c *RateLimitedClient) ListServicePlans(ctx context.Context, subaccountID string) ([]ServicePlan, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListServicePlans(ctx, subaccountID)
+}
+
+func (c *RateLimitedClient) GetDirectoryRoleCollection(ctx context.Context, directoryID string, name string) (*RoleCollection, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetDirectoryRoleCollection(ctx, directoryID, name)
+}
+
+func (c *RateLimitedClient) ListSubaccountRoleCollections(ctx context.Context, subaccountID string) ([]RoleCollection, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListSubaccountRoleCollections(ctx, subaccountID)
+}
+
+func (c *RateLimitedClient) GetSubaccountRoleCollection(ctx context.Context, subaccountID string, name string) (*RoleCollection, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetSubaccountRoleCollection(ctx, subaccountID, name)
+}
+
+// ListKymaModules is not rate limited, because it calls the Kyma runtime instead of BTP.
+func (c *RateLimitedClient) ListKymaModules(ctx context.Context, env *EnvironmentInstance) ([]kymaclient.Module, error) {
+	return c.client.ListKymaModules(ctx, env)
+}
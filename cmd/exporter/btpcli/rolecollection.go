@@ -0,0 +1,73 @@
+package btpcli
+
+import "context"
+
+// GetDirectoryRoleCollection retrieves a role collection of a directory, including its user and group assignments.
+func (c *BtpCli) GetDirectoryRoleCollection(ctx context.Context, directoryID string, name string) (*RoleCollection, error) {
+	var result RoleCollection
+
+	err := c.ExecuteJSON(ctx, &result, "get", "security/role-collection", name, "--directory", directoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListSubaccountRoleCollections retrieves all role collections of a subaccount, without their assignments.
+func (c *BtpCli) ListSubaccountRoleCollections(ctx context.Context, subaccountID string) ([]RoleCollection, error) {
+	var result []RoleCollection
+
+	err := c.ExecuteJSON(ctx, &result, "list", "security/role-collection", "--subaccount", subaccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetSubaccountRoleCollection retrieves a role collection of a subaccount, including its user and group assignments.
+func (c *BtpCli) GetSubaccountRoleCollection(ctx context.Context, subaccountID string, name string) (*RoleCollection, error) {
+	var result RoleCollection
+
+	err := c.ExecuteJSON(ctx, &result, "get", "security/role-collection", name, "--subaccount", subaccountID, "--show-user-assignments")
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+type RoleCollection struct {
+	Name            string           `json:"name,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	IsReadOnly      bool             `json:"isReadOnly,omitempty"`
+	RoleReferences  []RoleReference  `json:"roleReferences,omitempty"`
+	UserReferences  []UserReference  `json:"userReferences,omitempty"`
+	GroupReferences []GroupReference `json:"groupReferences,omitempty"`
+}
+
+type RoleReference struct {
+	RoleTemplateAppID string `json:"roleTemplateAppId,omitempty"`
+	RoleTemplateName  string `json:"roleTemplateName,omitempty"`
+	Name              string `json:"name,omitempty"`
+	Description       string `json:"description,omitempty"`
+}
+
+type UserReference struct {
+	ID         string `json:"id,omitempty"`
+	Username   string `json:"username,omitempty"`
+	Email      string `json:"email,omitempty"`
+	Origin     string `json:"origin,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// GroupReference maps an attribute of users of an identity provider to the role collection.
+// Group assignments use the attribute name "Groups", with the group name as attribute value.
+type GroupReference struct {
+	Origin             string `json:"origin,omitempty"`
+	AttributeName      string `json:"attributeName,omitempty"`
+	AttributeValue     string `json:"attributeValue,omitempty"`
+	ComparisonOperator string `json:"comparisonOperator,omitempty"`
+}
@@ -33,7 +33,14 @@ type Subaccount struct {
 	State             string              `json:"state,omitempty"`
 	StateMessage      string              `json:"stateMessage,omitempty"`
 	Labels            map[string][]string `json:"labels,omitempty"`
+	CustomProperties  []CustomProperty    `json:"customProperties,omitempty"`
 	CreatedDate       string              `json:"createdDate,omitempty"`
 	CreatedBy         string              `json:"createdBy,omitempty"`
 	ModifiedDate      string              `json:"modifiedDate,omitempty"`
 }
+
+type CustomProperty struct {
+	AccountGUID string `json:"accountGUID,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Value       string `json:"value,omitempty"`
+}
@@ -0,0 +1,42 @@
+package btpcli
+
+import (
+	"context"
+)
+
+const (
+	SubscriptionStateSubscribed    = "SUBSCRIBED"
+	SubscriptionStateNotSubscribed = "NOT_SUBSCRIBED"
+)
+
+// ListSubscriptions retrieves all applications, which the subaccount is entitled to, together with their subscription state.
+func (c *BtpCli) ListSubscriptions(ctx context.Context, subaccountID string) ([]Subscription, error) {
+	var result ListSubscriptionsResponse
+
+	err := c.ExecuteJSON(ctx, &result, "list", "accounts/subscription", "--subaccount", subaccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Applications, nil
+}
+
+type ListSubscriptionsResponse struct {
+	Applications []Subscription `json:"applications,omitempty"`
+}
+
+type Subscription struct {
+	AppID                  string `json:"appId,omitempty"`
+	AppName                string `json:"appName,omitempty"`
+	PlanName               string `json:"planName,omitempty"`
+	DisplayName            string `json:"displayName,omitempty"`
+	State                  string `json:"state,omitempty"`
+	SubscriptionGUID       string `json:"subscriptionGUID,omitempty"`
+	SubscribedSubaccountID string `json:"subscribedSubaccountId,omitempty"`
+	SubscribedTenantID     string `json:"subscribedTenantId,omitempty"`
+	GlobalAccountID        string `json:"globalAccountId,omitempty"`
+	TenantID               string `json:"tenantId,omitempty"`
+	SubscriptionURL        string `json:"subscriptionUrl,omitempty"`
+	CreatedDate            string `json:"createdDate,omitempty"`
+	ModifiedDate           string `json:"modifiedDate,omitempty"`
+}
@@ -0,0 +1,38 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListClusterObjects reads all objects of the given kinds from the cluster.
+// Without an explicit kubeconfig path, $KUBECONFIG or ~/.kube/config is used, like kubectl does.
+func ListClusterObjects(ctx context.Context, kubeconfig string, gvks []schema.GroupVersionKind) ([]unstructured.Unstructured, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubeconfig
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("cannot load kubeconfig: %w", err)
+	}
+
+	kube, err := client.New(cfg, client.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create kubernetes client: %w", err)
+	}
+
+	var objs []unstructured.Unstructured
+	for _, gvk := range gvks {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := kube.List(ctx, list); err != nil {
+			return nil, fmt.Errorf("cannot list %s: %w", gvk.Kind, err)
+		}
+		objs = append(objs, list.Items...)
+	}
+	return objs, nil
+}
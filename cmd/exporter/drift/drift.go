@@ -0,0 +1,251 @@
+// Package drift compares exported BTP resources with the managed resources that already exist in a cluster.
+package drift
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/yaml"
+)
+
+// Collector passes all events to the wrapped event handler and keeps the exported resources for comparison.
+type Collector struct {
+	export.EventHandler
+	mu        sync.Mutex
+	resources []*unstructured.Unstructured
+}
+
+var _ export.EventHandler = &Collector{}
+
+// NewCollector creates a Collector wrapping the event handler.
+func NewCollector(eventHandler export.EventHandler) *Collector {
+	return &Collector{EventHandler: eventHandler}
+}
+
+// Resource keeps the resource and passes it to the wrapped event handler.
+func (c *Collector) Resource(res resource.Object) {
+	obj := res
+	if rwc, ok := res.(*yaml.ResourceWithComment); ok {
+		obj = rwc.Resource()
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		c.Warn(fmt.Errorf("cannot compare resource %s: %w", obj.GetName(), err))
+	} else {
+		c.mu.Lock()
+		c.resources = append(c.resources, &unstructured.Unstructured{Object: u})
+		c.mu.Unlock()
+	}
+
+	c.EventHandler.Resource(res)
+}
+
+// Resources returns the exported resources.
+func (c *Collector) Resources() []*unstructured.Unstructured {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.resources)
+}
+
+// GroupVersionKinds returns the kinds of the exported resources, sorted by name.
+func (c *Collector) GroupVersionKinds() []schema.GroupVersionKind {
+	return groupVersionKinds(c.Resources())
+}
+
+func groupVersionKinds(objs []*unstructured.Unstructured) []schema.GroupVersionKind {
+	var gvks []schema.GroupVersionKind
+	for _, o := range objs {
+		if gvk := o.GroupVersionKind(); !slices.Contains(gvks, gvk) {
+			gvks = append(gvks, gvk)
+		}
+	}
+	sort.Slice(gvks, func(i, j int) bool {
+		return gvks[i].String() < gvks[j].String()
+	})
+	return gvks
+}
+
+// Report lists the differences between BTP and the cluster for each exported kind.
+type Report struct {
+	Kinds []KindReport `json:"kinds"`
+}
+
+// KindReport lists the differences of a single kind.
+type KindReport struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Unmanaged BTP objects have no managed resource with the same external name in the cluster.
+	Unmanaged []Object `json:"unmanaged,omitempty"`
+	// Orphaned managed resources have an external name, that was not exported from BTP.
+	Orphaned []Object `json:"orphaned,omitempty"`
+	// Changed objects differ between BTP and the cluster in at least one field of spec.forProvider.
+	Changed []Change `json:"changed,omitempty"`
+	// NotCompared BTP objects have no external name, e.g. entitlements.
+	NotCompared []string `json:"notCompared,omitempty"`
+}
+
+// Object identifies a BTP object or a managed resource.
+type Object struct {
+	Name         string `json:"name"`
+	ExternalName string `json:"externalName"`
+}
+
+// Change lists the field differences of a BTP object and the matching managed resource.
+type Change struct {
+	// Name of the managed resource in the cluster.
+	Name         string  `json:"name"`
+	ExternalName string  `json:"externalName"`
+	Fields       []Field `json:"fields"`
+}
+
+// Field is a single field difference.
+type Field struct {
+	Path    string `json:"path"`
+	BTP     any    `json:"btp"`
+	Cluster any    `json:"cluster"`
+}
+
+// Compare matches exported resources with the managed resources in the cluster by their external name.
+// Cluster objects must be of the kinds of the exported resources.
+func Compare(exported []*unstructured.Unstructured, cluster []unstructured.Unstructured) Report {
+	report := Report{}
+	for _, gvk := range groupVersionKinds(exported) {
+		kr := KindReport{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+		}
+
+		// Index cluster objects by external name.
+		inCluster := map[string]*unstructured.Unstructured{}
+		for i := range cluster {
+			o := &cluster[i]
+			if o.GroupVersionKind() != gvk {
+				continue
+			}
+			if en := meta.GetExternalName(o); en != "" {
+				inCluster[en] = o
+			}
+		}
+
+		inBTP := map[string]bool{}
+		for _, e := range exported {
+			if e.GroupVersionKind() != gvk {
+				continue
+			}
+			en := meta.GetExternalName(e)
+			if en == "" {
+				kr.NotCompared = append(kr.NotCompared, e.GetName())
+				continue
+			}
+			inBTP[en] = true
+
+			c, found := inCluster[en]
+			if !found {
+				kr.Unmanaged = append(kr.Unmanaged, Object{Name: e.GetName(), ExternalName: en})
+				continue
+			}
+			if fields := compareForProvider(e, c); len(fields) > 0 {
+				kr.Changed = append(kr.Changed, Change{Name: c.GetName(), ExternalName: en, Fields: fields})
+			}
+		}
+
+		for en, c := range inCluster {
+			if !inBTP[en] {
+				kr.Orphaned = append(kr.Orphaned, Object{Name: c.GetName(), ExternalName: en})
+			}
+		}
+		sort.Slice(kr.Orphaned, func(i, j int) bool {
+			return kr.Orphaned[i].Name < kr.Orphaned[j].Name
+		})
+
+		report.Kinds = append(report.Kinds, kr)
+	}
+	return report
+}
+
+// compareForProvider compares the fields of spec.forProvider, that are set in the exported resource.
+// Fields that are only set in the cluster are ignored, as they are usually defaults or late-initialized.
+// References and selectors are ignored, as they are resolved differently in the export and in the cluster.
+func compareForProvider(exported, cluster *unstructured.Unstructured) []Field {
+	want, _, _ := unstructured.NestedMap(exported.Object, "spec", "forProvider")
+	got, _, _ := unstructured.NestedMap(cluster.Object, "spec", "forProvider")
+
+	var fields []Field
+	compareMaps("spec.forProvider", want, got, &fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+	return fields
+}
+
+func compareMaps(path string, want, got map[string]any, fields *[]Field) {
+	for k, w := range want {
+		if isReference(k) || w == nil {
+			continue
+		}
+		p := path + "." + k
+		g := got[k]
+
+		wm, wIsMap := w.(map[string]any)
+		gm, gIsMap := g.(map[string]any)
+		if wIsMap && (gIsMap || g == nil) {
+			compareMaps(p, wm, gm, fields)
+			continue
+		}
+
+		if !equal(w, g) {
+			*fields = append(*fields, Field{Path: p, BTP: w, Cluster: g})
+		}
+	}
+}
+
+func isReference(field string) bool {
+	return strings.HasSuffix(field, "Ref") || strings.HasSuffix(field, "Refs") || strings.HasSuffix(field, "Selector")
+}
+
+// equal compares two unstructured values, treating integer and floating point numbers of the same value as equal.
+func equal(a, b any) bool {
+	if af, ok := toFloat(a); ok {
+		bf, ok := toFloat(b)
+		return ok && af == bf
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// WriteReport writes the report as YAML.
+func WriteReport(w io.Writer, report Report) error {
+	y, err := sigsyaml.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("cannot marshal drift report: %w", err)
+	}
+	_, err = w.Write(y)
+	return err
+}
@@ -0,0 +1,128 @@
+package drift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newObject(kind, name, externalName string, forProvider map[string]any) unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "account.btp.sap.crossplane.io/v1alpha1",
+		"kind":       kind,
+		"metadata": map[string]any{
+			"name": name,
+		},
+		"spec": map[string]any{
+			"forProvider": forProvider,
+		},
+	}}
+	if externalName != "" {
+		o.SetAnnotations(map[string]string{"crossplane.io/external-name": externalName})
+	}
+	return o
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	subaccount := func(name, externalName, description string) unstructured.Unstructured {
+		return newObject("Subaccount", name, externalName, map[string]any{
+			"displayName":  name,
+			"description":  description,
+			"directoryRef": map[string]any{"name": "dir"},
+			"labels":       map[string]any{"team": []any{"a"}},
+		})
+	}
+
+	tests := []struct {
+		name     string
+		exported []unstructured.Unstructured
+		cluster  []unstructured.Unstructured
+		want     Report
+	}{
+		{
+			name: "in sync",
+			exported: []unstructured.Unstructured{
+				subaccount("dev", "sa-1", "development"),
+			},
+			cluster: []unstructured.Unstructured{
+				subaccount("dev", "sa-1", "development"),
+			},
+			want: Report{Kinds: []KindReport{
+				{APIVersion: "account.btp.sap.crossplane.io/v1alpha1", Kind: "Subaccount"},
+			}},
+		},
+		{
+			name: "unmanaged, orphaned and changed",
+			exported: []unstructured.Unstructured{
+				subaccount("dev", "sa-1", "development"),
+				subaccount("prod", "sa-2", "production"),
+			},
+			cluster: []unstructured.Unstructured{
+				subaccount("dev-mr", "sa-1", "old description"),
+				subaccount("test", "sa-3", "test"),
+			},
+			want: Report{Kinds: []KindReport{
+				{
+					APIVersion: "account.btp.sap.crossplane.io/v1alpha1",
+					Kind:       "Subaccount",
+					Unmanaged:  []Object{{Name: "prod", ExternalName: "sa-2"}},
+					Orphaned:   []Object{{Name: "test", ExternalName: "sa-3"}},
+					Changed: []Change{
+						{
+							Name:         "dev-mr",
+							ExternalName: "sa-1",
+							Fields: []Field{
+								{Path: "spec.forProvider.description", BTP: "development", Cluster: "old description"},
+								{Path: "spec.forProvider.displayName", BTP: "dev", Cluster: "dev-mr"},
+							},
+						},
+					},
+				},
+			}},
+		},
+		{
+			name: "fields only set in cluster and numbers of different types are equal",
+			exported: []unstructured.Unstructured{
+				newObject("Entitlement", "cis.local", "ent-1", map[string]any{"amount": int64(1)}),
+			},
+			cluster: []unstructured.Unstructured{
+				newObject("Entitlement", "cis.local", "ent-1", map[string]any{"amount": float64(1), "enable": true}),
+			},
+			want: Report{Kinds: []KindReport{
+				{APIVersion: "account.btp.sap.crossplane.io/v1alpha1", Kind: "Entitlement"},
+			}},
+		},
+		{
+			name: "objects without external name are not compared",
+			exported: []unstructured.Unstructured{
+				newObject("Entitlement", "cis.local", "", map[string]any{"amount": int64(1)}),
+			},
+			cluster: []unstructured.Unstructured{
+				newObject("Subaccount", "other-kind", "sa-1", nil),
+			},
+			want: Report{Kinds: []KindReport{
+				{
+					APIVersion:  "account.btp.sap.crossplane.io/v1alpha1",
+					Kind:        "Entitlement",
+					NotCompared: []string{"cis.local"},
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var exported []*unstructured.Unstructured
+			for i := range tt.exported {
+				exported = append(exported, &tt.exported[i])
+			}
+
+			require.Equal(t, tt.want, Compare(exported, tt.cluster))
+		})
+	}
+}
@@ -0,0 +1,365 @@
+// Package gitops writes exported resources as a directory tree with kustomizations,
+// that can be committed to a GitOps repository as is.
+//
+// The layout is <directory>/<subaccount>/<kind>/<name>.yaml. Resources that do not belong to a subaccount,
+// e.g. directories, are written to the global folder.
+package gitops
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+const (
+	// GlobalFolder contains the resources that do not belong to a subaccount.
+	GlobalFolder = "global"
+
+	kustomizationFile  = "kustomization.yaml"
+	providerConfigFile = "providerconfig.yaml"
+)
+
+// Writer keeps the exported resources instead of passing them to the wrapped event handler,
+// warnings are passed through.
+type Writer struct {
+	export.EventHandler
+	mu        sync.Mutex
+	resources []resource.Object
+}
+
+var _ export.EventHandler = &Writer{}
+
+// NewWriter creates a Writer wrapping the event handler.
+func NewWriter(eventHandler export.EventHandler) *Writer {
+	return &Writer{EventHandler: eventHandler}
+}
+
+// Resource keeps the resource until the tree is written.
+func (w *Writer) Resource(res resource.Object) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.resources = append(w.resources, res)
+}
+
+type file struct {
+	res       resource.Object
+	obj       *unstructured.Unstructured
+	folder    string
+	commented bool
+}
+
+// Write writes all kept resources to the directory tree, with a kustomization in each folder
+// and a ProviderConfig skeleton in the root folder.
+// Commented out resources are written, but not listed in the kustomizations.
+func (w *Writer) Write(dir string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	files := make([]*file, 0, len(w.resources))
+	for _, res := range w.resources {
+		f, err := newFile(res)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+	}
+	assignFolders(files)
+
+	// Write resources and collect the folder structure for the kustomizations.
+	tree := map[string]map[string][]string{}
+	for _, f := range files {
+		kindDir := strings.ToLower(f.obj.GetKind())
+		name, err := writeResource(filepath.Join(dir, f.folder, kindDir), f)
+		if err != nil {
+			return err
+		}
+
+		if tree[f.folder] == nil {
+			tree[f.folder] = map[string][]string{}
+		}
+		kinds := tree[f.folder]
+		if _, ok := kinds[kindDir]; !ok {
+			kinds[kindDir] = []string{}
+		}
+		if !f.commented {
+			kinds[kindDir] = append(kinds[kindDir], name)
+		}
+	}
+
+	// Write kustomizations bottom-up.
+	var folders []string
+	for folder, kinds := range tree {
+		var kindDirs []string
+		for kindDir, names := range kinds {
+			if err := writeKustomization(filepath.Join(dir, folder, kindDir), names); err != nil {
+				return err
+			}
+			kindDirs = append(kindDirs, kindDir)
+		}
+		if err := writeKustomization(filepath.Join(dir, folder), kindDirs); err != nil {
+			return err
+		}
+		folders = append(folders, folder)
+	}
+
+	if err := writeProviderConfig(dir); err != nil {
+		return err
+	}
+	return writeKustomization(dir, append(folders, providerConfigFile))
+}
+
+func newFile(res resource.Object) (*file, error) {
+	obj := res
+	commented := false
+	if rwc, ok := res.(*yaml.ResourceWithComment); ok {
+		obj = rwc.Resource()
+		_, commented = rwc.Comment()
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert resource %s: %w", obj.GetName(), err)
+	}
+
+	return &file{
+		res:       res,
+		obj:       &unstructured.Unstructured{Object: u},
+		commented: commented,
+	}, nil
+}
+
+// assignFolders determines the subaccount of each resource, either from its subaccount fields,
+// or from the resources it references, e.g. a service binding belongs to the subaccount of its service instance.
+func assignFolders(files []*file) {
+	// Subaccount resource names by subaccount ID.
+	subaccounts := map[string]string{}
+	for _, f := range files {
+		if isSubaccount(f.obj) {
+			subaccounts[meta.GetExternalName(f.obj)] = f.obj.GetName()
+		}
+	}
+
+	folderByName := map[string]string{}
+	for _, f := range files {
+		f.folder = subaccountFolder(f.obj, subaccounts)
+		if f.folder != "" {
+			folderByName[f.obj.GetName()] = f.folder
+		}
+	}
+
+	// Follow references until no more folders can be assigned.
+	for changed := true; changed; {
+		changed = false
+		for _, f := range files {
+			if f.folder != "" {
+				continue
+			}
+			for _, ref := range referencedNames(f.obj.Object["spec"]) {
+				if folder, ok := folderByName[ref]; ok {
+					f.folder = folder
+					folderByName[f.obj.GetName()] = folder
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	for _, f := range files {
+		if f.folder == "" {
+			f.folder = GlobalFolder
+		}
+	}
+}
+
+func isSubaccount(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().GroupKind() == v1alpha1.SubaccountGroupVersionKind.GroupKind()
+}
+
+func subaccountFolder(obj *unstructured.Unstructured, subaccounts map[string]string) string {
+	if isSubaccount(obj) {
+		return folderName(obj.GetName())
+	}
+
+	for _, path := range [][]string{{"spec", "forProvider"}, {"spec"}} {
+		if name, found, _ := unstructured.NestedString(obj.Object, append(path, "subaccountRef", "name")...); found && name != "" {
+			return folderName(name)
+		}
+		for _, field := range []string{"subaccountId", "subaccountGuid"} {
+			if id, found, _ := unstructured.NestedString(obj.Object, append(path, field)...); found && id != "" {
+				if name, ok := subaccounts[id]; ok {
+					return folderName(name)
+				}
+				return folderName(id)
+			}
+		}
+	}
+	return ""
+}
+
+// folderName maps undefined names of commented out resources to a valid folder name.
+func folderName(name string) string {
+	if name == resources.UndefinedName {
+		return GlobalFolder
+	}
+	return name
+}
+
+// referencedNames returns the names of all references in the value, e.g. spec.forProvider.serviceInstanceRef.name.
+func referencedNames(v any) []string {
+	var names []string
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			if ref, ok := child.(map[string]any); ok && strings.HasSuffix(k, "Ref") {
+				if name, ok := ref["name"].(string); ok && name != "" {
+					names = append(names, name)
+					continue
+				}
+			}
+			names = append(names, referencedNames(child)...)
+		}
+	case []any:
+		for _, child := range t {
+			names = append(names, referencedNames(child)...)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func writeResource(dir string, f *file) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("cannot create directory: %w", err)
+	}
+
+	// Commented out resources keep the comments of the YAML stream output.
+	var content string
+	if f.commented {
+		y, err := yaml.Marshal(f.res)
+		if err != nil {
+			return "", fmt.Errorf("cannot marshal resource %s: %w", f.obj.GetName(), err)
+		}
+		content = y
+	} else {
+		y, err := sigsyaml.Marshal(f.obj.Object)
+		if err != nil {
+			return "", fmt.Errorf("cannot marshal resource %s: %w", f.obj.GetName(), err)
+		}
+		content = string(y)
+	}
+
+	// Names are unique per kind, except for undefined names of commented out resources.
+	name := f.obj.GetName() + ".yaml"
+	for i := 2; fileExists(filepath.Join(dir, name)); i++ {
+		name = fmt.Sprintf("%s-%d.yaml", f.obj.GetName(), i)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("cannot write resource %s: %w", f.obj.GetName(), err)
+	}
+	return name, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+type kustomization struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Resources  []string `json:"resources"`
+}
+
+func writeKustomization(dir string, entries []string) error {
+	sorted := slices.Clone(entries)
+	slices.Sort(sorted)
+	if sorted == nil {
+		sorted = []string{}
+	}
+
+	y, err := sigsyaml.Marshal(kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+		Resources:  sorted,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot marshal kustomization: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, kustomizationFile), y, 0o644); err != nil {
+		return fmt.Errorf("cannot write kustomization: %w", err)
+	}
+	return nil
+}
+
+// writeProviderConfig writes the default ProviderConfig, that the exported resources use.
+// The referenced secrets have to be created separately.
+func writeProviderConfig(dir string) error {
+	pc := &apisv1alpha1.ProviderConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       apisv1alpha1.ProviderConfigKind,
+			APIVersion: apisv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			CISSecret: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{
+							Name:      "cis-provider-secret",
+							Namespace: resources.DefaultSecretNamespace,
+						},
+						Key: "data",
+					},
+				},
+			},
+			ServiceAccountSecret: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{
+							Name:      "sa-provider-secret",
+							Namespace: resources.DefaultSecretNamespace,
+						},
+						Key: "credentials",
+					},
+				},
+			},
+		},
+	}
+
+	y, err := sigsyaml.Marshal(pc)
+	if err != nil {
+		return fmt.Errorf("cannot marshal provider config: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("cannot create directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, providerConfigFile), y, 0o644); err != nil {
+		return fmt.Errorf("cannot write provider config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package gitops
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	dir := t.TempDir()
+
+	subaccountID := "6c3c6fa4-6f12-4a36-9bd1-0f6b5e2a4c11"
+	subaccountName := "dev.eu10"
+	instanceName := "my-db." + subaccountID
+
+	objectMeta := func(name string, annotations map[string]string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Annotations: annotations}
+	}
+	typeMeta := func(kind string) metav1.TypeMeta {
+		return metav1.TypeMeta{Kind: kind, APIVersion: v1alpha1.CRDGroupVersion.String()}
+	}
+
+	w := NewWriter(nil)
+
+	w.Resource(yaml.NewResourceWithComment(&v1alpha1.Directory{
+		TypeMeta:   typeMeta(v1alpha1.DirectoryKind),
+		ObjectMeta: objectMeta("projects", nil),
+	}))
+	w.Resource(yaml.NewResourceWithComment(&v1alpha1.Subaccount{
+		TypeMeta:   typeMeta(v1alpha1.SubaccountKind),
+		ObjectMeta: objectMeta(subaccountName, map[string]string{"crossplane.io/external-name": subaccountID}),
+	}))
+	// Subaccount referenced.
+	w.Resource(yaml.NewResourceWithComment(&v1alpha1.Entitlement{
+		TypeMeta:   typeMeta(v1alpha1.EntitlementKind),
+		ObjectMeta: objectMeta("cis.local", nil),
+		Spec: v1alpha1.EntitlementSpec{
+			ForProvider: v1alpha1.EntitlementParameters{
+				SubaccountRef: &v1.Reference{Name: subaccountName},
+			},
+		},
+	}))
+	// Subaccount by ID.
+	w.Resource(yaml.NewResourceWithComment(&v1alpha1.ServiceInstance{
+		TypeMeta:   typeMeta(v1alpha1.ServiceInstanceKind),
+		ObjectMeta: objectMeta(instanceName, nil),
+		Spec: v1alpha1.ServiceInstanceSpec{
+			ForProvider: v1alpha1.ServiceInstanceParameters{
+				SubaccountID: &subaccountID,
+			},
+		},
+	}))
+	// Subaccount of the referenced service instance.
+	w.Resource(yaml.NewResourceWithComment(&v1alpha1.ServiceBinding{
+		TypeMeta:   typeMeta(v1alpha1.ServiceBindingKind),
+		ObjectMeta: objectMeta("my-binding", nil),
+		Spec: v1alpha1.ServiceBindingSpec{
+			ForProvider: v1alpha1.ServiceBindingParameters{
+				ServiceInstanceRef: &v1.Reference{Name: instanceName},
+			},
+		},
+	}))
+	// Commented out resource.
+	commented := yaml.NewResourceWithComment(&v1alpha1.Entitlement{
+		TypeMeta:   typeMeta(v1alpha1.EntitlementKind),
+		ObjectMeta: objectMeta(resources.UndefinedName, nil),
+		Spec: v1alpha1.EntitlementSpec{
+			ForProvider: v1alpha1.EntitlementParameters{
+				SubaccountRef: &v1.Reference{Name: subaccountName},
+			},
+		},
+	})
+	commented.AddComment(resources.WarnUndefinedResourceName)
+	w.Resource(commented)
+
+	r.NoError(w.Write(dir))
+
+	for _, f := range []string{
+		"providerconfig.yaml",
+		"global/directory/projects.yaml",
+		"dev.eu10/subaccount/dev.eu10.yaml",
+		"dev.eu10/entitlement/cis.local.yaml",
+		"dev.eu10/entitlement/" + resources.UndefinedName + ".yaml",
+		"dev.eu10/serviceinstance/" + instanceName + ".yaml",
+		"dev.eu10/servicebinding/my-binding.yaml",
+	} {
+		r.FileExists(filepath.Join(dir, f))
+	}
+
+	requireKustomization(t, filepath.Join(dir, kustomizationFile), "dev.eu10", "global", "providerconfig.yaml")
+	requireKustomization(t, filepath.Join(dir, "dev.eu10", kustomizationFile), "entitlement", "servicebinding", "serviceinstance", "subaccount")
+	requireKustomization(t, filepath.Join(dir, "dev.eu10", "entitlement", kustomizationFile), "cis.local.yaml")
+	requireKustomization(t, filepath.Join(dir, "global", kustomizationFile), "directory")
+}
+
+func requireKustomization(t *testing.T, path string, resources ...string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var k kustomization
+	require.NoError(t, sigsyaml.Unmarshal(data, &k))
+	require.Equal(t, "Kustomization", k.Kind)
+	require.Equal(t, resources, k.Resources)
+}
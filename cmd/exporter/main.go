@@ -2,20 +2,34 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"os"
 
 	"github.com/SAP/xp-clifford/cli"
 	"github.com/SAP/xp-clifford/cli/configparam"
 	"github.com/SAP/xp-clifford/cli/export"
 	"github.com/SAP/xp-clifford/erratt"
 
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpapi"
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/drift"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/gitops"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/recording"
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
 	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/cfenvironment"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/cloudmanagement"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/directory"
 	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/entitlement"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/kymaenvironment"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/kymamodule"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/rolecollection"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/rolecollectionassignment"
 	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/servicebinding"
 	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/serviceinstance"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/servicemanager"
 	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
+	_ "github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subscription"
 )
 
 const (
@@ -24,6 +38,40 @@ const (
 
 	envVarBtpCliPath   = "BTP_EXPORT_BTP_CLI_PATH"
 	flagNameBtpCliPath = "btp-cli"
+
+	envVarBackend   = "BTP_EXPORT_BACKEND"
+	flagNameBackend = "backend"
+	backendCli      = "cli"
+	backendApi      = "api"
+
+	envVarCisSecret   = "BTP_EXPORT_CIS_SECRET"
+	flagNameCisSecret = "cis-secret"
+
+	envVarUserSecret   = "BTP_EXPORT_USER_SECRET"
+	flagNameUserSecret = "user-secret"
+
+	envVarRecord   = "BTP_EXPORT_RECORD"
+	flagNameRecord = "record"
+
+	envVarReplay   = "BTP_EXPORT_REPLAY"
+	flagNameReplay = "replay"
+
+	envVarCompareWithCluster   = "BTP_EXPORT_COMPARE_WITH_CLUSTER"
+	flagNameCompareWithCluster = "compare-with-cluster"
+
+	flagNameKubeconfig = "kubeconfig"
+
+	envVarDriftReport   = "BTP_EXPORT_DRIFT_REPORT"
+	flagNameDriftReport = "drift-report"
+
+	envVarOutputDir   = "BTP_EXPORT_OUTPUT_DIR"
+	flagNameOutputDir = "output-dir"
+
+	envVarWorkers   = "BTP_EXPORT_WORKERS"
+	flagNameWorkers = "workers"
+
+	envVarRateLimit   = "BTP_EXPORT_RATE_LIMIT"
+	flagNameRateLimit = "rate-limit"
 )
 
 var (
@@ -33,6 +81,39 @@ var (
 	paramBtpCliPath = configparam.String(flagNameBtpCliPath, "Path to the BTP CLI binary that should be used by the export tool to access BTP. Default: 'btp' in your $PATH.").
 		WithFlagName(flagNameBtpCliPath).
 		WithEnvVarName(envVarBtpCliPath)
+	paramBackend = configparam.String(flagNameBackend, "Backend used to access BTP: 'cli' calls the BTP CLI, 'api' calls the BTP REST APIs with the credentials of a CIS service binding. Default: 'cli'.").
+		WithFlagName(flagNameBackend).
+		WithEnvVarName(envVarBackend)
+	paramCisSecret = configparam.String(flagNameCisSecret, "Path to a file with the CIS service binding credentials, in the same format as the ProviderConfig's CIS secret. Required for the 'api' backend.").
+		WithFlagName(flagNameCisSecret).
+		WithEnvVarName(envVarCisSecret)
+	paramUserSecret = configparam.String(flagNameUserSecret, "Path to a file with the technical user credentials, in the same format as the ProviderConfig's service account secret. Optional for the 'api' backend.").
+		WithFlagName(flagNameUserSecret).
+		WithEnvVarName(envVarUserSecret)
+	paramRecord = configparam.String(flagNameRecord, "Directory to save all BTP responses to, so that the export can be replayed later.").
+		WithFlagName(flagNameRecord).
+		WithEnvVarName(envVarRecord)
+	paramReplay = configparam.String(flagNameReplay, "Directory with BTP responses saved by --record, to export from instead of BTP.").
+		WithFlagName(flagNameReplay).
+		WithEnvVarName(envVarReplay)
+	paramCompareWithCluster = configparam.Bool(flagNameCompareWithCluster, "Compare the exported resources with the managed resources in the cluster and report the differences.").
+		WithFlagName(flagNameCompareWithCluster).
+		WithEnvVarName(envVarCompareWithCluster)
+	paramKubeconfig = configparam.String(flagNameKubeconfig, "Path to the kubeconfig of the cluster to compare with. Default: $KUBECONFIG or ~/.kube/config.").
+		WithFlagName(flagNameKubeconfig)
+	paramDriftReport = configparam.String(flagNameDriftReport, "File to write the drift report to. Default: stderr.").
+		WithFlagName(flagNameDriftReport).
+		WithEnvVarName(envVarDriftReport)
+	paramOutputDir = configparam.String(flagNameOutputDir, "Directory to write the resources to as a tree of <subaccount>/<kind>/<name>.yaml files with kustomizations, instead of a single YAML stream.").
+		WithFlagName(flagNameOutputDir).
+		WithEnvVarName(envVarOutputDir)
+	paramWorkers = configparam.Int(flagNameWorkers, "Number of subaccounts whose resources are retrieved from BTP in parallel.").
+		WithFlagName(flagNameWorkers).
+		WithEnvVarName(envVarWorkers).
+		WithDefaultValue(resources.DefaultWorkers)
+	paramRateLimit = configparam.Float(flagNameRateLimit, "Maximum number of requests per second sent to BTP. Default: 0, which means unlimited.").
+		WithFlagName(flagNameRateLimit).
+		WithEnvVarName(envVarRateLimit)
 )
 
 func main() {
@@ -42,6 +123,17 @@ func main() {
 	export.AddConfigParams(
 		paramResolveRefences,
 		paramBtpCliPath,
+		paramBackend,
+		paramCisSecret,
+		paramUserSecret,
+		paramRecord,
+		paramReplay,
+		paramCompareWithCluster,
+		paramKubeconfig,
+		paramDriftReport,
+		paramOutputDir,
+		paramWorkers,
+		paramRateLimit,
 	)
 	export.AddConfigParams(resources.ConfigParams()...)
 	export.AddResourceKinds(resources.KindNames()...)
@@ -58,9 +150,26 @@ func exportCmd(ctx context.Context, eventHandler export.EventHandler) error {
 	}
 	slog.Debug("Kinds selected", "kinds", selectedResources)
 
-	// This client does not try to log in, thus relying on existing session.
-	// Explicit authentication can be done by a separate `login` command or by BTP CLI's `login` command.
-	btpClient := btpcli.NewClient(paramBtpCliPath.Value())
+	resources.SetWorkers(paramWorkers.Value())
+
+	btpClient, err := newBtpClient(ctx)
+	if err != nil {
+		return erratt.Errorf("cannot create BTP client: %w", err).With("backend", paramBackend.Value())
+	}
+
+	// Keep the exported resources, if they have to be written as a directory tree.
+	var treeWriter *gitops.Writer
+	if paramOutputDir.Value() != "" {
+		treeWriter = gitops.NewWriter(eventHandler)
+		eventHandler = treeWriter
+	}
+
+	// Keep the exported resources, if they have to be compared with the cluster.
+	var collector *drift.Collector
+	if paramCompareWithCluster.Value() {
+		collector = drift.NewCollector(eventHandler)
+		eventHandler = collector
+	}
 
 	// Export selected kinds.
 	for _, kind := range selectedResources {
@@ -73,5 +182,93 @@ func exportCmd(ctx context.Context, eventHandler export.EventHandler) error {
 		}
 	}
 
+	if treeWriter != nil {
+		if err := treeWriter.Write(paramOutputDir.Value()); err != nil {
+			eventHandler.Warn(erratt.Errorf("cannot write output directory: %w", err).With("directory", paramOutputDir.Value()))
+		}
+	}
+
+	if collector != nil {
+		if err := reportDrift(ctx, collector); err != nil {
+			eventHandler.Warn(erratt.Errorf("cannot compare exported resources with cluster: %w", err))
+		}
+	}
+
 	return nil
 }
+
+func reportDrift(ctx context.Context, collector *drift.Collector) error {
+	clusterObjs, err := drift.ListClusterObjects(ctx, paramKubeconfig.Value(), collector.GroupVersionKinds())
+	if err != nil {
+		return err
+	}
+	report := drift.Compare(collector.Resources(), clusterObjs)
+
+	if paramDriftReport.Value() == "" {
+		return drift.WriteReport(os.Stderr, report)
+	}
+
+	f, err := os.Create(paramDriftReport.Value())
+	if err != nil {
+		return fmt.Errorf("cannot create drift report file: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return drift.WriteReport(f, report)
+}
+
+func newBtpClient(ctx context.Context) (btpcli.Client, error) {
+	if paramReplay.Value() != "" {
+		if paramRecord.Value() != "" {
+			return nil, fmt.Errorf("--%s and --%s cannot be used together", flagNameRecord, flagNameReplay)
+		}
+		return recording.NewReplayer(paramReplay.Value())
+	}
+
+	client, err := newBackendClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if paramRateLimit.Value() < 0 {
+		return nil, fmt.Errorf("--%s must not be negative", flagNameRateLimit)
+	}
+	if paramRateLimit.Value() > 0 {
+		client = btpcli.NewRateLimitedClient(client, paramRateLimit.Value(), paramWorkers.Value())
+	}
+
+	if paramRecord.Value() != "" {
+		return recording.NewRecorder(client, paramRecord.Value())
+	}
+	return client, nil
+}
+
+func newBackendClient(ctx context.Context) (btpcli.Client, error) {
+	switch paramBackend.Value() {
+	case "", backendCli:
+		// This client does not try to log in, thus relying on existing session.
+		// Explicit authentication can be done by a separate `login` command or by BTP CLI's `login` command.
+		return btpcli.NewClient(paramBtpCliPath.Value()), nil
+	case backendApi:
+		if paramCisSecret.Value() == "" {
+			return nil, fmt.Errorf("--%s is required for the %s backend", flagNameCisSecret, backendApi)
+		}
+		cisSecret, err := os.ReadFile(paramCisSecret.Value())
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CIS secret: %w", err)
+		}
+
+		var userSecret []byte
+		if paramUserSecret.Value() != "" {
+			userSecret, err = os.ReadFile(paramUserSecret.Value())
+			if err != nil {
+				return nil, fmt.Errorf("cannot read user secret: %w", err)
+			}
+		}
+
+		return btpapi.NewClient(ctx, cisSecret, userSecret)
+	default:
+		return nil, fmt.Errorf("unknown backend %q, must be one of: %s, %s", paramBackend.Value(), backendCli, backendApi)
+	}
+}
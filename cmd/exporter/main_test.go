@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/yaml"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// collectingEventHandler keeps the exported resources in memory.
+type collectingEventHandler struct {
+	mu        sync.Mutex
+	resources []string
+	warnings  []string
+}
+
+var _ export.EventHandler = &collectingEventHandler{}
+
+func (h *collectingEventHandler) Warn(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.warnings = append(h.warnings, err.Error())
+}
+
+func (h *collectingEventHandler) Resource(res resource.Object) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	y, err := yaml.Marshal(res)
+	if err != nil {
+		h.warnings = append(h.warnings, err.Error())
+		return
+	}
+	h.resources = append(h.resources, y)
+}
+
+func (h *collectingEventHandler) Stop() {}
+
+// TestExportCmdReplay runs the whole export pipeline against the recording in testdata/replay.
+// Run with -update to regenerate the golden file after intended changes of the output.
+func TestExportCmdReplay(t *testing.T) {
+	r := require.New(t)
+
+	viper.Set(export.ResourceKindParam.GetName(), []string{"subaccount", "entitlement", "servicemanager", "serviceinstance", "kyma-module"})
+	viper.Set("subaccount", []string{".*"})
+	viper.Set("entitlement", []string{".*"})
+	viper.Set("servicemanager", []string{".*"})
+	viper.Set("serviceinstance", []string{".*"})
+	viper.Set("kyma-environment", []string{".*"})
+	viper.Set("kyma-module", []string{".*"})
+	viper.Set(paramResolveRefences.GetName(), true)
+	viper.Set(paramReplay.GetName(), filepath.Join("testdata", "replay"))
+	t.Cleanup(viper.Reset)
+
+	eventHandler := &collectingEventHandler{}
+	r.NoError(exportCmd(t.Context(), eventHandler))
+	r.Empty(eventHandler.warnings)
+
+	got := strings.Join(eventHandler.resources, "")
+	golden := filepath.Join("testdata", "export.golden.yaml")
+	if *update {
+		r.NoError(os.WriteFile(golden, []byte(got), 0o644))
+	}
+
+	want, err := os.ReadFile(golden)
+	r.NoError(err)
+	r.Equal(string(want), got)
+}
@@ -0,0 +1,276 @@
+// Package recording records the responses of a BTP client to a directory and replays them later,
+// so that exports can be reproduced without access to BTP.
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	kymaclient "github.com/sap/crossplane-provider-btp/internal/clients/kymamodule"
+)
+
+// ErrNotRecorded is returned during replay for calls that are not part of the recording.
+var ErrNotRecorded = errors.New("response not found in recording")
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// entry is a single recorded call, stored as one JSON file.
+// The method and the arguments identify the call, the file name is only informative,
+// so recordings can be anonymized by replacing values in the file contents.
+type entry struct {
+	Method   string          `json:"method"`
+	Args     []string        `json:"args,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+func key(method string, args []string) string {
+	return strings.Join(append([]string{method}, args...), "\x00")
+}
+
+func fileName(method string, args []string) string {
+	name := method
+	for _, arg := range args {
+		name += "_" + unsafeFileNameChars.ReplaceAllString(arg, "-")
+	}
+	return name + ".json"
+}
+
+// Recorder passes all calls to the wrapped client and stores the responses in a directory.
+type Recorder struct {
+	client btpcli.Client
+	dir    string
+	mu     sync.Mutex
+}
+
+var _ btpcli.Client = &Recorder{}
+
+// NewRecorder creates a Recorder for the client, creating the directory if needed.
+func NewRecorder(client btpcli.Client, dir string) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cannot create recording directory: %w", err)
+	}
+	return &Recorder{
+		client: client,
+		dir:    dir,
+	}, nil
+}
+
+func record[T any](ctx context.Context, r *Recorder, method string, args []string, call func() (T, error)) (T, error) {
+	result, err := call()
+
+	e := entry{
+		Method: method,
+		Args:   args,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	} else {
+		response, mErr := json.Marshal(result)
+		if mErr != nil {
+			slog.WarnContext(ctx, "Cannot record response", "method", method, "args", args, "error", mErr)
+			return result, err
+		}
+		e.Response = response
+	}
+
+	if wErr := r.write(&e); wErr != nil {
+		slog.WarnContext(ctx, "Cannot record response", "method", method, "args", args, "error", wErr)
+	}
+
+	return result, err
+}
+
+func (r *Recorder) write(e *entry) error {
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return os.WriteFile(filepath.Join(r.dir, fileName(e.Method, e.Args)), append(data, '\n'), 0o644)
+}
+
+func (r *Recorder) ListSubaccounts(ctx context.Context) ([]btpcli.Subaccount, error) {
+	return record(ctx, r, "ListSubaccounts", nil, func() ([]btpcli.Subaccount, error) {
+		return r.client.ListSubaccounts(ctx)
+	})
+}
+
+func (r *Recorder) ListDirectories(ctx context.Context) ([]btpcli.Directory, error) {
+	return record(ctx, r, "ListDirectories", nil, func() ([]btpcli.Directory, error) {
+		return r.client.ListDirectories(ctx)
+	})
+}
+
+func (r *Recorder) ListServiceAssignments(ctx context.Context, subaccountID string) ([]btpcli.AssignedService, error) {
+	return record(ctx, r, "ListServiceAssignments", []string{subaccountID}, func() ([]btpcli.AssignedService, error) {
+		return r.client.ListServiceAssignments(ctx, subaccountID)
+	})
+}
+
+func (r *Recorder) ListEnvironmentInstances(ctx context.Context, subaccountID string) ([]btpcli.EnvironmentInstance, error) {
+	return record(ctx, r, "ListEnvironmentInstances", []string{subaccountID}, func() ([]btpcli.EnvironmentInstance, error) {
+		return r.client.ListEnvironmentInstances(ctx, subaccountID)
+	})
+}
+
+func (r *Recorder) ListSubscriptions(ctx context.Context, subaccountID string) ([]btpcli.Subscription, error) {
+	return record(ctx, r, "ListSubscriptions", []string{subaccountID}, func() ([]btpcli.Subscription, error) {
+		return r.client.ListSubscriptions(ctx, subaccountID)
+	})
+}
+
+func (r *Recorder) ListServiceInstances(ctx context.Context, subaccountID string) ([]btpcli.ServiceInstance, error) {
+	return record(ctx, r, "ListServiceInstances", []string{subaccountID}, func() ([]btpcli.ServiceInstance, error) {
+		return r.client.ListServiceInstances(ctx, subaccountID)
+	})
+}
+
+func (r *Recorder) ListServiceBindings(ctx context.Context, subaccountID string) ([]btpcli.ServiceBinding, error) {
+	return record(ctx, r, "ListServiceBindings", []string{subaccountID}, func() ([]btpcli.ServiceBinding, error) {
+		return r.client.ListServiceBindings(ctx, subaccountID)
+	})
+}
+
+func (r *Recorder) ListServicePlans(ctx context.Context, subaccountID string) ([]btpcli.ServicePlan, error) {
+	return record(ctx, r, "ListServicePlans", []string{subaccountID}, func() ([]btpcli.ServicePlan, error) {
+		return r.client.ListServicePlans(ctx, subaccountID)
+	})
+}
+
+func (r *Recorder) GetDirectoryRoleCollection(ctx context.Context, directoryID string, name string) (*btpcli.RoleCollection, error) {
+	return record(ctx, r, "GetDirectoryRoleCollection", []string{directoryID, name}, func() (*btpcli.RoleCollection, error) {
+		return r.client.GetDirectoryRoleCollection(ctx, directoryID, name)
+	})
+}
+
+func (r *Recorder) ListSubaccountRoleCollections(ctx context.Context, subaccountID string) ([]btpcli.RoleCollection, error) {
+	return record(ctx, r, "ListSubaccountRoleCollections", []string{subaccountID}, func() ([]btpcli.RoleCollection, error) {
+		return r.client.ListSubaccountRoleCollections(ctx, subaccountID)
+	})
+}
+
+func (r *Recorder) GetSubaccountRoleCollection(ctx context.Context, subaccountID string, name string) (*btpcli.RoleCollection, error) {
+	return record(ctx, r, "GetSubaccountRoleCollection", []string{subaccountID, name}, func() (*btpcli.RoleCollection, error) {
+		return r.client.GetSubaccountRoleCollection(ctx, subaccountID, name)
+	})
+}
+
+func (r *Recorder) ListKymaModules(ctx context.Context, env *btpcli.EnvironmentInstance) ([]kymaclient.Module, error) {
+	return record(ctx, r, "ListKymaModules", []string{env.ID}, func() ([]kymaclient.Module, error) {
+		return r.client.ListKymaModules(ctx, env)
+	})
+}
+
+// Replayer serves the responses of a recording, without contacting BTP.
+type Replayer struct {
+	entries map[string]entry
+}
+
+var _ btpcli.Client = &Replayer{}
+
+// NewReplayer loads all recorded calls from the directory.
+func NewReplayer(dir string) (*Replayer, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot list recording directory: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no recorded responses found in %s", dir)
+	}
+
+	entries := make(map[string]entry, len(files))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read recorded response: %w", err)
+		}
+
+		var e entry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, fmt.Errorf("cannot parse recorded response %s: %w", f, err)
+		}
+		entries[key(e.Method, e.Args)] = e
+	}
+
+	return &Replayer{entries: entries}, nil
+}
+
+func replay[T any](ctx context.Context, r *Replayer, method string, args ...string) (T, error) {
+	var result T
+
+	e, ok := r.entries[key(method, args)]
+	if !ok {
+		return result, fmt.Errorf("%w: %s %s", ErrNotRecorded, method, strings.Join(args, " "))
+	}
+	slog.DebugContext(ctx, "Replaying recorded response", "method", method, "args", args)
+
+	if e.Error != "" {
+		return result, errors.New(e.Error)
+	}
+	if len(e.Response) > 0 {
+		if err := json.Unmarshal(e.Response, &result); err != nil {
+			return result, fmt.Errorf("cannot parse recorded response of %s: %w", method, err)
+		}
+	}
+	return result, nil
+}
+
+func (r *Replayer) ListSubaccounts(ctx context.Context) ([]btpcli.Subaccount, error) {
+	return replay[[]btpcli.Subaccount](ctx, r, "ListSubaccounts")
+}
+
+func (r *Replayer) ListDirectories(ctx context.Context) ([]btpcli.Directory, error) {
+	return replay[[]btpcli.Directory](ctx, r, "ListDirectories")
+}
+
+func (r *Replayer) ListServiceAssignments(ctx context.Context, subaccountID string) ([]btpcli.AssignedService, error) {
+	return replay[[]btpcli.AssignedService](ctx, r, "ListServiceAssignments", subaccountID)
+}
+
+func (r *Replayer) ListEnvironmentInstances(ctx context.Context, subaccountID string) ([]btpcli.EnvironmentInstance, error) {
+	return replay[[]btpcli.EnvironmentInstance](ctx, r, "ListEnvironmentInstances", subaccountID)
+}
+
+func (r *Replayer) ListSubscriptions(ctx context.Context, subaccountID string) ([]btpcli.Subscription, error) {
+	return replay[[]btpcli.Subscription](ctx, r, "ListSubscriptions", subaccountID)
+}
+
+func (r *Replayer) ListServiceInstances(ctx context.Context, subaccountID string) ([]btpcli.ServiceInstance, error) {
+	return replay[[]btpcli.ServiceInstance](ctx, r, "ListServiceInstances", subaccountID)
+}
+
+func (r *Replayer) ListServiceBindings(ctx context.Context, subaccountID string) ([]btpcli.ServiceBinding, error) {
+	return replay[[]btpcli.ServiceBinding](ctx, r, "ListServiceBindings", subaccountID)
+}
+
+func (r *Replayer) ListServicePlans(ctx context.Context, subaccountID string) ([]btpcli.ServicePlan, error) {
+	return replay[[]btpcli.ServicePlan](ctx, r, "ListServicePlans", subaccountID)
+}
+
+func (r *Replayer) GetDirectoryRoleCollection(ctx context.Context, directoryID string, name string) (*btpcli.RoleCollection, error) {
+	return replay[*btpcli.RoleCollection](ctx, r, "GetDirectoryRoleCollection", directoryID, name)
+}
+
+func (r *Replayer) ListSubaccountRoleCollections(ctx context.Context, subaccountID string) ([]btpcli.RoleCollection, error) {
+	return replay[[]btpcli.RoleCollection](ctx, r, "ListSubaccountRoleCollections", subaccountID)
+}
+
+func (r *Replayer) GetSubaccountRoleCollection(ctx context.Context, subaccountID string, name string) (*btpcli.RoleCollection, error) {
+	return replay[*btpcli.RoleCollection](ctx, r, "GetSubaccountRoleCollection", subaccountID, name)
+}
+
+func (r *Replayer) ListKymaModules(ctx context.Context, env *btpcli.EnvironmentInstance) ([]kymaclient.Module, error) {
+	return replay[[]kymaclient.Module](ctx, r, "ListKymaModules", env.ID)
+}
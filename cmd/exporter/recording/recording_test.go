@@ -0,0 +1,116 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+)
+
+// fakeClient returns fixed responses, calls of other methods panic.
+type fakeClient struct {
+	btpcli.Client
+	subaccounts  []btpcli.Subaccount
+	environments []btpcli.EnvironmentInstance
+	roleErr      error
+}
+
+func (c *fakeClient) ListSubaccounts(_ context.Context) ([]btpcli.Subaccount, error) {
+	return c.subaccounts, nil
+}
+
+func (c *fakeClient) ListEnvironmentInstances(_ context.Context, _ string) ([]btpcli.EnvironmentInstance, error) {
+	return c.environments, nil
+}
+
+func (c *fakeClient) GetSubaccountRoleCollection(_ context.Context, _ string, _ string) (*btpcli.RoleCollection, error) {
+	return nil, c.roleErr
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	ctx := t.Context()
+	dir := t.TempDir()
+
+	subaccountID := "sa-12345678"
+	client := &fakeClient{
+		subaccounts: []btpcli.Subaccount{
+			{GUID: subaccountID, DisplayName: "dev", Labels: map[string][]string{"team": {"a"}}},
+		},
+		environments: []btpcli.EnvironmentInstance{
+			{
+				ID:         "env-1",
+				Parameters: btpcli.Parameters{InstanceName: "my-org", Raw: []byte(`{"instance_name":"my-org"}`)},
+				Labels:     btpcli.Labels{OrgName: "my-org"},
+			},
+		},
+		roleErr: errors.New("role collection not found"),
+	}
+
+	// Record.
+	recorder, err := NewRecorder(client, dir)
+	r.NoError(err)
+
+	subaccounts, err := recorder.ListSubaccounts(ctx)
+	r.NoError(err)
+	r.Equal(client.subaccounts, subaccounts)
+
+	environments, err := recorder.ListEnvironmentInstances(ctx, subaccountID)
+	r.NoError(err)
+	r.Equal(client.environments, environments)
+
+	_, err = recorder.GetSubaccountRoleCollection(ctx, subaccountID, "Subaccount Viewer")
+	r.EqualError(err, "role collection not found")
+
+	r.FileExists(filepath.Join(dir, "ListSubaccounts.json"))
+	r.FileExists(filepath.Join(dir, "ListEnvironmentInstances_"+subaccountID+".json"))
+	r.FileExists(filepath.Join(dir, "GetSubaccountRoleCollection_"+subaccountID+"_Subaccount-Viewer.json"))
+
+	// Replay.
+	replayer, err := NewReplayer(dir)
+	r.NoError(err)
+
+	subaccounts, err = replayer.ListSubaccounts(ctx)
+	r.NoError(err)
+	r.Equal(client.subaccounts, subaccounts)
+
+	environments, err = replayer.ListEnvironmentInstances(ctx, subaccountID)
+	r.NoError(err)
+	r.Equal(client.environments, environments)
+
+	_, err = replayer.GetSubaccountRoleCollection(ctx, subaccountID, "Subaccount Viewer")
+	r.EqualError(err, "role collection not found")
+
+	_, err = replayer.ListEnvironmentInstances(ctx, "other-subaccount")
+	r.ErrorIs(err, ErrNotRecorded)
+}
+
+func TestReplayIgnoresFileNames(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	dir := t.TempDir()
+
+	// Anonymized recordings may keep the original file names.
+	r.NoError(os.WriteFile(filepath.Join(dir, "ListServiceAssignments_real-id.json"),
+		[]byte(`{"method":"ListServiceAssignments","args":["anonymized-id"],"response":[{"name":"postgresql-db"}]}`), 0o644))
+
+	replayer, err := NewReplayer(dir)
+	r.NoError(err)
+
+	services, err := replayer.ListServiceAssignments(t.Context(), "anonymized-id")
+	r.NoError(err)
+	r.Equal([]btpcli.AssignedService{{Name: "postgresql-db"}}, services)
+}
+
+func TestNewReplayerEmptyDirectory(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewReplayer(t.TempDir())
+	require.Error(t, err)
+}
@@ -66,11 +66,15 @@ func (c *resourceCache[T]) Store(resources ...T) {
 	}
 }
 
+// All iterates over the cached resources ordered by ID, so that the export output is stable.
 func (c *resourceCache[T]) All() iter.Seq2[string, T] {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	return maps.All(c.resources)
+	return func(yield func(string, T) bool) {
+		for _, id := range c.AllIDs() {
+			if !yield(id, c.Get(id)) {
+				return
+			}
+		}
+	}
 }
 
 func (c *resourceCache[T]) AllIDs() []string {
@@ -41,7 +41,7 @@ func (e exporter) KindName() string {
 	return KindName
 }
 
-func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHandler export.EventHandler, resolveReferences bool) error {
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		return fmt.Errorf("failed to get cache with CF environment instances: %w", err)
@@ -57,7 +57,7 @@ func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHan
 	return nil
 }
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*CloudFoundryEnvironment], error) {
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*CloudFoundryEnvironment], error) {
 	if cfEnvCache != nil {
 		return cfEnvCache, nil
 	}
@@ -70,13 +70,15 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
 
 	// Retrieve all CF environment instances from selected subaccounts.
-	var btpEnvironments []btpcli.EnvironmentInstance
-	for _, saId := range saCache.AllIDs() {
+	btpEnvironments, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]btpcli.EnvironmentInstance, error) {
 		instances, err := btpClient.ListEnvironmentInstances(ctx, saId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get environment instances for subaccount %s: %w", saId, err)
 		}
-		btpEnvironments = append(btpEnvironments, instances...)
+		return instances, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	slog.DebugContext(ctx, "Total environments returned by BTP CLI", "count", len(btpEnvironments))
 
@@ -120,7 +122,7 @@ func isCloudFoundryEnvironment(instance *btpcli.EnvironmentInstance) bool {
 	return instance.EnvironmentType == CfServiceName
 }
 
-func convert(ctx context.Context, btpClient *btpcli.BtpCli, eventHandler export.EventHandler, resolveReferences bool) {
+func convert(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get cache with cloud foundry environments", "error", err)
@@ -133,7 +135,7 @@ func convert(ctx context.Context, btpClient *btpcli.BtpCli, eventHandler export.
 	}
 }
 
-func exportPrerequisiteResources(ctx context.Context, btpClient *btpcli.BtpCli, e *CloudFoundryEnvironment, eventHandler export.EventHandler, resolveReferences bool) {
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, e *CloudFoundryEnvironment, eventHandler export.EventHandler, resolveReferences bool) {
 	// Export subaccount Cloud Management resource.
 	cmName, err := cloudmanagement.ExportInstanceForSubaccount(ctx, btpClient, e.SubaccountGUID, eventHandler, resolveReferences)
 	if err != nil {
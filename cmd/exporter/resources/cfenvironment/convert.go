@@ -15,7 +15,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
 )
 
-func convertCloudFoundryEnvResource(ctx context.Context, btpClient *btpcli.BtpCli, e *CloudFoundryEnvironment, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertCloudFoundryEnvResource(ctx context.Context, btpClient btpcli.Client, e *CloudFoundryEnvironment, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 	subAccountGuid := e.SubaccountGUID
 	resourceName := e.GenerateK8sResourceName()
 	externalName := e.GetExternalName()
@@ -98,7 +98,7 @@ func convertCloudFoundryEnvResource(ctx context.Context, btpClient *btpcli.BtpCl
 	return cfEnvInstance
 }
 
-func resolveReference(ctx context.Context, btpClient *btpcli.BtpCli, spec *v1alpha1.CfEnvironmentSpec) error {
+func resolveReference(ctx context.Context, btpClient btpcli.Client, spec *v1alpha1.CfEnvironmentSpec) error {
 	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, spec.SubaccountGuid)
 	if err != nil {
 		return err
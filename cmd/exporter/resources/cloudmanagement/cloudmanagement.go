@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/SAP/xp-clifford/cli/configparam"
 	"github.com/SAP/xp-clifford/cli/export"
 	"github.com/SAP/xp-clifford/yaml"
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
@@ -15,15 +16,55 @@ import (
 )
 
 const (
+	KindName = "cloudmanagement"
+
 	defaultNamePrefix = "managed-cloud-management"
 )
 
 var (
-	cmCache  resources.ResourceCache[*serviceinstancebase.ServiceInstance]
-	registry = resources.NewRegistry()
+	selectedCache resources.ResourceCache[*serviceinstancebase.ServiceInstance]
+	cmCache       resources.ResourceCache[*serviceinstancebase.ServiceInstance]
+	registry      = resources.NewRegistry()
+	instanceParam = configparam.StringSlice(KindName, "Cloud management instance ID or regex expression for name.").
+			WithFlagName(KindName)
 )
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
+func init() {
+	resources.RegisterKind(exporter{})
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return instanceParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	cache, err := getSelected(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with cloud management instances: %w", err)
+	}
+	slog.DebugContext(ctx, "Cloud management instances in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no cloud management instances found"))
+		return nil
+	}
+
+	for _, si := range cache.All() {
+		Convert(ctx, btpClient, si, eventHandler, resolveReferences)
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
 	if cmCache != nil {
 		return cmCache, nil
 	}
@@ -47,14 +88,46 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	return cmCache, nil
 }
 
-func Convert(ctx context.Context, btpClient *btpcli.BtpCli, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+// getSelected returns the cloud management instances selected by the user.
+// The cache returned by Get remains complete, because other kinds look up the cloud management of a subaccount in it.
+func getSelected(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
+	if selectedCache != nil {
+		return selectedCache, nil
+	}
+
+	fc, err := Get(ctx, btpClient)
+	if err != nil {
+		return nil, err
+	}
+	cache := fc.Copy()
+
+	// Let the user select cloud management instances to export.
+	widgetValues := cache.ValuesForSelection()
+	instanceParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selected, err := instanceParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", instanceParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected cloud management instances", "instances", selected)
+
+	// Keep only selected cloud management instances in the cache.
+	cache.KeepSelectedOnly(selected)
+	selectedCache = cache
+
+	return selectedCache, nil
+}
+
+func Convert(ctx context.Context, btpClient btpcli.Client, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	if register(ctx, cm) {
 		exportPrerequisiteResources(ctx, btpClient, cm, eventHandler, resolveReferences)
 		eventHandler.Resource(convertCloudManagementResource(ctx, btpClient, cm, eventHandler, resolveReferences))
 	}
 }
 
-func convertDefault(ctx context.Context, btpClient *btpcli.BtpCli, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+func convertDefault(ctx context.Context, btpClient btpcli.Client, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	if register(ctx, cm) {
 		exportPrerequisiteResources(ctx, btpClient, cm, eventHandler, resolveReferences)
 		eventHandler.Resource(convertDefaultCloudManagementResource(ctx, btpClient, cm, eventHandler, resolveReferences))
@@ -69,7 +142,7 @@ func register(ctx context.Context, cm *serviceinstancebase.ServiceInstance) bool
 	return success
 }
 
-func ExportInstanceForSubaccount(ctx context.Context, btpClient *btpcli.BtpCli, subaccountID string, eventHandler export.EventHandler, resolveReferences bool) (string, error) {
+func ExportInstanceForSubaccount(ctx context.Context, btpClient btpcli.Client, subaccountID string, eventHandler export.EventHandler, resolveReferences bool) (string, error) {
 	cm, found, err := getCloudManagement(ctx, btpClient, subaccountID)
 	if err != nil {
 		return "", fmt.Errorf("failed to retrieve cloud management instance for subaccount %s: %w", subaccountID, err)
@@ -84,7 +157,7 @@ func ExportInstanceForSubaccount(ctx context.Context, btpClient *btpcli.BtpCli,
 	return cm.GenerateK8sResourceName(), nil
 }
 
-func getCloudManagement(ctx context.Context, btpClient *btpcli.BtpCli, subaccountID string) (*serviceinstancebase.ServiceInstance, bool, error) {
+func getCloudManagement(ctx context.Context, btpClient btpcli.Client, subaccountID string) (*serviceinstancebase.ServiceInstance, bool, error) {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to retrieve cloud management cache: %w", err)
@@ -100,12 +173,12 @@ func getCloudManagement(ctx context.Context, btpClient *btpcli.BtpCli, subaccoun
 	return defaultCloudManagement(subaccountID), false, nil
 }
 
-func exportPrerequisiteResources(ctx context.Context, btpClient *btpcli.BtpCli, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	exportPrerequisiteEntitlement(ctx, btpClient, cm, eventHandler, resolveReferences)
 	exportPrerequisiteSM(ctx, btpClient, cm, eventHandler, resolveReferences)
 }
 
-func exportPrerequisiteEntitlement(ctx context.Context, btpClient *btpcli.BtpCli, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+func exportPrerequisiteEntitlement(ctx context.Context, btpClient btpcli.Client, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	saID := cm.SubaccountID
 	service := serviceinstancebase.CloudManagementOffering
 	plan := serviceinstancebase.CloudManagementPlan
@@ -116,7 +189,7 @@ func exportPrerequisiteEntitlement(ctx context.Context, btpClient *btpcli.BtpCli
 	}
 }
 
-func exportPrerequisiteSM(ctx context.Context, btpClient *btpcli.BtpCli, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+func exportPrerequisiteSM(ctx context.Context, btpClient btpcli.Client, cm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	// Export subaccount service manager.
 	saID := cm.SubaccountID
 	smName, err := servicemanager.ExportOperatorInstance(ctx, btpClient, saID, eventHandler, resolveReferences)
@@ -17,7 +17,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
 )
 
-func convertCloudManagementResource(ctx context.Context, btpClient *btpcli.BtpCli, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertCloudManagementResource(ctx context.Context, btpClient btpcli.Client, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 	resourceName := si.GenerateK8sResourceName()
 	externalName := si.GetExternalName()
 	subAccountID := si.SubaccountID
@@ -106,7 +106,7 @@ func convertCloudManagementResource(ctx context.Context, btpClient *btpcli.BtpCl
 	return cm
 }
 
-func convertDefaultCloudManagementResource(ctx context.Context, btpClient *btpcli.BtpCli, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertDefaultCloudManagementResource(ctx context.Context, btpClient btpcli.Client, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 	resourceName := si.GenerateK8sResourceName()
 	subAccountID := si.SubaccountID
 	smName := si.ServiceManagerName
@@ -161,7 +161,7 @@ func convertDefaultCloudManagementResource(ctx context.Context, btpClient *btpcl
 	return cm
 }
 
-func resolveReference(ctx context.Context, btpClient *btpcli.BtpCli, spec *v1beta1.CloudManagementParameters) error {
+func resolveReference(ctx context.Context, btpClient btpcli.Client, spec *v1beta1.CloudManagementParameters) error {
 	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, spec.SubaccountGuid)
 	if err != nil {
 		return err
@@ -0,0 +1,98 @@
+package directory
+
+import (
+	"github.com/SAP/xp-clifford/yaml"
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func convertDirectoryResource(d *Directory) *yaml.ResourceWithComment {
+	displayName := d.GetDisplayName()
+	resourceName := d.GenerateK8sResourceName()
+	externalName := d.GetExternalName()
+	admins := d.Admins
+
+	// Create Directory with required fields first.
+	dirResource := yaml.NewResourceWithComment(
+		&v1alpha1.Directory{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.DirectoryKind,
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.DirectorySpec{
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{
+						v1.ManagementActionObserve,
+					},
+				},
+				ForProvider: v1alpha1.DirectoryParameters{
+					DisplayName:       &displayName,
+					DirectoryAdmins:   admins,
+					DirectoryFeatures: d.DirectoryFeatures,
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	dirResource.CloneComment(d)
+
+	// Comment the resource out, if any of the required fields is missing.
+	if displayName == "" {
+		dirResource.AddComment(resources.WarnMissingDisplayName)
+	}
+	if resourceName == resources.UndefinedName {
+		dirResource.AddComment(resources.WarnUndefinedResourceName)
+	}
+	if externalName == resources.UndefinedExternalName {
+		dirResource.AddComment(resources.WarnUndefinedExternalName)
+	}
+	if len(admins) == 0 {
+		dirResource.AddComment(resources.WarnMissingDirectoryAdmins)
+	}
+
+	// Fill the optional fields that are relevant for the Update operation, to have it match status.atProvider
+	// and not trigger an update right after managementPolicies is set to manage the resource.
+	forProvider := &dirResource.Resource().(*v1alpha1.Directory).Spec.ForProvider
+
+	// Description
+	if d.Description != "" {
+		description := d.Description
+		forProvider.Description = &description
+	}
+
+	// Subdomain applies only to directories with user authorization management.
+	if d.Subdomain != "" && d.HasFeature(FeatureAuthorizations) {
+		subdomain := d.Subdomain
+		forProvider.Subdomain = &subdomain
+	}
+
+	// Labels
+	if len(d.Labels) > 0 {
+		labels := make(map[string][]string, len(d.Labels))
+		for k, v := range d.Labels {
+			labels[k] = v
+		}
+		forProvider.Labels = labels
+	}
+
+	// Parent directory, either referenced or by GUID.
+	switch {
+	case d.ParentK8sName != "":
+		forProvider.DirectoryRef = &v1.Reference{
+			Name: d.ParentK8sName,
+		}
+	case d.HasParentDirectory():
+		forProvider.DirectoryGuid = d.ParentGUID
+	}
+
+	return dirResource
+}
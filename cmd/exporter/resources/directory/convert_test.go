@@ -0,0 +1,280 @@
+package directory
+
+import (
+	"testing"
+
+	"github.com/SAP/xp-clifford/yaml"
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func TestConvertDirectoryResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	dirGuid := "a1b2c3d4-1234-1234-1234-123456789abc"
+	parentGuid := "f0e1d2c3-1234-1234-1234-123456789abc"
+	gaGuid := "global-account-guid"
+	displayName := "Team Directory"
+	description := "Directory of the team"
+	subdomain := "team-directory"
+	createdBy := "admin@example.com"
+	admins := []string{"admin1@example.com", "admin2@example.com"}
+	labels := map[string][]string{"cost-center": {"1234"}}
+	wantResourceName := "team-directory.a1b2c3d4-1234-1234-1234-123456789abc"
+
+	tests := []struct {
+		name string
+		dir  *Directory
+		want *yaml.ResourceWithComment
+	}{
+		{
+			name: "directory in global account",
+			dir: &Directory{
+				Directory: &btpcli.Directory{
+					GUID:              dirGuid,
+					ParentGUID:        gaGuid,
+					GlobalAccountGUID: gaGuid,
+					DisplayName:       displayName,
+					DirectoryFeatures: []string{"DEFAULT"},
+					CreatedBy:         createdBy,
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Admins:              []string{createdBy},
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.Directory{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.DirectoryKind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: wantResourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": dirGuid,
+						},
+					},
+					Spec: v1alpha1.DirectorySpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.DirectoryParameters{
+							DisplayName:       &displayName,
+							DirectoryAdmins:   []string{createdBy},
+							DirectoryFeatures: []string{"DEFAULT"},
+						},
+					},
+				}),
+		},
+		{
+			name: "nested directory with authorizations and all optional fields",
+			dir: &Directory{
+				Directory: &btpcli.Directory{
+					GUID:              dirGuid,
+					ParentGUID:        parentGuid,
+					GlobalAccountGUID: gaGuid,
+					DisplayName:       displayName,
+					Description:       description,
+					Subdomain:         subdomain,
+					DirectoryFeatures: []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"},
+					Labels:            labels,
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Admins:              admins,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.Directory{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.DirectoryKind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: wantResourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": dirGuid,
+						},
+					},
+					Spec: v1alpha1.DirectorySpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.DirectoryParameters{
+							DisplayName:       &displayName,
+							Description:       &description,
+							Subdomain:         &subdomain,
+							DirectoryAdmins:   admins,
+							DirectoryFeatures: []string{"DEFAULT", "ENTITLEMENTS", "AUTHORIZATIONS"},
+							Labels:            labels,
+							DirectoryGuid:     parentGuid,
+						},
+					},
+				}),
+		},
+		{
+			name: "nested directory with resolved parent reference",
+			dir: &Directory{
+				Directory: &btpcli.Directory{
+					GUID:              dirGuid,
+					ParentGUID:        parentGuid,
+					GlobalAccountGUID: gaGuid,
+					DisplayName:       displayName,
+					Subdomain:         subdomain,
+					DirectoryFeatures: []string{"DEFAULT"},
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Admins:              []string{createdBy},
+				ParentK8sName:       "parent-directory",
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.Directory{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.DirectoryKind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: wantResourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": dirGuid,
+						},
+					},
+					Spec: v1alpha1.DirectorySpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.DirectoryParameters{
+							DisplayName:       &displayName,
+							DirectoryAdmins:   []string{createdBy},
+							DirectoryFeatures: []string{"DEFAULT"},
+							DirectoryRef:      &v1.Reference{Name: "parent-directory"},
+						},
+					},
+				}),
+		},
+		{
+			name: "all fields missing",
+			dir: &Directory{
+				Directory:           &btpcli.Directory{},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+			},
+			want: func() *yaml.ResourceWithComment {
+				empty := ""
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.Directory{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.DirectoryKind,
+							APIVersion: v1alpha1.CRDGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resources.UndefinedName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": resources.UndefinedExternalName,
+							},
+						},
+						Spec: v1alpha1.DirectorySpec{
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+							},
+							ForProvider: v1alpha1.DirectoryParameters{
+								DisplayName: &empty,
+							},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingDisplayName)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				rwc.AddComment(resources.WarnUndefinedExternalName)
+				rwc.AddComment(resources.WarnMissingDirectoryAdmins)
+				return rwc
+			}(),
+		},
+		{
+			name: "comments inherited from original resource",
+			dir: func() *Directory {
+				rwc := yaml.NewResourceWithComment(nil)
+				rwc.AddComment(resources.WarnCannotResolveDirectory + ": " + parentGuid)
+				return &Directory{
+					Directory: &btpcli.Directory{
+						GUID:              dirGuid,
+						ParentGUID:        parentGuid,
+						GlobalAccountGUID: gaGuid,
+						DisplayName:       displayName,
+					},
+					ResourceWithComment: rwc,
+					Admins:              []string{createdBy},
+				}
+			}(),
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.Directory{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.DirectoryKind,
+							APIVersion: v1alpha1.CRDGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: wantResourceName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": dirGuid,
+							},
+						},
+						Spec: v1alpha1.DirectorySpec{
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+							},
+							ForProvider: v1alpha1.DirectoryParameters{
+								DisplayName:     &displayName,
+								DirectoryAdmins: []string{createdBy},
+								DirectoryGuid:   parentGuid,
+							},
+						},
+					})
+				rwc.AddComment(resources.WarnCannotResolveDirectory + ": " + parentGuid)
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertDirectoryResource(tt.dir)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotDir, gotOk := result.Resource().(*v1alpha1.Directory)
+			wantDir, wantOk := tt.want.Resource().(*v1alpha1.Directory)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.Directory")
+
+			// Final overall comparison.
+			r.Equal(wantDir, gotDir)
+		})
+	}
+}
+
+func TestSortParentFirst(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	gaGuid := "ga"
+	cache := resources.NewResourceCache[*Directory]()
+	for _, d := range []btpcli.Directory{
+		{GUID: "a-grandchild", ParentGUID: "b-child", GlobalAccountGUID: gaGuid},
+		{GUID: "b-child", ParentGUID: "c-root", GlobalAccountGUID: gaGuid},
+		{GUID: "c-root", ParentGUID: gaGuid, GlobalAccountGUID: gaGuid},
+		{GUID: "d-root", ParentGUID: gaGuid, GlobalAccountGUID: gaGuid},
+	} {
+		cache.Set(&Directory{Directory: &d, ResourceWithComment: yaml.NewResourceWithComment(nil)})
+	}
+
+	r.Equal([]string{"c-root", "d-root", "b-child", "a-grandchild"}, sortParentFirst(cache, cache.AllIDs()))
+}
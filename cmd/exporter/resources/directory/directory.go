@@ -0,0 +1,288 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/SAP/xp-clifford/cli/configparam"
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/erratt"
+	"github.com/SAP/xp-clifford/yaml"
+
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+const (
+	KindName = "directory"
+
+	// FeatureAuthorizations is the directory feature that enables user authorization management in a directory.
+	FeatureAuthorizations = "AUTHORIZATIONS"
+
+	adminRoleCollection = "Directory Administrator"
+)
+
+var (
+	fullCache     resources.ResourceCache[*Directory]
+	selectedCache resources.ResourceCache[*Directory]
+	registry      = resources.NewRegistry()
+
+	directoryParam = configparam.StringSlice(KindName, "BTP directory ID or regex expression for name.").
+		WithFlagName(KindName)
+)
+
+func init() {
+	resources.RegisterKind(exporter{})
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return directoryParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	cache, err := Get(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with directories: %w", err)
+	}
+	slog.DebugContext(ctx, "Directories in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no directories found"))
+		return nil
+	}
+
+	fc, err := getFullCache(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get full cache with directories: %w", err)
+	}
+
+	// Parent directories must be exported before their children.
+	for _, id := range sortParentFirst(fc, cache.AllIDs()) {
+		convert(ctx, btpClient, cache.Get(id), eventHandler, resolveReferences)
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*Directory], error) {
+	if selectedCache != nil {
+		return selectedCache, nil
+	}
+
+	fc, err := getFullCache(ctx, btpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get full cache with directories: %w", err)
+	}
+	slog.DebugContext(ctx, "Directories in full cache before selection", "count", fc.Len())
+
+	// Create a shallow copy of the full cache to keep only selected directories,
+	// so that the full cache remains unchanged for parent directories referenced by other resources.
+	cache := fc.Copy()
+
+	// Let the user select directories to export.
+	widgetValues := cache.ValuesForSelection()
+	directoryParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selectedDirectories, err := directoryParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", directoryParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected directories", "directories", selectedDirectories)
+
+	// Keep only selected directories in the cache.
+	cache.KeepSelectedOnly(selectedDirectories)
+	selectedCache = cache
+
+	return selectedCache, nil
+}
+
+func getFullCache(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*Directory], error) {
+	if fullCache != nil {
+		return fullCache, nil
+	}
+
+	// Retrieve all directories of the global account.
+	originals, err := btpClient.ListDirectories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get directories: %w", err)
+	}
+	slog.DebugContext(ctx, "Total directories returned by BTP CLI", "count", len(originals))
+
+	// Wrap directories for internal processing and caching.
+	directories := make([]*Directory, len(originals))
+	for i, d := range originals {
+		directories[i] = &Directory{
+			Directory:           &d,
+			ResourceWithComment: yaml.NewResourceWithComment(nil),
+		}
+	}
+
+	fullCache = resources.NewResourceCache[*Directory]()
+	fullCache.Store(directories...)
+
+	return fullCache, nil
+}
+
+// ExportDirectory exports the directory with the given ID, including its parent directories, if references are resolved.
+// It returns the name of the K8s resource of the exported directory.
+func ExportDirectory(ctx context.Context, btpClient btpcli.Client, directoryID string, eventHandler export.EventHandler, resolveReferences bool) (string, error) {
+	if directoryID == "" {
+		return "", fmt.Errorf("directory ID is not set")
+	}
+
+	cache, err := getFullCache(ctx, btpClient)
+	if err != nil {
+		return "", fmt.Errorf("failed to get full cache with directories: %w", err)
+	}
+
+	d := cache.Get(directoryID)
+	if d == nil {
+		return "", fmt.Errorf("directory not found by ID: %s", directoryID)
+	}
+
+	convert(ctx, btpClient, d, eventHandler, resolveReferences)
+
+	return d.GenerateK8sResourceName(), nil
+}
+
+func convert(ctx context.Context, btpClient btpcli.Client, d *Directory, eventHandler export.EventHandler, resolveReferences bool) {
+	if register(ctx, d) {
+		exportPrerequisiteResources(ctx, btpClient, d, eventHandler, resolveReferences)
+		addAdmins(ctx, btpClient, d, eventHandler)
+		eventHandler.Resource(convertDirectoryResource(d))
+	}
+}
+
+func register(ctx context.Context, d *Directory) bool {
+	success := registry.Register(d.GetID())
+	if !success {
+		slog.DebugContext(ctx, "Directory already exported", "id", d.GetID())
+	}
+	return success
+}
+
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, d *Directory, eventHandler export.EventHandler, resolveReferences bool) {
+	if !resolveReferences || !d.HasParentDirectory() {
+		return
+	}
+
+	// Export parent directory, so that it can be referenced.
+	parentName, err := ExportDirectory(ctx, btpClient, d.ParentGUID, eventHandler, resolveReferences)
+	if err != nil {
+		eventHandler.Warn(erratt.Errorf("cannot resolve parent directory reference: %w", err).With("directory", d.GetID()))
+		d.AddComment(resources.WarnCannotResolveDirectory + ": " + d.ParentGUID)
+		return
+	}
+
+	// Set parent directory reference.
+	d.ParentK8sName = parentName
+}
+
+func addAdmins(ctx context.Context, btpClient btpcli.Client, d *Directory, eventHandler export.EventHandler) {
+	if !d.HasFeature(FeatureAuthorizations) {
+		// API doesn't provide the list of admins for directories without user authorization management.
+		// Using CreatedBy as the only admin, like for subaccounts.
+		if d.CreatedBy != "" {
+			d.Admins = []string{d.CreatedBy}
+		}
+		return
+	}
+
+	rc, err := btpClient.GetDirectoryRoleCollection(ctx, d.GetID(), adminRoleCollection)
+	if err != nil {
+		eventHandler.Warn(erratt.Errorf("cannot retrieve directory admins: %w", err).With("directory", d.GetID()))
+		return
+	}
+
+	for _, u := range rc.UserReferences {
+		if u.Username != "" && !slices.Contains(d.Admins, u.Username) {
+			d.Admins = append(d.Admins, u.Username)
+		}
+	}
+	slices.Sort(d.Admins)
+}
+
+// sortParentFirst orders directory IDs so that each directory comes after its ancestors.
+// Directories on the same level are ordered by ID to keep the output stable.
+func sortParentFirst(cache resources.ResourceCache[*Directory], ids []string) []string {
+	sorted := slices.Clone(ids)
+	slices.SortStableFunc(sorted, func(a, b string) int {
+		return depth(cache, a) - depth(cache, b)
+	})
+	return sorted
+}
+
+func depth(cache resources.ResourceCache[*Directory], id string) int {
+	level := 0
+	visited := map[string]bool{}
+	for d := cache.Get(id); d != nil && d.HasParentDirectory() && !visited[d.GetID()]; d = cache.Get(d.ParentGUID) {
+		visited[d.GetID()] = true
+		level++
+	}
+	return level
+}
+
+type Directory struct {
+	*btpcli.Directory
+	*yaml.ResourceWithComment
+
+	// Admins of the directory, resolved during export.
+	Admins []string
+	// ParentK8sName is the K8s resource name of the parent directory,
+	// resolved during export and used as a reference in the generated manifest.
+	ParentK8sName string
+}
+
+var _ resources.BtpResource = &Directory{}
+
+func (d *Directory) GetID() string {
+	return d.GUID
+}
+
+func (d *Directory) GetDisplayName() string {
+	return d.DisplayName
+}
+
+func (d *Directory) GetExternalName() string {
+	if d.GetID() == "" {
+		return resources.UndefinedExternalName
+	}
+
+	return d.GetID()
+}
+
+func (d *Directory) GenerateK8sResourceName() string {
+	if d.GetDisplayName() == "" || d.GetID() == "" {
+		return resources.UndefinedName
+	}
+
+	// Directory display names are unique per parent only, so the GUID is part of the name.
+	rn, err := resources.GenerateK8sResourceName(d.GetID(), d.GetDisplayName())
+	if err != nil {
+		d.AddComment(fmt.Sprintf("cannot generate directory resource name: %s", err))
+	}
+
+	return rn
+}
+
+// HasParentDirectory returns true, if the directory is nested in another directory and not directly in the global account.
+func (d *Directory) HasParentDirectory() bool {
+	return d.ParentGUID != "" && d.ParentGUID != d.GlobalAccountGUID
+}
+
+// HasFeature returns true, if the given directory feature is enabled.
+func (d *Directory) HasFeature(feature string) bool {
+	return slices.Contains(d.DirectoryFeatures, feature)
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
 )
 
-func convertEntitlementResource(ctx context.Context, btpClient *btpcli.BtpCli, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertEntitlementResource(ctx context.Context, btpClient btpcli.Client, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 
 	serviceName := e.serviceName
 	servicePlanName := e.planName
@@ -89,7 +89,7 @@ func convertEntitlementResource(ctx context.Context, btpClient *btpcli.BtpCli, e
 	return managedEntitlement
 }
 
-func convertDefaultEntitlementResource(ctx context.Context, btpClient *btpcli.BtpCli, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertDefaultEntitlementResource(ctx context.Context, btpClient btpcli.Client, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 	serviceName := e.serviceName
 	servicePlanName := e.planName
 	subAccountGuid := e.assignment.EntityID
@@ -151,7 +151,7 @@ func convertDefaultEntitlementResource(ctx context.Context, btpClient *btpcli.Bt
 	return managedEntitlement
 }
 
-func resolveReference(ctx context.Context, btpClient *btpcli.BtpCli, entitlement *v1alpha1.EntitlementParameters) error {
+func resolveReference(ctx context.Context, btpClient btpcli.Client, entitlement *v1alpha1.EntitlementParameters) error {
 	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, entitlement.SubaccountGuid)
 	if err != nil {
 		return err
@@ -49,7 +49,7 @@ func (e exporter) KindName() string {
 	return KindName
 }
 
-func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHandler export.EventHandler, resolveReferences bool) error {
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
 	slog.DebugContext(ctx, "Export auto-assigned entitlements", "auto-assigned", autoAssignedParam.Value())
 
 	cache, err := Get(ctx, btpClient)
@@ -69,7 +69,7 @@ func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHan
 	return nil
 }
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*entitlement], error) {
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*entitlement], error) {
 	if selectedCache != nil {
 		return selectedCache, nil
 	}
@@ -103,7 +103,7 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	return selectedCache, nil
 }
 
-func getFullCache(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*entitlement], error) {
+func getFullCache(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*entitlement], error) {
 	if fullCache != nil {
 		return fullCache, nil
 	}
@@ -116,13 +116,15 @@ func getFullCache(ctx context.Context, btpClient *btpcli.BtpCli) (resources.Reso
 	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
 
 	// Retrieve service assignments for all selected subaccounts.
-	var svcs []btpcli.AssignedService
-	for _, saId := range saCache.AllIDs() {
+	svcs, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]btpcli.AssignedService, error) {
 		saAssignments, err := btpClient.ListServiceAssignments(ctx, saId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get entitlements for subaccount %s: %w", saId, err)
 		}
-		svcs = append(svcs, saAssignments...)
+		return saAssignments, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	slog.DebugContext(ctx, "Service assignments retrieved by BTP CLI", "count", len(svcs))
 
@@ -158,7 +160,7 @@ func serviceToEntitlement(assignments []btpcli.AssignedService) []*entitlement {
 	return entitlements
 }
 
-func ExportEntitlement(ctx context.Context, btpClient *btpcli.BtpCli, subaccountID string, serviceName string, planName string, eventHandler export.EventHandler, resolveReferences bool) error {
+func ExportEntitlement(ctx context.Context, btpClient btpcli.Client, subaccountID string, serviceName string, planName string, eventHandler export.EventHandler, resolveReferences bool) error {
 	e, found, err := getEntitlement(ctx, btpClient, subaccountID, serviceName, planName)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve cloud management instance for subaccount %s: %w", subaccountID, err)
@@ -173,13 +175,13 @@ func ExportEntitlement(ctx context.Context, btpClient *btpcli.BtpCli, subaccount
 	return nil
 }
 
-func convert(ctx context.Context, btpClient *btpcli.BtpCli, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) {
+func convert(ctx context.Context, btpClient btpcli.Client, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) {
 	if register(ctx, e) {
 		eventHandler.Resource(convertEntitlementResource(ctx, btpClient, e, eventHandler, resolveReferences))
 	}
 }
 
-func convertDefault(ctx context.Context, btpClient *btpcli.BtpCli, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) {
+func convertDefault(ctx context.Context, btpClient btpcli.Client, e *entitlement, eventHandler export.EventHandler, resolveReferences bool) {
 	if register(ctx, e) {
 		eventHandler.Resource(convertDefaultEntitlementResource(ctx, btpClient, e, eventHandler, resolveReferences))
 	}
@@ -193,7 +195,7 @@ func register(ctx context.Context, e *entitlement) bool {
 	return success
 }
 
-func getEntitlement(ctx context.Context, btpClient *btpcli.BtpCli, subaccountID string, serviceName string, planName string) (*entitlement, bool, error) {
+func getEntitlement(ctx context.Context, btpClient btpcli.Client, subaccountID string, serviceName string, planName string) (*entitlement, bool, error) {
 	cache, err := getFullCache(ctx, btpClient)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to get full cache with entitlements: %w", err)
@@ -23,7 +23,7 @@ type Kind interface {
 	// parameters. Then it collects the resource definitions
 	// through BTP Client. Finally, the resources are exported
 	// using the eventHandler.
-	Export(ctx context.Context, btpClient *btpcli.BtpCli, evHandler export.EventHandler, resolveReferences bool) error
+	Export(ctx context.Context, btpClient btpcli.Client, evHandler export.EventHandler, resolveReferences bool) error
 }
 
 var kinds = map[string]Kind{}
@@ -51,7 +51,7 @@ func KindNames() []string {
 }
 
 // ExportFn returns the export function of a given kind.
-func ExportFn(kind string) func(context.Context, *btpcli.BtpCli, export.EventHandler, bool) error {
+func ExportFn(kind string) func(context.Context, btpcli.Client, export.EventHandler, bool) error {
 	resource, ok := kinds[kind]
 	if !ok || resource == nil {
 		return nil
@@ -0,0 +1,125 @@
+package kymaenvironment
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/erratt"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
+)
+
+func convertKymaEnvResource(ctx context.Context, btpClient btpcli.Client, e *KymaEnvironment, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+	subAccountGuid := e.SubaccountGUID
+	resourceName := e.GenerateK8sResourceName()
+	externalName := e.GetExternalName()
+	planName := e.PlanName
+	envName := e.Name
+	cmName := e.CloudManagementName
+
+	kymaEnvInstance := yaml.NewResourceWithComment(
+		&v1alpha1.KymaEnvironment{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.KymaEnvironmentKind,
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.KymaEnvironmentSpec{
+				SubaccountGuid: subAccountGuid,
+				ForProvider: v1alpha1.KymaEnvironmentParameters{
+					PlanName: planName,
+					Name:     &envName,
+				},
+				CloudManagementRef: &v1.Reference{
+					Name: cmName,
+				},
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{
+						v1.ManagementActionObserve,
+					},
+					WriteConnectionSecretToReference: &v1.SecretReference{
+						Name:      resourceName,
+						Namespace: resources.DefaultSecretNamespace,
+					},
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	kymaEnvInstance.CloneComment(e)
+
+	// Comment the resource out, if any of the important fields is missing.
+	if subAccountGuid == "" {
+		kymaEnvInstance.AddComment(resources.WarnMissingSubaccountGuid)
+	}
+	if resourceName == resources.UndefinedName {
+		kymaEnvInstance.AddComment(resources.WarnUndefinedResourceName)
+	}
+	if externalName == "" {
+		kymaEnvInstance.AddComment(resources.WarnMissingExternalName)
+	}
+	if externalName == resources.UndefinedExternalName {
+		kymaEnvInstance.AddComment(resources.WarnUndefinedExternalName)
+	}
+	if planName == "" {
+		kymaEnvInstance.AddComment(resources.WarnMissingEnvironmentPlanName)
+	}
+	if envName == "" {
+		kymaEnvInstance.AddComment(resources.WarnMissingEnvironmentName)
+	}
+	if cmName == "" {
+		kymaEnvInstance.AddComment(resources.WarnMissingCloudManagementName)
+	}
+
+	// Fill the optional fields.
+	forProvider := &kymaEnvInstance.Resource().(*v1alpha1.KymaEnvironment).Spec.ForProvider
+
+	// LandscapeLabel
+	if e.LandscapeLabel != "" {
+		landscape := e.LandscapeLabel
+		forProvider.LandscapeLabel = &landscape
+	}
+
+	// Parameters
+	if len(e.Parameters.Raw) > 0 {
+		forProvider.Parameters = runtime.RawExtension{
+			Raw: e.Parameters.Raw,
+		}
+	}
+
+	// Reference subaccount resource, if requested.
+	if resolveReferences {
+		if err := resolveReference(ctx, btpClient, &kymaEnvInstance.Object.(*v1alpha1.KymaEnvironment).Spec); err != nil {
+			eventHandler.Warn(erratt.Errorf("cannot resolve subaccount reference: %w", err).With("kyma environment", e.GetID()))
+			kymaEnvInstance.AddComment(resources.WarnCannotResolveSubaccount + ": " + e.SubaccountGUID)
+		}
+	}
+
+	return kymaEnvInstance
+}
+
+func resolveReference(ctx context.Context, btpClient btpcli.Client, spec *v1alpha1.KymaEnvironmentSpec) error {
+	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, spec.SubaccountGuid)
+	if err != nil {
+		return err
+	}
+
+	spec.SubaccountRef = &v1.Reference{
+		Name: saName,
+	}
+	spec.SubaccountGuid = ""
+
+	return nil
+}
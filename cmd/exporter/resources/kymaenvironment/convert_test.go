@@ -0,0 +1,292 @@
+package kymaenvironment
+
+import (
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func TestConvertKymaEnvResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	// Test data
+	envID := "a1b2c3d4-5678-9abc-def0-123456789abc"
+	envName := "kyma-runtime-1"
+	subaccountGUID := "sa-12345678-1234-1234-1234-123456789"
+	planName := "azure"
+	landscapeLabel := "cf-eu10"
+	parameters := []byte(`{"name":"kyma-runtime-1","region":"westeurope"}`)
+	cmName := "cloud-management-ref"
+	resourceName := envName + "." + envID
+
+	tests := []struct {
+		name    string
+		kymaEnv *KymaEnvironment
+		want    *yaml.ResourceWithComment
+	}{
+		{
+			name: "all fields present",
+			kymaEnv: &KymaEnvironment{
+				EnvironmentInstance: &btpcli.EnvironmentInstance{
+					ID:             envID,
+					Name:           envName,
+					SubaccountGUID: subaccountGUID,
+					PlanName:       planName,
+					LandscapeLabel: landscapeLabel,
+					Parameters: btpcli.Parameters{
+						Raw: parameters,
+					},
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				CloudManagementName: cmName,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.KymaEnvironment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.KymaEnvironmentKind,
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": envID,
+						},
+					},
+					Spec: v1alpha1.KymaEnvironmentSpec{
+						SubaccountGuid: subaccountGUID,
+						ForProvider: v1alpha1.KymaEnvironmentParameters{
+							PlanName:       planName,
+							Name:           &envName,
+							LandscapeLabel: &landscapeLabel,
+							Parameters:     runtime.RawExtension{Raw: parameters},
+						},
+						CloudManagementRef: &v1.Reference{
+							Name: cmName,
+						},
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+							WriteConnectionSecretToReference: &v1.SecretReference{
+								Name:      resourceName,
+								Namespace: resources.DefaultSecretNamespace,
+							},
+						},
+					},
+				}),
+		},
+		{
+			name: "missing plan name",
+			kymaEnv: &KymaEnvironment{
+				EnvironmentInstance: &btpcli.EnvironmentInstance{
+					ID:             envID,
+					Name:           envName,
+					SubaccountGUID: subaccountGUID,
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				CloudManagementName: cmName,
+			},
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.KymaEnvironment{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.KymaEnvironmentKind,
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resourceName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": envID,
+							},
+						},
+						Spec: v1alpha1.KymaEnvironmentSpec{
+							SubaccountGuid: subaccountGUID,
+							ForProvider: v1alpha1.KymaEnvironmentParameters{
+								Name: &envName,
+							},
+							CloudManagementRef: &v1.Reference{
+								Name: cmName,
+							},
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+								WriteConnectionSecretToReference: &v1.SecretReference{
+									Name:      resourceName,
+									Namespace: resources.DefaultSecretNamespace,
+								},
+							},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingEnvironmentPlanName)
+				return rwc
+			}(),
+		},
+		{
+			name: "missing cloud management name",
+			kymaEnv: &KymaEnvironment{
+				EnvironmentInstance: &btpcli.EnvironmentInstance{
+					ID:             envID,
+					Name:           envName,
+					SubaccountGUID: subaccountGUID,
+					PlanName:       planName,
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+			},
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.KymaEnvironment{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.KymaEnvironmentKind,
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resourceName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": envID,
+							},
+						},
+						Spec: v1alpha1.KymaEnvironmentSpec{
+							SubaccountGuid: subaccountGUID,
+							ForProvider: v1alpha1.KymaEnvironmentParameters{
+								PlanName: planName,
+								Name:     &envName,
+							},
+							CloudManagementRef: &v1.Reference{},
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+								WriteConnectionSecretToReference: &v1.SecretReference{
+									Name:      resourceName,
+									Namespace: resources.DefaultSecretNamespace,
+								},
+							},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingCloudManagementName)
+				return rwc
+			}(),
+		},
+		{
+			name: "all fields missing",
+			kymaEnv: &KymaEnvironment{
+				EnvironmentInstance: &btpcli.EnvironmentInstance{},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+			},
+			want: func() *yaml.ResourceWithComment {
+				empty := ""
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.KymaEnvironment{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.KymaEnvironmentKind,
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resources.UndefinedName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": resources.UndefinedExternalName,
+							},
+						},
+						Spec: v1alpha1.KymaEnvironmentSpec{
+							ForProvider: v1alpha1.KymaEnvironmentParameters{
+								Name: &empty,
+							},
+							CloudManagementRef: &v1.Reference{},
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+								WriteConnectionSecretToReference: &v1.SecretReference{
+									Name:      resources.UndefinedName,
+									Namespace: resources.DefaultSecretNamespace,
+								},
+							},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingSubaccountGuid)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				rwc.AddComment(resources.WarnUndefinedExternalName)
+				rwc.AddComment(resources.WarnMissingEnvironmentPlanName)
+				rwc.AddComment(resources.WarnMissingEnvironmentName)
+				rwc.AddComment(resources.WarnMissingCloudManagementName)
+				return rwc
+			}(),
+		},
+		{
+			name: "comments inherited from original resource",
+			kymaEnv: func() *KymaEnvironment {
+				rwc := yaml.NewResourceWithComment(nil)
+				rwc.AddComment("original comment")
+				return &KymaEnvironment{
+					EnvironmentInstance: &btpcli.EnvironmentInstance{
+						ID:             envID,
+						Name:           envName,
+						SubaccountGUID: subaccountGUID,
+						PlanName:       planName,
+					},
+					ResourceWithComment: rwc,
+					CloudManagementName: cmName,
+				}
+			}(),
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.KymaEnvironment{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.KymaEnvironmentKind,
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resourceName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": envID,
+							},
+						},
+						Spec: v1alpha1.KymaEnvironmentSpec{
+							SubaccountGuid: subaccountGUID,
+							ForProvider: v1alpha1.KymaEnvironmentParameters{
+								PlanName: planName,
+								Name:     &envName,
+							},
+							CloudManagementRef: &v1.Reference{
+								Name: cmName,
+							},
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+								WriteConnectionSecretToReference: &v1.SecretReference{
+									Name:      resourceName,
+									Namespace: resources.DefaultSecretNamespace,
+								},
+							},
+						},
+					})
+				rwc.AddComment("original comment")
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertKymaEnvResource(t.Context(), nil, tt.kymaEnv, nil, false)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotKymaEnv, gotOk := result.Resource().(*v1alpha1.KymaEnvironment)
+			wantKymaEnv, wantOk := tt.want.Resource().(*v1alpha1.KymaEnvironment)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.KymaEnvironment")
+
+			// Final overall comparison.
+			r.Equal(wantKymaEnv, gotKymaEnv)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package kymaenvironment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/SAP/xp-clifford/cli/configparam"
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/cloudmanagement"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
+)
+
+const (
+	KindName        = "kyma-environment"
+	KymaServiceName = "kyma"
+)
+
+var (
+	kymaEnvCache resources.ResourceCache[*KymaEnvironment]
+	registry     = resources.NewRegistry()
+	kymaEnvParam = configparam.StringSlice(KindName, "Kyma environment ID or regex expression for name.").
+		WithFlagName(KindName)
+)
+
+func init() {
+	resources.RegisterKind(exporter{})
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return kymaEnvParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	cache, err := Get(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with Kyma environment instances: %w", err)
+	}
+	slog.DebugContext(ctx, "Kyma environment instances in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no Kyma environment instances found"))
+	} else {
+		for _, e := range cache.All() {
+			Convert(ctx, btpClient, e, eventHandler, resolveReferences)
+		}
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*KymaEnvironment], error) {
+	if kymaEnvCache != nil {
+		return kymaEnvCache, nil
+	}
+
+	// Let the user select relevant subaccounts.
+	saCache, err := subaccount.Get(ctx, btpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve subaccount cache: %w", err)
+	}
+	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
+
+	// Retrieve all environment instances from selected subaccounts.
+	btpEnvironments, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]btpcli.EnvironmentInstance, error) {
+		instances, err := btpClient.ListEnvironmentInstances(ctx, saId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get environment instances for subaccount %s: %w", saId, err)
+		}
+		return instances, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.DebugContext(ctx, "Total environments returned by BTP CLI", "count", len(btpEnvironments))
+
+	// Wrap Kyma environment instances for internal processing and caching.
+	// Filter out non-Kyma environments.
+	instances := make([]*KymaEnvironment, 0, len(btpEnvironments))
+	for _, e := range btpEnvironments {
+		if !isKymaEnvironment(&e) {
+			continue
+		}
+		instances = append(instances, &KymaEnvironment{
+			EnvironmentInstance: &e,
+			ResourceWithComment: yaml.NewResourceWithComment(nil),
+		})
+	}
+
+	// Create a cache and store all Kyma environment instances.
+	cache := resources.NewResourceCache[*KymaEnvironment]()
+	cache.Store(instances...)
+
+	// Let the user select Kyma environments to export.
+	widgetValues := cache.ValuesForSelection()
+	kymaEnvParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selectedEnvironments, err := kymaEnvParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", kymaEnvParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected Kyma environments", "environments", selectedEnvironments)
+
+	// Keep only selected Kyma environments in the cache.
+	cache.KeepSelectedOnly(selectedEnvironments)
+	kymaEnvCache = cache
+
+	return kymaEnvCache, nil
+}
+
+func isKymaEnvironment(instance *btpcli.EnvironmentInstance) bool {
+	return instance.EnvironmentType == KymaServiceName
+}
+
+// Convert exports the given Kyma environment instance, unless it has been exported already.
+func Convert(ctx context.Context, btpClient btpcli.Client, e *KymaEnvironment, eventHandler export.EventHandler, resolveReferences bool) {
+	if register(ctx, e) {
+		exportPrerequisiteResources(ctx, btpClient, e, eventHandler, resolveReferences)
+		eventHandler.Resource(convertKymaEnvResource(ctx, btpClient, e, eventHandler, resolveReferences))
+	}
+}
+
+func register(ctx context.Context, e *KymaEnvironment) bool {
+	success := registry.Register(e.GetID())
+	if !success {
+		slog.DebugContext(ctx, "Kyma environment already exported", "subaccount", e.SubaccountGUID, "instance", e.GetID())
+	}
+	return success
+}
+
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, e *KymaEnvironment, eventHandler export.EventHandler, resolveReferences bool) {
+	// Export subaccount Cloud Management resource.
+	cmName, err := cloudmanagement.ExportInstanceForSubaccount(ctx, btpClient, e.SubaccountGUID, eventHandler, resolveReferences)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to export cloud management for subaccount", "subaccount", e.SubaccountGUID, "error", err)
+	}
+
+	// Set Cloud Management name in Kyma environment resource for reference.
+	if cmName != "" {
+		e.CloudManagementName = cmName
+	}
+}
+
+type KymaEnvironment struct {
+	*btpcli.EnvironmentInstance
+	*yaml.ResourceWithComment
+	CloudManagementName string
+}
+
+var _ resources.BtpResource = &KymaEnvironment{}
+
+func (e *KymaEnvironment) GetID() string {
+	return e.ID
+}
+
+func (e *KymaEnvironment) GetDisplayName() string {
+	return e.Name
+}
+
+func (e *KymaEnvironment) GetExternalName() string {
+	if e.GetID() == "" {
+		return resources.UndefinedExternalName
+	}
+
+	return e.GetID()
+}
+
+func (e *KymaEnvironment) GenerateK8sResourceName() string {
+	if e.GetDisplayName() == "" || e.GetID() == "" {
+		return resources.UndefinedName
+	}
+
+	// Kyma environment names are unique per subaccount only, so the instance ID is part of the name.
+	resourceName, err := resources.GenerateK8sResourceName(e.GetID(), e.GetDisplayName())
+	if err != nil {
+		e.AddComment(fmt.Sprintf("cannot generate Kyma environment resource name: %s", err))
+	}
+
+	return resourceName
+}
@@ -0,0 +1,135 @@
+package kymamodule
+
+import (
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func convertKymaModuleResource(m *KymaModule) *yaml.ResourceWithComment {
+	resourceName := m.GenerateK8sResourceName()
+	externalName := m.GetExternalName()
+	moduleName := m.Name
+	bindingName := m.BindingName
+
+	module := yaml.NewResourceWithComment(
+		&v1alpha1.KymaModule{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.KymaModuleKind,
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.KymaModuleSpec{
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{
+						v1.ManagementActionObserve,
+					},
+				},
+				ForProvider: v1alpha1.KymaModuleParameters{
+					Name: moduleName,
+				},
+				KymaEnvironmentBindingRef: &v1.Reference{
+					Name: bindingName,
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	module.CloneComment(m)
+
+	// Comment the resource out, if any of the required fields is missing.
+	if moduleName == "" {
+		module.AddComment(resources.WarnMissingModuleName)
+	}
+	if resourceName == resources.UndefinedName {
+		module.AddComment(resources.WarnUndefinedResourceName)
+	}
+	if externalName == resources.UndefinedExternalName {
+		module.AddComment(resources.WarnUndefinedExternalName)
+	}
+	if bindingName == "" || bindingName == resources.UndefinedName {
+		module.AddComment(resources.WarnMissingBindingReference)
+	}
+
+	// Fill the optional fields. Empty values mean the defaults of the Kyma runtime.
+	forProvider := &module.Resource().(*v1alpha1.KymaModule).Spec.ForProvider
+
+	// Channel
+	if m.Channel != "" {
+		channel := m.Channel
+		forProvider.Channel = &channel
+	}
+
+	// CustomResourcePolicy
+	if m.CustomResourcePolicy != "" {
+		policy := m.CustomResourcePolicy
+		forProvider.CustomResourcePolicy = &policy
+	}
+
+	return module
+}
+
+func convertKymaEnvBindingResource(b *kymaEnvironmentBinding) *yaml.ResourceWithComment {
+	resourceName := b.GenerateK8sResourceName()
+	envID := b.GetID()
+	envName := b.EnvironmentK8sName
+	cmName := b.Environment.CloudManagementName
+
+	// The binding has no external name and no Observe management policy,
+	// because the provider has to create a new binding to retrieve a kubeconfig.
+	binding := yaml.NewResourceWithComment(
+		&v1alpha1.KymaEnvironmentBinding{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.KymaEnvironmentBindingKind,
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+			},
+			Spec: v1alpha1.KymaEnvironmentBindingSpec{
+				ResourceSpec: v1.ResourceSpec{
+					WriteConnectionSecretToReference: &v1.SecretReference{
+						Name:      resourceName,
+						Namespace: resources.DefaultSecretNamespace,
+					},
+				},
+				CloudManagementRef: &v1.Reference{
+					Name: cmName,
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	binding.CloneComment(b)
+
+	// Comment the resource out, if any of the required fields is missing.
+	if envID == "" {
+		binding.AddComment(resources.WarnMissingKymaEnvironmentId)
+	}
+	if resourceName == resources.UndefinedName {
+		binding.AddComment(resources.WarnUndefinedResourceName)
+	}
+	if cmName == "" {
+		binding.AddComment(resources.WarnMissingCloudManagementName)
+	}
+
+	// Kyma environment, either referenced or by ID.
+	spec := &binding.Resource().(*v1alpha1.KymaEnvironmentBinding).Spec
+	if envName != "" && envName != resources.UndefinedName {
+		spec.KymaEnvironmentRef = &v1.Reference{
+			Name: envName,
+		}
+	} else {
+		spec.KymaEnvironmentId = envID
+	}
+
+	return binding
+}
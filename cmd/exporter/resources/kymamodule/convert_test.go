@@ -0,0 +1,324 @@
+package kymamodule
+
+import (
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/kymaenvironment"
+	kymaclient "github.com/sap/crossplane-provider-btp/internal/clients/kymamodule"
+)
+
+func TestConvertKymaModuleResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	// Test data
+	envID := "a1b2c3d4-5678-9abc-def0-123456789abc"
+	moduleName := "btp-operator"
+	channel := "fast"
+	policy := "CreateAndDelete"
+	bindingName := "kyma-runtime-1-binding." + envID
+	resourceName := moduleName + "." + envID
+
+	env := &kymaenvironment.KymaEnvironment{
+		EnvironmentInstance: &btpcli.EnvironmentInstance{
+			ID:   envID,
+			Name: "kyma-runtime-1",
+		},
+		ResourceWithComment: yaml.NewResourceWithComment(nil),
+	}
+
+	tests := []struct {
+		name   string
+		module *KymaModule
+		want   *yaml.ResourceWithComment
+	}{
+		{
+			name: "all fields present",
+			module: &KymaModule{
+				Module: &kymaclient.Module{
+					Name:                 moduleName,
+					Channel:              channel,
+					CustomResourcePolicy: policy,
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Environment:         env,
+				BindingName:         bindingName,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.KymaModule{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.KymaModuleKind,
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": moduleName,
+						},
+					},
+					Spec: v1alpha1.KymaModuleSpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.KymaModuleParameters{
+							Name:                 moduleName,
+							Channel:              &channel,
+							CustomResourcePolicy: &policy,
+						},
+						KymaEnvironmentBindingRef: &v1.Reference{
+							Name: bindingName,
+						},
+					},
+				}),
+		},
+		{
+			name: "default channel and policy",
+			module: &KymaModule{
+				Module: &kymaclient.Module{
+					Name: moduleName,
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Environment:         env,
+				BindingName:         bindingName,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.KymaModule{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.KymaModuleKind,
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": moduleName,
+						},
+					},
+					Spec: v1alpha1.KymaModuleSpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.KymaModuleParameters{
+							Name: moduleName,
+						},
+						KymaEnvironmentBindingRef: &v1.Reference{
+							Name: bindingName,
+						},
+					},
+				}),
+		},
+		{
+			name: "all fields missing",
+			module: &KymaModule{
+				Module:              &kymaclient.Module{},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Environment: &kymaenvironment.KymaEnvironment{
+					EnvironmentInstance: &btpcli.EnvironmentInstance{},
+					ResourceWithComment: yaml.NewResourceWithComment(nil),
+				},
+			},
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.KymaModule{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.KymaModuleKind,
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resources.UndefinedName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": resources.UndefinedExternalName,
+							},
+						},
+						Spec: v1alpha1.KymaModuleSpec{
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+							},
+							KymaEnvironmentBindingRef: &v1.Reference{},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingModuleName)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				rwc.AddComment(resources.WarnUndefinedExternalName)
+				rwc.AddComment(resources.WarnMissingBindingReference)
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertKymaModuleResource(tt.module)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotModule, gotOk := result.Resource().(*v1alpha1.KymaModule)
+			wantModule, wantOk := tt.want.Resource().(*v1alpha1.KymaModule)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.KymaModule")
+
+			// Final overall comparison.
+			r.Equal(wantModule, gotModule)
+		})
+	}
+}
+
+func TestConvertKymaEnvBindingResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	// Test data
+	envID := "a1b2c3d4-5678-9abc-def0-123456789abc"
+	envName := "kyma-runtime-1"
+	envK8sName := envName + "." + envID
+	cmName := "cloud-management-ref"
+	resourceName := envName + "-binding." + envID
+
+	newEnv := func(id, name, cmName string) *kymaenvironment.KymaEnvironment {
+		return &kymaenvironment.KymaEnvironment{
+			EnvironmentInstance: &btpcli.EnvironmentInstance{
+				ID:   id,
+				Name: name,
+			},
+			ResourceWithComment: yaml.NewResourceWithComment(nil),
+			CloudManagementName: cmName,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		binding *kymaEnvironmentBinding
+		want    *yaml.ResourceWithComment
+	}{
+		{
+			name: "kyma environment referenced by ID",
+			binding: &kymaEnvironmentBinding{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Environment:         newEnv(envID, envName, cmName),
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.KymaEnvironmentBinding{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.KymaEnvironmentBindingKind,
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+					},
+					Spec: v1alpha1.KymaEnvironmentBindingSpec{
+						ResourceSpec: v1.ResourceSpec{
+							WriteConnectionSecretToReference: &v1.SecretReference{
+								Name:      resourceName,
+								Namespace: resources.DefaultSecretNamespace,
+							},
+						},
+						KymaEnvironmentId: envID,
+						CloudManagementRef: &v1.Reference{
+							Name: cmName,
+						},
+					},
+				}),
+		},
+		{
+			name: "kyma environment resolved to reference",
+			binding: &kymaEnvironmentBinding{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Environment:         newEnv(envID, envName, cmName),
+				EnvironmentK8sName:  envK8sName,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.KymaEnvironmentBinding{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.KymaEnvironmentBindingKind,
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+					},
+					Spec: v1alpha1.KymaEnvironmentBindingSpec{
+						ResourceSpec: v1.ResourceSpec{
+							WriteConnectionSecretToReference: &v1.SecretReference{
+								Name:      resourceName,
+								Namespace: resources.DefaultSecretNamespace,
+							},
+						},
+						KymaEnvironmentRef: &v1.Reference{
+							Name: envK8sName,
+						},
+						CloudManagementRef: &v1.Reference{
+							Name: cmName,
+						},
+					},
+				}),
+		},
+		{
+			name: "all fields missing",
+			binding: &kymaEnvironmentBinding{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Environment:         newEnv("", "", ""),
+			},
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.KymaEnvironmentBinding{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.KymaEnvironmentBindingKind,
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resources.UndefinedName,
+						},
+						Spec: v1alpha1.KymaEnvironmentBindingSpec{
+							ResourceSpec: v1.ResourceSpec{
+								WriteConnectionSecretToReference: &v1.SecretReference{
+									Name:      resources.UndefinedName,
+									Namespace: resources.DefaultSecretNamespace,
+								},
+							},
+							CloudManagementRef: &v1.Reference{},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingKymaEnvironmentId)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				rwc.AddComment(resources.WarnMissingCloudManagementName)
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertKymaEnvBindingResource(tt.binding)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotBinding, gotOk := result.Resource().(*v1alpha1.KymaEnvironmentBinding)
+			wantBinding, wantOk := tt.want.Resource().(*v1alpha1.KymaEnvironmentBinding)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.KymaEnvironmentBinding")
+
+			// Final overall comparison.
+			r.Equal(wantBinding, gotBinding)
+		})
+	}
+}
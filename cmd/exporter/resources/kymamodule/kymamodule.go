@@ -0,0 +1,225 @@
+package kymamodule
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/SAP/xp-clifford/cli/configparam"
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/erratt"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/cloudmanagement"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/kymaenvironment"
+	kymaclient "github.com/sap/crossplane-provider-btp/internal/clients/kymamodule"
+)
+
+const (
+	KindName = "kyma-module"
+)
+
+var (
+	moduleCache     resources.ResourceCache[*KymaModule]
+	bindingRegistry = resources.NewRegistry()
+	moduleParam     = configparam.StringSlice(KindName, "Kyma module name or regex expression for name.").
+		WithFlagName(KindName)
+)
+
+func init() {
+	resources.RegisterKind(exporter{})
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return moduleParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	cache, err := Get(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with Kyma modules: %w", err)
+	}
+	slog.DebugContext(ctx, "Kyma modules in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no Kyma modules found"))
+	} else {
+		for _, m := range cache.All() {
+			exportPrerequisiteResources(ctx, btpClient, m, eventHandler, resolveReferences)
+			eventHandler.Resource(convertKymaModuleResource(m))
+		}
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*KymaModule], error) {
+	if moduleCache != nil {
+		return moduleCache, nil
+	}
+
+	// Let the user select relevant Kyma environments.
+	envCache, err := kymaenvironment.Get(ctx, btpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve Kyma environment cache: %w", err)
+	}
+	slog.DebugContext(ctx, "Kyma environments in cache after user selection", "count", envCache.Len())
+
+	// Retrieve modules enabled in the default Kyma CR of each selected Kyma runtime.
+	// A runtime that cannot be reached must not prevent the export of the other runtimes.
+	var modules []*KymaModule
+	for _, envID := range envCache.AllIDs() {
+		env := envCache.Get(envID)
+		enabled, err := btpClient.ListKymaModules(ctx, env.EnvironmentInstance)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to retrieve Kyma modules", "kyma environment", envID, "error", err)
+			continue
+		}
+		for _, m := range enabled {
+			modules = append(modules, &KymaModule{
+				Module:              &m,
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				Environment:         env,
+			})
+		}
+	}
+	slog.DebugContext(ctx, "Total Kyma modules returned by Kyma runtimes", "count", len(modules))
+
+	// Create a cache and store all Kyma modules.
+	cache := resources.NewResourceCache[*KymaModule]()
+	cache.Store(modules...)
+
+	// Let the user select Kyma modules to export.
+	widgetValues := cache.ValuesForSelection()
+	moduleParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selectedModules, err := moduleParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", moduleParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected Kyma modules", "modules", selectedModules)
+
+	// Keep only selected Kyma modules in the cache.
+	cache.KeepSelectedOnly(selectedModules)
+	moduleCache = cache
+
+	return moduleCache, nil
+}
+
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, m *KymaModule, eventHandler export.EventHandler, resolveReferences bool) {
+	binding := &kymaEnvironmentBinding{
+		Environment:         m.Environment,
+		ResourceWithComment: yaml.NewResourceWithComment(nil),
+	}
+
+	if registerBinding(ctx, binding) {
+		exportBindingPrerequisiteResources(ctx, btpClient, binding, eventHandler, resolveReferences)
+		eventHandler.Resource(convertKymaEnvBindingResource(binding))
+	}
+
+	// Set binding reference.
+	m.BindingName = binding.GenerateK8sResourceName()
+}
+
+func registerBinding(ctx context.Context, b *kymaEnvironmentBinding) bool {
+	success := bindingRegistry.Register(b.GetID())
+	if !success {
+		slog.DebugContext(ctx, "Kyma environment binding already exported", "kyma environment", b.GetID())
+	}
+	return success
+}
+
+func exportBindingPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, b *kymaEnvironmentBinding, eventHandler export.EventHandler, resolveReferences bool) {
+	env := b.Environment
+
+	// Export Kyma environment, so that it can be referenced.
+	if resolveReferences {
+		kymaenvironment.Convert(ctx, btpClient, env, eventHandler, resolveReferences)
+		b.EnvironmentK8sName = env.GenerateK8sResourceName()
+	}
+
+	// Export subaccount Cloud Management resource, unless it has been done for the Kyma environment already.
+	if env.CloudManagementName == "" {
+		cmName, err := cloudmanagement.ExportInstanceForSubaccount(ctx, btpClient, env.SubaccountGUID, eventHandler, resolveReferences)
+		if err != nil {
+			eventHandler.Warn(erratt.Errorf("cannot export cloud management: %w", err).With("subaccount", env.SubaccountGUID))
+		}
+		env.CloudManagementName = cmName
+	}
+}
+
+type KymaModule struct {
+	*kymaclient.Module
+	*yaml.ResourceWithComment
+	Environment *kymaenvironment.KymaEnvironment
+	BindingName string
+}
+
+var _ resources.BtpResource = &KymaModule{}
+
+// GetID returns the ID of the module, which is unique across Kyma runtimes.
+func (m *KymaModule) GetID() string {
+	return m.Environment.GetID() + "/" + m.Name
+}
+
+func (m *KymaModule) GetDisplayName() string {
+	return m.Name
+}
+
+func (m *KymaModule) GetExternalName() string {
+	if m.Name == "" {
+		return resources.UndefinedExternalName
+	}
+
+	return m.Name
+}
+
+func (m *KymaModule) GenerateK8sResourceName() string {
+	if m.GetDisplayName() == "" || m.Environment.GetID() == "" {
+		return resources.UndefinedName
+	}
+
+	// The same module is usually enabled in several Kyma runtimes, so the Kyma environment ID is part of the name.
+	resourceName, err := resources.GenerateK8sResourceName(m.Environment.GetID(), m.GetDisplayName())
+	if err != nil {
+		m.AddComment(fmt.Sprintf("cannot generate Kyma module resource name: %s", err))
+	}
+
+	return resourceName
+}
+
+// kymaEnvironmentBinding provides the kubeconfig that Kyma modules use to access the Kyma runtime.
+// Existing bindings cannot be imported, so a new one is always created for each Kyma environment.
+type kymaEnvironmentBinding struct {
+	*yaml.ResourceWithComment
+	Environment        *kymaenvironment.KymaEnvironment
+	EnvironmentK8sName string
+}
+
+func (b *kymaEnvironmentBinding) GetID() string {
+	return b.Environment.GetID()
+}
+
+func (b *kymaEnvironmentBinding) GenerateK8sResourceName() string {
+	if b.Environment.GetDisplayName() == "" || b.GetID() == "" {
+		return resources.UndefinedName
+	}
+
+	resourceName, err := resources.GenerateK8sResourceName(b.GetID(), b.Environment.GetDisplayName()+"-binding")
+	if err != nil {
+		b.AddComment(fmt.Sprintf("cannot generate Kyma environment binding resource name: %s", err))
+	}
+
+	return resourceName
+}
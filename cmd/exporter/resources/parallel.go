@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"context"
+	"slices"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultWorkers is the default number of subaccounts that are processed in parallel.
+const DefaultWorkers = 4
+
+var workers = DefaultWorkers
+
+// SetWorkers sets the number of subaccounts that are processed in parallel.
+// Values less than 1 disable parallel processing.
+func SetWorkers(n int) {
+	workers = max(n, 1)
+}
+
+// ListPerSubaccount calls list for each subaccount in parallel, bounded by the configured number of workers.
+// The results are concatenated in the order of the given subaccount IDs, so that the output stays deterministic.
+// The first error cancels the remaining calls and is returned.
+func ListPerSubaccount[T any](ctx context.Context, subaccountIDs []string, list func(ctx context.Context, subaccountID string) ([]T, error)) ([]T, error) {
+	results := make([][]T, len(subaccountIDs))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+	for i, id := range subaccountIDs {
+		g.Go(func() error {
+			r, err := list(gctx, id)
+			if err != nil {
+				return err
+			}
+			results[i] = r
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return slices.Concat(results...), nil
+}
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPerSubaccount(t *testing.T) {
+	SetWorkers(3)
+	t.Cleanup(func() { SetWorkers(DefaultWorkers) })
+
+	ids := []string{"sa-1", "sa-2", "sa-3", "sa-4", "sa-5", "sa-6"}
+
+	t.Run("results in subaccount order", func(t *testing.T) {
+		r := require.New(t)
+
+		var running, maxRunning atomic.Int32
+		got, err := ListPerSubaccount(t.Context(), ids, func(_ context.Context, id string) ([]string, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Duration(rand.IntN(5)) * time.Millisecond)
+			return []string{id + "/a", id + "/b"}, nil
+		})
+		r.NoError(err)
+		r.Equal([]string{
+			"sa-1/a", "sa-1/b", "sa-2/a", "sa-2/b", "sa-3/a", "sa-3/b",
+			"sa-4/a", "sa-4/b", "sa-5/a", "sa-5/b", "sa-6/a", "sa-6/b",
+		}, got)
+		r.LessOrEqual(maxRunning.Load(), int32(3))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := require.New(t)
+
+		_, err := ListPerSubaccount(t.Context(), ids, func(_ context.Context, id string) ([]string, error) {
+			if id == "sa-4" {
+				return nil, errors.New("failed")
+			}
+			return []string{id}, nil
+		})
+		r.EqualError(err, "failed")
+	})
+}
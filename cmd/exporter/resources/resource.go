@@ -36,6 +36,19 @@ const (
 	WarnMissingEnvironmentName        = "WARNING: environment name is missing"
 	WarnMissingCloudManagementName    = "WARNING: cloud management reference is missing"
 	WarnDefaultEntitlementEnableFalse = "WARNING: entitlement does not have 'enable' field set to true"
+	WarnCannotResolveDirectory        = "WARNING: cannot resolve directory ID to a resource name"
+	WarnMissingDisplayName            = "WARNING: display name is missing"
+	WarnMissingDirectoryAdmins        = "WARNING: directory admins are missing"
+	WarnMissingEnvironmentPlanName    = "WARNING: environment plan name is missing"
+	WarnMissingModuleName             = "WARNING: Kyma module name is missing"
+	WarnMissingKymaEnvironmentId      = "WARNING: Kyma environment ID is missing"
+	WarnMissingBindingReference       = "WARNING: Kyma environment binding reference is missing"
+	WarnMissingAppName                = "WARNING: application name is missing"
+	WarnSubscriptionNotSubscribed     = "WARNING: subscription is not in SUBSCRIBED state"
+	WarnMissingRoleCollectionName     = "WARNING: role collection name is missing"
+	WarnMissingApiCredentialName      = "WARNING: subaccount API credential reference is missing"
+	WarnMissingOrigin                 = "WARNING: identity provider origin is missing"
+	WarnMissingUserOrGroupName        = "WARNING: user or group name is missing"
 )
 
 type BtpResource interface {
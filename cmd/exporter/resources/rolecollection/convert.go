@@ -0,0 +1,86 @@
+package rolecollection
+
+import (
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func convertRoleCollectionResource(rc *RoleCollection) *yaml.ResourceWithComment {
+	resourceName := rc.GenerateK8sResourceName()
+	externalName := rc.GetExternalName()
+	rcName := rc.Name
+	credentialName := rc.ApiCredentialName
+
+	roleCollection := yaml.NewResourceWithComment(
+		&v1alpha1.RoleCollection{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.RoleCollectionKind,
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.RoleCollectionSpec{
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{
+						v1.ManagementActionObserve,
+					},
+				},
+				ForProvider: v1alpha1.RoleCollectionParameters{
+					Name:           rcName,
+					RoleReferences: convertRoleReferences(rc.RoleReferences),
+				},
+				XSUAACredentialsReference: v1alpha1.XSUAACredentialsReference{
+					SubaccountApiCredentialRef: &v1.Reference{
+						Name: credentialName,
+					},
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	roleCollection.CloneComment(rc)
+
+	// Comment the resource out, if any of the required fields is missing.
+	if rcName == "" {
+		roleCollection.AddComment(resources.WarnMissingRoleCollectionName)
+	}
+	if resourceName == resources.UndefinedName {
+		roleCollection.AddComment(resources.WarnUndefinedResourceName)
+	}
+	if externalName == resources.UndefinedExternalName {
+		roleCollection.AddComment(resources.WarnUndefinedExternalName)
+	}
+	if credentialName == "" || credentialName == resources.UndefinedName {
+		roleCollection.AddComment(resources.WarnMissingApiCredentialName)
+	}
+
+	// Description
+	if rc.Description != "" {
+		description := rc.Description
+		roleCollection.Resource().(*v1alpha1.RoleCollection).Spec.ForProvider.Description = &description
+	}
+
+	return roleCollection
+}
+
+// convertRoleReferences never returns nil, because the list of roles is a required field.
+func convertRoleReferences(refs []btpcli.RoleReference) []v1alpha1.RoleReference {
+	roles := make([]v1alpha1.RoleReference, 0, len(refs))
+	for _, ref := range refs {
+		roles = append(roles, v1alpha1.RoleReference{
+			RoleTemplateAppId: ref.RoleTemplateAppID,
+			RoleTemplateName:  ref.RoleTemplateName,
+			Name:              ref.Name,
+		})
+	}
+	return roles
+}
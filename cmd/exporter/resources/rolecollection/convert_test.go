@@ -0,0 +1,185 @@
+package rolecollection
+
+import (
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func TestConvertRoleCollectionResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	// Test data
+	subaccountID := "a1b2c3d4-5678-9abc-def0-123456789abc"
+	rcName := "Destination Admin"
+	description := "Manages destinations"
+	credentialName := "managed-subaccount-api-credential." + subaccountID
+	resourceName := "destination-admin." + subaccountID
+
+	tests := []struct {
+		name           string
+		roleCollection *RoleCollection
+		want           *yaml.ResourceWithComment
+	}{
+		{
+			name: "all fields present",
+			roleCollection: &RoleCollection{
+				RoleCollection: &btpcli.RoleCollection{
+					Name:        rcName,
+					Description: description,
+					RoleReferences: []btpcli.RoleReference{
+						{
+							RoleTemplateAppID: "destination-xsappname!b9",
+							RoleTemplateName:  "Destination_Administrator",
+							Name:              "Destination Administrator",
+						},
+					},
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				SubaccountID:        subaccountID,
+				ApiCredentialName:   credentialName,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.RoleCollection{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.RoleCollectionKind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": rcName,
+						},
+					},
+					Spec: v1alpha1.RoleCollectionSpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.RoleCollectionParameters{
+							Name:        rcName,
+							Description: &description,
+							RoleReferences: []v1alpha1.RoleReference{
+								{
+									RoleTemplateAppId: "destination-xsappname!b9",
+									RoleTemplateName:  "Destination_Administrator",
+									Name:              "Destination Administrator",
+								},
+							},
+						},
+						XSUAACredentialsReference: v1alpha1.XSUAACredentialsReference{
+							SubaccountApiCredentialRef: &v1.Reference{
+								Name: credentialName,
+							},
+						},
+					},
+				}),
+		},
+		{
+			name: "no roles",
+			roleCollection: &RoleCollection{
+				RoleCollection: &btpcli.RoleCollection{
+					Name: rcName,
+				},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				SubaccountID:        subaccountID,
+				ApiCredentialName:   credentialName,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.RoleCollection{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.RoleCollectionKind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": rcName,
+						},
+					},
+					Spec: v1alpha1.RoleCollectionSpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.RoleCollectionParameters{
+							Name:           rcName,
+							RoleReferences: []v1alpha1.RoleReference{},
+						},
+						XSUAACredentialsReference: v1alpha1.XSUAACredentialsReference{
+							SubaccountApiCredentialRef: &v1.Reference{
+								Name: credentialName,
+							},
+						},
+					},
+				}),
+		},
+		{
+			name: "all fields missing",
+			roleCollection: &RoleCollection{
+				RoleCollection:      &btpcli.RoleCollection{},
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+			},
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.RoleCollection{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.RoleCollectionKind,
+							APIVersion: v1alpha1.CRDGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resources.UndefinedName,
+							Annotations: map[string]string{
+								"crossplane.io/external-name": resources.UndefinedExternalName,
+							},
+						},
+						Spec: v1alpha1.RoleCollectionSpec{
+							ResourceSpec: v1.ResourceSpec{
+								ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+							},
+							ForProvider: v1alpha1.RoleCollectionParameters{
+								RoleReferences: []v1alpha1.RoleReference{},
+							},
+							XSUAACredentialsReference: v1alpha1.XSUAACredentialsReference{
+								SubaccountApiCredentialRef: &v1.Reference{},
+							},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingRoleCollectionName)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				rwc.AddComment(resources.WarnUndefinedExternalName)
+				rwc.AddComment(resources.WarnMissingApiCredentialName)
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertRoleCollectionResource(tt.roleCollection)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotRoleCollection, gotOk := result.Resource().(*v1alpha1.RoleCollection)
+			wantRoleCollection, wantOk := tt.want.Resource().(*v1alpha1.RoleCollection)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.RoleCollection")
+
+			// Final overall comparison.
+			r.Equal(wantRoleCollection, gotRoleCollection)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package rolecollection
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/SAP/xp-clifford/cli/configparam"
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccountapicredential"
+)
+
+const (
+	KindName = "rolecollection"
+)
+
+const (
+	paramNamePredefined = "rolecollection-predefined"
+)
+
+var (
+	rcCache         resources.ResourceCache[*RoleCollection]
+	registry        = resources.NewRegistry()
+	rcParam         = configparam.StringSlice(KindName, "Role collection name or regex expression for name.").
+		WithFlagName(KindName)
+	predefinedParam = configparam.Bool(paramNamePredefined, "Include role collections predefined by SAP. These cannot be created or modified.\nUsed in combination with '--kind "+KindName+"'").
+		WithFlagName(paramNamePredefined)
+)
+
+func init() {
+	resources.RegisterKind(exporter{})
+	export.AddConfigParams(predefinedParam)
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return rcParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	slog.DebugContext(ctx, "Export predefined role collections", "predefined", predefinedParam.Value())
+
+	cache, err := Get(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with role collections: %w", err)
+	}
+	slog.DebugContext(ctx, "Role collections in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no role collections found"))
+	} else {
+		for _, rc := range cache.All() {
+			Convert(ctx, btpClient, rc, eventHandler, resolveReferences)
+		}
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*RoleCollection], error) {
+	if rcCache != nil {
+		return rcCache, nil
+	}
+
+	// Let the user select relevant subaccounts.
+	saCache, err := subaccount.Get(ctx, btpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve subaccount cache: %w", err)
+	}
+	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
+
+	// Retrieve role collections from selected subaccounts.
+	includePredefined := predefinedParam.Value()
+	roleCollections, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]*RoleCollection, error) {
+		rcs, err := btpClient.ListSubaccountRoleCollections(ctx, saId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role collections for subaccount %s: %w", saId, err)
+		}
+		var result []*RoleCollection
+		for _, rc := range rcs {
+			if !includePredefined && IsPredefined(&rc) {
+				continue
+			}
+			result = append(result, &RoleCollection{
+				RoleCollection:      &rc,
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				SubaccountID:        saId,
+			})
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.DebugContext(ctx, "Total role collections returned by BTP CLI", "count", len(roleCollections))
+
+	// Create a cache and store all role collections.
+	cache := resources.NewResourceCache[*RoleCollection]()
+	cache.Store(roleCollections...)
+
+	// Let the user select role collections to export.
+	widgetValues := cache.ValuesForSelection()
+	rcParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selectedRoleCollections, err := rcParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", rcParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected role collections", "role collections", selectedRoleCollections)
+
+	// Keep only selected role collections in the cache.
+	cache.KeepSelectedOnly(selectedRoleCollections)
+	rcCache = cache
+
+	return rcCache, nil
+}
+
+// IsPredefined reports whether the role collection is predefined by SAP, e.g. "Subaccount Administrator".
+// Predefined role collections are read-only.
+func IsPredefined(rc *btpcli.RoleCollection) bool {
+	return rc.IsReadOnly
+}
+
+// Convert exports the given role collection, unless it has been exported already.
+func Convert(ctx context.Context, btpClient btpcli.Client, rc *RoleCollection, eventHandler export.EventHandler, resolveReferences bool) {
+	if register(ctx, rc) {
+		exportPrerequisiteResources(ctx, btpClient, rc, eventHandler, resolveReferences)
+		eventHandler.Resource(convertRoleCollectionResource(rc))
+	}
+}
+
+func register(ctx context.Context, rc *RoleCollection) bool {
+	success := registry.Register(rc.GetID())
+	if !success {
+		slog.DebugContext(ctx, "Role collection already exported", "subaccount", rc.SubaccountID, "role collection", rc.Name)
+	}
+	return success
+}
+
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, rc *RoleCollection, eventHandler export.EventHandler, resolveReferences bool) {
+	// Export the API credential the provider uses to access the XSUAA API of the subaccount.
+	rc.ApiCredentialName = subaccountapicredential.ExportForSubaccount(ctx, btpClient, rc.SubaccountID, eventHandler, resolveReferences)
+}
+
+type RoleCollection struct {
+	*btpcli.RoleCollection
+	*yaml.ResourceWithComment
+	SubaccountID      string
+	ApiCredentialName string
+}
+
+var _ resources.BtpResource = &RoleCollection{}
+
+// GetID returns the ID of the role collection, which is unique across subaccounts.
+func (rc *RoleCollection) GetID() string {
+	return rc.SubaccountID + "/" + rc.Name
+}
+
+func (rc *RoleCollection) GetDisplayName() string {
+	return rc.Name
+}
+
+func (rc *RoleCollection) GetExternalName() string {
+	if rc.Name == "" {
+		return resources.UndefinedExternalName
+	}
+
+	return rc.Name
+}
+
+func (rc *RoleCollection) GenerateK8sResourceName() string {
+	if rc.GetDisplayName() == "" || rc.SubaccountID == "" {
+		return resources.UndefinedName
+	}
+
+	// Role collection names are unique per subaccount only, so the subaccount ID is part of the name.
+	resourceName, err := resources.GenerateK8sResourceName(rc.SubaccountID, rc.GetDisplayName())
+	if err != nil {
+		rc.AddComment(fmt.Sprintf("cannot generate role collection resource name: %s", err))
+	}
+
+	return resourceName
+}
@@ -0,0 +1,75 @@
+package rolecollectionassignment
+
+import (
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func convertAssignmentResource(a *RoleCollectionAssignment) *yaml.ResourceWithComment {
+	resourceName := a.GenerateK8sResourceName()
+	externalName := a.GetExternalName()
+	rcName := a.RoleCollection.Name
+	origin := a.Origin
+	credentialName := a.ApiCredentialName
+
+	assignment := yaml.NewResourceWithComment(
+		&v1alpha1.RoleCollectionAssignment{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.RoleCollectionAssignmentKind,
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.RoleCollectionAssignmentSpec{
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{
+						v1.ManagementActionObserve,
+					},
+				},
+				ForProvider: v1alpha1.RoleCollectionAssignmentParameters{
+					Origin:             origin,
+					UserName:           a.UserName,
+					GroupName:          a.GroupName,
+					RoleCollectionName: rcName,
+				},
+				XSUAACredentialsReference: v1alpha1.XSUAACredentialsReference{
+					SubaccountApiCredentialRef: &v1.Reference{
+						Name: credentialName,
+					},
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	assignment.CloneComment(a)
+
+	// Comment the resource out, if any of the required fields is missing.
+	if rcName == "" {
+		assignment.AddComment(resources.WarnMissingRoleCollectionName)
+	}
+	if origin == "" {
+		assignment.AddComment(resources.WarnMissingOrigin)
+	}
+	if a.UserName == "" && a.GroupName == "" {
+		assignment.AddComment(resources.WarnMissingUserOrGroupName)
+	}
+	if resourceName == resources.UndefinedName {
+		assignment.AddComment(resources.WarnUndefinedResourceName)
+	}
+	if externalName == resources.UndefinedExternalName {
+		assignment.AddComment(resources.WarnUndefinedExternalName)
+	}
+	if credentialName == "" || credentialName == resources.UndefinedName {
+		assignment.AddComment(resources.WarnMissingApiCredentialName)
+	}
+
+	return assignment
+}
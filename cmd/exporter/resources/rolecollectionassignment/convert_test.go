@@ -0,0 +1,143 @@
+package rolecollectionassignment
+
+import (
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func TestConvertAssignmentResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	// Test data
+	subaccountID := "a1b2c3d4-5678-9abc-def0-123456789abc"
+	rcName := "Subaccount Administrator"
+	origin := "sap.default"
+	userName := "jane.doe@example.com"
+	groupName := "admins"
+	credentialName := "managed-subaccount-api-credential." + subaccountID
+
+	newWant := func(resourceName, externalName string, params v1alpha1.RoleCollectionAssignmentParameters, credentialName string) *v1alpha1.RoleCollectionAssignment {
+		return &v1alpha1.RoleCollectionAssignment{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.RoleCollectionAssignmentKind,
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.RoleCollectionAssignmentSpec{
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+				},
+				ForProvider: params,
+				XSUAACredentialsReference: v1alpha1.XSUAACredentialsReference{
+					SubaccountApiCredentialRef: &v1.Reference{
+						Name: credentialName,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		assignment *RoleCollectionAssignment
+		want       *yaml.ResourceWithComment
+	}{
+		{
+			name: "user assignment",
+			assignment: &RoleCollectionAssignment{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				RoleCollection:      &btpcli.RoleCollection{Name: rcName},
+				SubaccountID:        subaccountID,
+				Origin:              origin,
+				UserName:            userName,
+				ApiCredentialName:   credentialName,
+			},
+			want: yaml.NewResourceWithComment(
+				newWant(
+					"subaccount-administrator.jane.doe-at-example.com.sap.default."+subaccountID,
+					origin+"/"+userName+"/"+rcName,
+					v1alpha1.RoleCollectionAssignmentParameters{
+						Origin:             origin,
+						UserName:           userName,
+						RoleCollectionName: rcName,
+					},
+					credentialName)),
+		},
+		{
+			name: "group assignment",
+			assignment: &RoleCollectionAssignment{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				RoleCollection:      &btpcli.RoleCollection{Name: rcName},
+				SubaccountID:        subaccountID,
+				Origin:              origin,
+				GroupName:           groupName,
+				ApiCredentialName:   credentialName,
+			},
+			want: yaml.NewResourceWithComment(
+				newWant(
+					"subaccount-administrator.admins.sap.default."+subaccountID,
+					origin+"/"+groupName+"/"+rcName,
+					v1alpha1.RoleCollectionAssignmentParameters{
+						Origin:             origin,
+						GroupName:          groupName,
+						RoleCollectionName: rcName,
+					},
+					credentialName)),
+		},
+		{
+			name: "all fields missing",
+			assignment: &RoleCollectionAssignment{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				RoleCollection:      &btpcli.RoleCollection{},
+			},
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					newWant(resources.UndefinedName, resources.UndefinedExternalName, v1alpha1.RoleCollectionAssignmentParameters{}, ""))
+				rwc.AddComment(resources.WarnMissingRoleCollectionName)
+				rwc.AddComment(resources.WarnMissingOrigin)
+				rwc.AddComment(resources.WarnMissingUserOrGroupName)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				rwc.AddComment(resources.WarnUndefinedExternalName)
+				rwc.AddComment(resources.WarnMissingApiCredentialName)
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertAssignmentResource(tt.assignment)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotAssignment, gotOk := result.Resource().(*v1alpha1.RoleCollectionAssignment)
+			wantAssignment, wantOk := tt.want.Resource().(*v1alpha1.RoleCollectionAssignment)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.RoleCollectionAssignment")
+
+			// Final overall comparison.
+			r.Equal(wantAssignment, gotAssignment)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package rolecollectionassignment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/SAP/xp-clifford/cli/configparam"
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/rolecollection"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccountapicredential"
+	rolecollectiongroupassignment "github.com/sap/crossplane-provider-btp/internal/clients/security/rolecollectiongroupassignment"
+)
+
+const (
+	KindName = "rolecollectionassignment"
+)
+
+var (
+	assignmentCache resources.ResourceCache[*RoleCollectionAssignment]
+	assignmentParam = configparam.StringSlice(KindName, "Role collection assignment or regex expression for '<origin>/<user or group>/<role collection>'.").
+		WithFlagName(KindName)
+)
+
+func init() {
+	resources.RegisterKind(exporter{})
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return assignmentParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	cache, err := Get(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with role collection assignments: %w", err)
+	}
+	slog.DebugContext(ctx, "Role collection assignments in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no role collection assignments found"))
+	} else {
+		for _, a := range cache.All() {
+			exportPrerequisiteResources(ctx, btpClient, a, eventHandler, resolveReferences)
+			eventHandler.Resource(convertAssignmentResource(a))
+		}
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*RoleCollectionAssignment], error) {
+	if assignmentCache != nil {
+		return assignmentCache, nil
+	}
+
+	// Let the user select relevant subaccounts.
+	saCache, err := subaccount.Get(ctx, btpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve subaccount cache: %w", err)
+	}
+	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
+
+	// Retrieve the assignments of all role collections in selected subaccounts.
+	// Assignments of predefined role collections are included, as these are the most common ones.
+	assignments, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]*RoleCollectionAssignment, error) {
+		rcs, err := btpClient.ListSubaccountRoleCollections(ctx, saId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role collections for subaccount %s: %w", saId, err)
+		}
+		var result []*RoleCollectionAssignment
+		for _, listed := range rcs {
+			rc, err := btpClient.GetSubaccountRoleCollection(ctx, saId, listed.Name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get role collection %s for subaccount %s: %w", listed.Name, saId, err)
+			}
+			result = append(result, toAssignments(saId, rc)...)
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.DebugContext(ctx, "Total role collection assignments returned by BTP CLI", "count", len(assignments))
+
+	// Create a cache and store all assignments.
+	cache := resources.NewResourceCache[*RoleCollectionAssignment]()
+	cache.Store(assignments...)
+
+	// Let the user select assignments to export.
+	widgetValues := cache.ValuesForSelection()
+	assignmentParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selectedAssignments, err := assignmentParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", assignmentParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected role collection assignments", "assignments", selectedAssignments)
+
+	// Keep only selected assignments in the cache.
+	cache.KeepSelectedOnly(selectedAssignments)
+	assignmentCache = cache
+
+	return assignmentCache, nil
+}
+
+// toAssignments returns an assignment for each user and each group of the role collection.
+// Other attribute mappings of identity providers cannot be represented as RoleCollectionAssignment.
+func toAssignments(subaccountID string, rc *btpcli.RoleCollection) []*RoleCollectionAssignment {
+	var assignments []*RoleCollectionAssignment
+	for _, u := range rc.UserReferences {
+		assignments = append(assignments, &RoleCollectionAssignment{
+			ResourceWithComment: yaml.NewResourceWithComment(nil),
+			RoleCollection:      rc,
+			SubaccountID:        subaccountID,
+			Origin:              u.Origin,
+			UserName:            u.Username,
+		})
+	}
+	for _, g := range rc.GroupReferences {
+		if g.AttributeName != rolecollectiongroupassignment.GroupAttributeName {
+			continue
+		}
+		assignments = append(assignments, &RoleCollectionAssignment{
+			ResourceWithComment: yaml.NewResourceWithComment(nil),
+			RoleCollection:      rc,
+			SubaccountID:        subaccountID,
+			Origin:              g.Origin,
+			GroupName:           g.AttributeValue,
+		})
+	}
+	return assignments
+}
+
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, a *RoleCollectionAssignment, eventHandler export.EventHandler, resolveReferences bool) {
+	// Export the custom role collection, so that it exists before it is assigned.
+	// Predefined role collections exist in every subaccount.
+	if resolveReferences && !rolecollection.IsPredefined(a.RoleCollection) {
+		rc := &rolecollection.RoleCollection{
+			RoleCollection:      a.RoleCollection,
+			ResourceWithComment: yaml.NewResourceWithComment(nil),
+			SubaccountID:        a.SubaccountID,
+		}
+		rolecollection.Convert(ctx, btpClient, rc, eventHandler, resolveReferences)
+	}
+
+	// Export the API credential the provider uses to access the XSUAA API of the subaccount.
+	a.ApiCredentialName = subaccountapicredential.ExportForSubaccount(ctx, btpClient, a.SubaccountID, eventHandler, resolveReferences)
+}
+
+type RoleCollectionAssignment struct {
+	*yaml.ResourceWithComment
+	RoleCollection    *btpcli.RoleCollection
+	SubaccountID      string
+	Origin            string
+	UserName          string
+	GroupName         string
+	ApiCredentialName string
+}
+
+var _ resources.BtpResource = &RoleCollectionAssignment{}
+
+// GetID returns the ID of the assignment, which is unique across subaccounts.
+func (a *RoleCollectionAssignment) GetID() string {
+	return a.SubaccountID + "/" + a.GetDisplayName()
+}
+
+func (a *RoleCollectionAssignment) GetDisplayName() string {
+	return a.Origin + "/" + a.userOrGroupName() + "/" + a.RoleCollection.Name
+}
+
+// GetExternalName returns the external name in the `<origin>/<userOrGroupName>/<roleCollectionName>` format.
+func (a *RoleCollectionAssignment) GetExternalName() string {
+	if a.Origin == "" || a.userOrGroupName() == "" || a.RoleCollection.Name == "" {
+		return resources.UndefinedExternalName
+	}
+
+	return a.GetDisplayName()
+}
+
+func (a *RoleCollectionAssignment) GenerateK8sResourceName() string {
+	if a.Origin == "" || a.userOrGroupName() == "" || a.RoleCollection.Name == "" || a.SubaccountID == "" {
+		return resources.UndefinedName
+	}
+
+	// The same user or group is usually assigned in several subaccounts, so the subaccount ID is part of the name.
+	name := fmt.Sprintf("%s.%s.%s", a.RoleCollection.Name, a.userOrGroupName(), a.Origin)
+	resourceName, err := resources.GenerateK8sResourceName(a.SubaccountID, name)
+	if err != nil {
+		a.AddComment(fmt.Sprintf("cannot generate role collection assignment resource name: %s", err))
+	}
+
+	return resourceName
+}
+
+func (a *RoleCollectionAssignment) userOrGroupName() string {
+	if a.UserName != "" {
+		return a.UserName
+	}
+	return a.GroupName
+}
@@ -16,7 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func convertServiceBindingResource(ctx context.Context, btpClient *btpcli.BtpCli, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) resource.Object {
+func convertServiceBindingResource(ctx context.Context, btpClient btpcli.Client, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) resource.Object {
 	bindingName := sb.Name
 	resourceName := sb.GenerateK8sResourceName()
 	externalName := sb.GetExternalName()
@@ -88,7 +88,7 @@ func convertServiceBindingResource(ctx context.Context, btpClient *btpcli.BtpCli
 	return managedBinding
 }
 
-func resolveReference(ctx context.Context, btpClient *btpcli.BtpCli, spec *v1alpha1.ServiceBindingParameters) error {
+func resolveReference(ctx context.Context, btpClient btpcli.Client, spec *v1alpha1.ServiceBindingParameters) error {
 	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, *spec.SubaccountID)
 	if err != nil {
 		return err
@@ -41,7 +41,7 @@ func (e exporter) KindName() string {
 	return KindName
 }
 
-func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHandler export.EventHandler, resolveReferences bool) error {
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		return fmt.Errorf("failed to get cache with service bindings: %w", err)
@@ -59,7 +59,7 @@ func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHan
 	return nil
 }
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*servicebindingbase.ServiceBinding], error) {
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*servicebindingbase.ServiceBinding], error) {
 	if selectedCache != nil {
 		return selectedCache, nil
 	}
@@ -98,7 +98,7 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	return selectedCache, nil
 }
 
-func filterBySelectedInstances(ctx context.Context, btpClient *btpcli.BtpCli, cache resources.ResourceCache[*servicebindingbase.ServiceBinding]) error {
+func filterBySelectedInstances(ctx context.Context, btpClient btpcli.Client, cache resources.ResourceCache[*servicebindingbase.ServiceBinding]) error {
 	siCache, err := serviceinstance.Get(ctx, btpClient)
 	if err != nil {
 		return fmt.Errorf("failed to get service instance cache: %w", err)
@@ -125,16 +125,16 @@ func filterBySelectedInstances(ctx context.Context, btpClient *btpcli.BtpCli, ca
 	return nil
 }
 
-func convert(ctx context.Context, btpClient *btpcli.BtpCli, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) {
+func convert(ctx context.Context, btpClient btpcli.Client, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) {
 	exportPrerequisiteResources(ctx, btpClient, sb, eventHandler, resolveReferences)
 	eventHandler.Resource(convertServiceBindingResource(ctx, btpClient, sb, eventHandler, resolveReferences))
 }
 
-func exportPrerequisiteResources(ctx context.Context, btpClient *btpcli.BtpCli, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) {
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) {
 	exportServiceInstance(ctx, btpClient, sb, eventHandler, resolveReferences)
 }
 
-func exportServiceInstance(ctx context.Context, btpClient *btpcli.BtpCli, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) {
+func exportServiceInstance(ctx context.Context, btpClient btpcli.Client, sb *servicebindingbase.ServiceBinding, eventHandler export.EventHandler, resolveReferences bool) {
 	siID := sb.ServiceInstanceID
 	siName, err := serviceinstance.ExportInstance(ctx, btpClient, siID, eventHandler, resolveReferences)
 	if err != nil {
@@ -52,7 +52,7 @@ func (sb *ServiceBinding) GenerateK8sResourceName() string {
 
 // Get returns the full cache of all service bindings across selected subaccounts,
 // fetching from BTP CLI on the first call and returning the cached result on subsequent calls.
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*ServiceBinding], error) {
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*ServiceBinding], error) {
 	if fullCache != nil {
 		return fullCache, nil
 	}
@@ -63,13 +63,15 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	}
 	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
 
-	var btpBindings []btpcli.ServiceBinding
-	for _, saId := range saCache.AllIDs() {
+	btpBindings, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]btpcli.ServiceBinding, error) {
 		b, err := btpClient.ListServiceBindings(ctx, saId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get service bindings for subaccount %s: %w", saId, err)
 		}
-		btpBindings = append(btpBindings, b...)
+		return b, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	slog.DebugContext(ctx, "Total service bindings returned by BTP CLI", "count", len(btpBindings))
 
@@ -88,7 +90,7 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 }
 
 // GetServiceInstanceBindings returns all service bindings for a given service instance ID.
-func GetServiceInstanceBindings(ctx context.Context, btpClient *btpcli.BtpCli, instanceID string) (resources.ResourceCache[*ServiceBinding], error) {
+func GetServiceInstanceBindings(ctx context.Context, btpClient btpcli.Client, instanceID string) (resources.ResourceCache[*ServiceBinding], error) {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve service binding cache: %w", err)
@@ -16,7 +16,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
 )
 
-func convertServiceInstanceResource(ctx context.Context, btpClient *btpcli.BtpCli, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertServiceInstanceResource(ctx context.Context, btpClient btpcli.Client, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 	serviceName := si.OfferingName
 	servicePlanName := si.PlanName
 	subAccountGuid := si.SubaccountID
@@ -102,7 +102,7 @@ func convertServiceInstanceResource(ctx context.Context, btpClient *btpcli.BtpCl
 	return serviceInstance
 }
 
-func resolveReference(ctx context.Context, btpClient *btpcli.BtpCli, spec *v1alpha1.ServiceInstanceParameters) error {
+func resolveReference(ctx context.Context, btpClient btpcli.Client, spec *v1alpha1.ServiceInstanceParameters) error {
 	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, *spec.SubaccountID)
 	if err != nil {
 		return err
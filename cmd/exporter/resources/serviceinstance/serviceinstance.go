@@ -42,7 +42,7 @@ func (e exporter) KindName() string {
 	return KindName
 }
 
-func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHandler export.EventHandler, resolveReferences bool) error {
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		return fmt.Errorf("failed to get cache with service instances: %w", err)
@@ -61,7 +61,7 @@ func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHan
 	return nil
 }
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
 	if instanceCache != nil {
 		return instanceCache, nil
 	}
@@ -98,7 +98,7 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	return instanceCache, nil
 }
 
-func ExportInstance(ctx context.Context, btpClient *btpcli.BtpCli, instanceID string, eventHandler export.EventHandler, resolveReferences bool) (string, error) {
+func ExportInstance(ctx context.Context, btpClient btpcli.Client, instanceID string, eventHandler export.EventHandler, resolveReferences bool) (string, error) {
 	si, err := getServiceInstance(ctx, btpClient, instanceID)
 	if err != nil {
 		return "", fmt.Errorf("failed to retrieve service instance %s: %w", instanceID, err)
@@ -109,7 +109,7 @@ func ExportInstance(ctx context.Context, btpClient *btpcli.BtpCli, instanceID st
 	return si.GenerateK8sResourceName(), nil
 }
 
-func getServiceInstance(ctx context.Context, btpClient *btpcli.BtpCli, instanceID string) (*serviceinstancebase.ServiceInstance, error) {
+func getServiceInstance(ctx context.Context, btpClient btpcli.Client, instanceID string) (*serviceinstancebase.ServiceInstance, error) {
 	// Get complete list of service instances.
 	cache, err := serviceinstancebase.Get(ctx, btpClient)
 	if err != nil {
@@ -124,7 +124,7 @@ func getServiceInstance(ctx context.Context, btpClient *btpcli.BtpCli, instanceI
 	return si, nil
 }
 
-func convert(ctx context.Context, btpClient *btpcli.BtpCli, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+func convert(ctx context.Context, btpClient btpcli.Client, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	// Instances of certain services, e.g. Service Manager, Cloud Management or XSUAA, require special handling.
 	switch {
 	case si.IsCloudManagement():
@@ -147,7 +147,7 @@ func register(ctx context.Context, si *serviceinstancebase.ServiceInstance) bool
 	return success
 }
 
-func exportPrerequisiteResources(ctx context.Context, btpClient *btpcli.BtpCli, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	// Export subaccount service manager.
 	saID := si.SubaccountID
 	smName, err := servicemanager.ExportOperatorInstance(ctx, btpClient, saID, eventHandler, resolveReferences)
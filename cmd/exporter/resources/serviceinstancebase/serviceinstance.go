@@ -24,7 +24,7 @@ var (
 	instanceCache resources.ResourceCache[*ServiceInstance]
 )
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*ServiceInstance], error) {
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*ServiceInstance], error) {
 	if instanceCache != nil {
 		return instanceCache, nil
 	}
@@ -37,13 +37,15 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
 
 	// Retrieve all service instances from selected subaccounts.
-	var btpInstances []btpcli.ServiceInstance
-	for _, saId := range saCache.AllIDs() {
+	btpInstances, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]btpcli.ServiceInstance, error) {
 		instances, err := btpClient.ListServiceInstances(ctx, saId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get service instances for subaccount %s: %w", saId, err)
 		}
-		btpInstances = append(btpInstances, instances...)
+		return instances, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	slog.DebugContext(ctx, "Total service instances returned by BTP CLI", "count", len(btpInstances))
 
@@ -70,7 +72,7 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	return instanceCache, nil
 }
 
-func addServiceAndBindingInfo(ctx context.Context, btpClient *btpcli.BtpCli, si *ServiceInstance) error {
+func addServiceAndBindingInfo(ctx context.Context, btpClient btpcli.Client, si *ServiceInstance) error {
 	// Service plans for selected subaccounts.
 	planCache, err := serviceplan.Get(ctx, btpClient)
 	if err != nil {
@@ -91,7 +93,7 @@ func addServiceAndBindingInfo(ctx context.Context, btpClient *btpcli.BtpCli, si
 	return addBindingInfo(ctx, btpClient, si)
 }
 
-func addBindingInfo(ctx context.Context, btpClient *btpcli.BtpCli, si *ServiceInstance) error {
+func addBindingInfo(ctx context.Context, btpClient btpcli.Client, si *ServiceInstance) error {
 	bindingIDs, err := servicebindingbase.GetServiceInstanceBindings(ctx, btpClient, si.ID)
 	if err != nil {
 		slog.WarnContext(ctx, "Failed to retrieve binding IDs for service instance", "id", si.ID)
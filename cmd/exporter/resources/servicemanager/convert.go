@@ -17,7 +17,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
 )
 
-func convertServiceManagerResource(ctx context.Context, btpClient *btpcli.BtpCli, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertServiceManagerResource(ctx context.Context, btpClient btpcli.Client, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 	resourceName := si.GenerateK8sResourceName()
 	externalName := si.GetExternalName()
 	subAccountID := si.SubaccountID
@@ -99,7 +99,7 @@ func convertServiceManagerResource(ctx context.Context, btpClient *btpcli.BtpCli
 	return serviceManager
 }
 
-func convertDefaultServiceManagerResource(ctx context.Context, btpClient *btpcli.BtpCli, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+func convertDefaultServiceManagerResource(ctx context.Context, btpClient btpcli.Client, si *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
 	resourceName := si.GenerateK8sResourceName()
 	subaccountID := si.SubaccountID
 
@@ -147,7 +147,7 @@ func convertDefaultServiceManagerResource(ctx context.Context, btpClient *btpcli
 	return serviceManager
 }
 
-func resolveReference(ctx context.Context, btpClient *btpcli.BtpCli, spec *v1beta1.ServiceManagerParameters) error {
+func resolveReference(ctx context.Context, btpClient btpcli.Client, spec *v1beta1.ServiceManagerParameters) error {
 	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, spec.SubaccountGuid)
 	if err != nil {
 		return err
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/SAP/xp-clifford/cli/configparam"
 	"github.com/SAP/xp-clifford/cli/export"
 	"github.com/SAP/xp-clifford/yaml"
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
@@ -13,16 +14,56 @@ import (
 )
 
 const (
+	KindName = "servicemanager"
+
 	defaultNamePrefix   = "managed-service-manager"
 	serviceOperatorPlan = "service-operator-access"
 )
 
 var (
-	managerCache resources.ResourceCache[*serviceinstancebase.ServiceInstance]
-	registry     = resources.NewRegistry()
+	selectedCache resources.ResourceCache[*serviceinstancebase.ServiceInstance]
+	managerCache  resources.ResourceCache[*serviceinstancebase.ServiceInstance]
+	registry      = resources.NewRegistry()
+	instanceParam = configparam.StringSlice(KindName, "Service manager instance ID or regex expression for name.").
+			WithFlagName(KindName)
 )
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
+func init() {
+	resources.RegisterKind(exporter{})
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return instanceParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	cache, err := getSelected(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with service managers: %w", err)
+	}
+	slog.DebugContext(ctx, "Service manager instances in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no service managers found"))
+		return nil
+	}
+
+	for _, si := range cache.All() {
+		Convert(ctx, btpClient, si, eventHandler, resolveReferences)
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
 	if managerCache != nil {
 		return managerCache, nil
 	}
@@ -46,13 +87,45 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	return managerCache, nil
 }
 
-func Convert(ctx context.Context, btpClient *btpcli.BtpCli, sm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+// getSelected returns the service managers selected by the user.
+// The cache returned by Get remains complete, because other kinds look up the service manager of a subaccount in it.
+func getSelected(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*serviceinstancebase.ServiceInstance], error) {
+	if selectedCache != nil {
+		return selectedCache, nil
+	}
+
+	fc, err := Get(ctx, btpClient)
+	if err != nil {
+		return nil, err
+	}
+	cache := fc.Copy()
+
+	// Let the user select service managers to export.
+	widgetValues := cache.ValuesForSelection()
+	instanceParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selected, err := instanceParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", instanceParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected service managers", "instances", selected)
+
+	// Keep only selected service managers in the cache.
+	cache.KeepSelectedOnly(selected)
+	selectedCache = cache
+
+	return selectedCache, nil
+}
+
+func Convert(ctx context.Context, btpClient btpcli.Client, sm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	if register(ctx, sm) {
 		eventHandler.Resource(convertServiceManagerResource(ctx, btpClient, sm, eventHandler, resolveReferences))
 	}
 }
 
-func convertDefault(ctx context.Context, btpClient *btpcli.BtpCli, sm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
+func convertDefault(ctx context.Context, btpClient btpcli.Client, sm *serviceinstancebase.ServiceInstance, eventHandler export.EventHandler, resolveReferences bool) {
 	if register(ctx, sm) {
 		eventHandler.Resource(convertDefaultServiceManagerResource(ctx, btpClient, sm, eventHandler, resolveReferences))
 	}
@@ -69,7 +142,7 @@ func register(ctx context.Context, sm *serviceinstancebase.ServiceInstance) bool
 // ExportOperatorInstance exports a service manager instance for the given subaccount.
 // If a service manager instance exists for the subaccount, it is exported. Otherwise, a default service manager instance is exported.
 // The service plan of the instance is "service-operator-access".
-func ExportOperatorInstance(ctx context.Context, btpClient *btpcli.BtpCli, subaccountID string, eventHandler export.EventHandler, resolveReferences bool) (string, error) {
+func ExportOperatorInstance(ctx context.Context, btpClient btpcli.Client, subaccountID string, eventHandler export.EventHandler, resolveReferences bool) (string, error) {
 	sm, found, err := getServiceOperator(ctx, btpClient, subaccountID)
 	if err != nil {
 		return "", fmt.Errorf("failed to retrieve service manager (operator) for subaccount %s: %w", subaccountID, err)
@@ -83,7 +156,7 @@ func ExportOperatorInstance(ctx context.Context, btpClient *btpcli.BtpCli, subac
 	return sm.GenerateK8sResourceName(), nil
 }
 
-func getServiceOperator(ctx context.Context, btpClient *btpcli.BtpCli, subaccountID string) (*serviceinstancebase.ServiceInstance, bool, error) {
+func getServiceOperator(ctx context.Context, btpClient btpcli.Client, subaccountID string) (*serviceinstancebase.ServiceInstance, bool, error) {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to retrieve service manager cache: %w", err)
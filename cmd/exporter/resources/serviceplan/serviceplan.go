@@ -14,7 +14,7 @@ var (
 	planCache resources.ResourceCache[*servicePlan]
 )
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*servicePlan], error) {
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*servicePlan], error) {
 	if planCache != nil {
 		return planCache, nil
 	}
@@ -27,13 +27,15 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
 
 	// Retrieve all service plans from selected subaccounts.
-	var btpPlans []btpcli.ServicePlan
-	for _, saId := range saCache.AllIDs() {
+	btpPlans, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]btpcli.ServicePlan, error) {
 		p, err := btpClient.ListServicePlans(ctx, saId)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get service plans for subaccount %s: %w", saId, err)
 		}
-		btpPlans = append(btpPlans, p...)
+		return p, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	slog.DebugContext(ctx, "Total service plans returned by BTP CLI", "count", len(btpPlans))
 
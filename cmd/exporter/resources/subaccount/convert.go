@@ -6,6 +6,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
 )
 
 const (
@@ -82,8 +83,13 @@ func convertSubaccountResource(sa *subaccount) *yaml.ResourceWithComment {
 		saResource.Resource().(*v1alpha1.Subaccount).Spec.ForProvider.GlobalAccountGuid = sa.GlobalAccountGUID
 	}
 
-	// DirectoryGuid
-	if sa.ParentGUID != "" && sa.ParentGUID != sa.GlobalAccountGUID {
+	// DirectoryGuid or DirectoryRef, if the parent directory has been resolved.
+	switch {
+	case sa.DirectoryK8sName != "":
+		saResource.Resource().(*v1alpha1.Subaccount).Spec.ForProvider.DirectoryRef = &v1.Reference{
+			Name: sa.DirectoryK8sName,
+		}
+	case sa.hasParentDirectory():
 		saResource.Resource().(*v1alpha1.Subaccount).Spec.ForProvider.DirectoryGuid = sa.ParentGUID
 	}
 
@@ -97,17 +103,43 @@ func convertSubaccountResource(sa *subaccount) *yaml.ResourceWithComment {
 		saResource.Resource().(*v1alpha1.Subaccount).Spec.ForProvider.UsedForProduction = sa.UsedForProduction
 	}
 
-	// Labels
-	if len(sa.Labels) > 0 {
-		labels := make(map[string]v1alpha1.SubaccountLabelValueList, len(sa.Labels))
-		for k, v := range sa.Labels {
-			labels[k] = v
-		}
+	// Labels and CustomProperties
+	labels, customProperties := splitCustomProperties(sa.Labels, sa.CustomProperties)
+	if len(labels) > 0 {
 		saResource.Resource().(*v1alpha1.Subaccount).Spec.ForProvider.Labels = labels
 	}
+	if len(customProperties) > 0 {
+		saResource.Resource().(*v1alpha1.Subaccount).Spec.ForProvider.CustomProperties = customProperties
+	}
 
 	// BetaEnabled
 	saResource.Resource().(*v1alpha1.Subaccount).Spec.ForProvider.BetaEnabled = sa.BetaEnabled
 
 	return saResource
 }
+
+// splitCustomProperties assigns each key of the subaccount to either the labels or the custom properties of the spec,
+// which must not share keys. BTP lists every custom property as a label and every label value as a custom property,
+// so keys with a single value are exported as custom properties and keys with several values as labels.
+func splitCustomProperties(saLabels map[string][]string, saCustomProperties []btpcli.CustomProperty) (map[string]v1alpha1.SubaccountLabelValueList, map[string]string) {
+	values := map[string][]string{}
+	for _, p := range saCustomProperties {
+		values[p.Key] = append(values[p.Key], p.Value)
+	}
+
+	customProperties := map[string]string{}
+	for k, v := range values {
+		label, isLabel := saLabels[k]
+		if len(v) == 1 && (!isLabel || len(label) == 1 && label[0] == v[0]) {
+			customProperties[k] = v[0]
+		}
+	}
+
+	labels := make(map[string]v1alpha1.SubaccountLabelValueList, len(saLabels))
+	for k, v := range saLabels {
+		if _, isCustomProperty := customProperties[k]; !isCustomProperty {
+			labels[k] = v
+		}
+	}
+	return labels, customProperties
+}
@@ -33,9 +33,10 @@ func TestConvertSubaccountResource(t *testing.T) {
 	wantResourceName := "test-subaccount.eu10"
 
 	tests := []struct {
-		name string
-		sa   *btpcli.Subaccount
-		want *yaml.ResourceWithComment
+		name          string
+		sa            *btpcli.Subaccount
+		directoryName string
+		want          *yaml.ResourceWithComment
 	}{
 		{
 			name: "all required fields present",
@@ -117,6 +118,89 @@ func TestConvertSubaccountResource(t *testing.T) {
 					},
 				}),
 		},
+		{
+			// BTP lists every custom property as a label and every label value as a custom property.
+			name: "single-valued keys as custom properties, multi-valued keys as labels",
+			sa: &btpcli.Subaccount{
+				DisplayName: displayName,
+				GUID:        saGuid,
+				Region:      region,
+				Subdomain:   subdomain,
+				CreatedBy:   createdBy,
+				Labels:      map[string][]string{"env": {"dev"}, "team": {"platform", "sre"}, "costCenter": {"4711"}},
+				CustomProperties: []btpcli.CustomProperty{
+					{AccountGUID: saGuid, Key: "env", Value: "dev"},
+					{AccountGUID: saGuid, Key: "team", Value: "platform"},
+					{AccountGUID: saGuid, Key: "team", Value: "sre"},
+					{AccountGUID: saGuid, Key: "costCenter", Value: "4711"},
+				},
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.Subaccount{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.SubaccountKind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: wantResourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": saGuid,
+						},
+					},
+					Spec: v1alpha1.SubaccountSpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.SubaccountParameters{
+							DisplayName:      displayName,
+							Region:           region,
+							Subdomain:        subdomain,
+							SubaccountAdmins: []string{createdBy},
+							Labels:           map[string]v1alpha1.SubaccountLabelValueList{"team": {"platform", "sre"}},
+							CustomProperties: map[string]string{"env": "dev", "costCenter": "4711"},
+						},
+					},
+				}),
+		},
+		{
+			name: "parent directory resolved to reference",
+			sa: &btpcli.Subaccount{
+				DisplayName:       displayName,
+				GUID:              saGuid,
+				Region:            region,
+				Subdomain:         subdomain,
+				CreatedBy:         createdBy,
+				GlobalAccountGUID: gaGuid,
+				ParentGUID:        dirGuid,
+			},
+			directoryName: "my-directory",
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.Subaccount{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.SubaccountKind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: wantResourceName,
+						Annotations: map[string]string{
+							"crossplane.io/external-name": saGuid,
+						},
+					},
+					Spec: v1alpha1.SubaccountSpec{
+						ResourceSpec: v1.ResourceSpec{
+							ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+						},
+						ForProvider: v1alpha1.SubaccountParameters{
+							DisplayName:       displayName,
+							Region:            region,
+							Subdomain:         subdomain,
+							SubaccountAdmins:  []string{createdBy},
+							GlobalAccountGuid: gaGuid,
+							DirectoryRef:      &v1.Reference{Name: "my-directory"},
+						},
+					},
+				}),
+		},
 		{
 			name: "missing displayName",
 			sa: &btpcli.Subaccount{
@@ -416,6 +500,7 @@ func TestConvertSubaccountResource(t *testing.T) {
 			result := convertSubaccountResource(&subaccount{
 				Subaccount:          tt.sa,
 				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				DirectoryK8sName:    tt.directoryName,
 			})
 			r.NotNil(result)
 
@@ -460,6 +545,7 @@ func TestConvertSubaccountResource(t *testing.T) {
 			r.Equal(wantSa.Spec.ForProvider.UsedForProduction, gotSa.Spec.ForProvider.UsedForProduction)
 			r.Equal(wantSa.Spec.ForProvider.BetaEnabled, gotSa.Spec.ForProvider.BetaEnabled)
 			r.Equal(wantSa.Spec.ForProvider.Labels, gotSa.Spec.ForProvider.Labels)
+			r.Equal(wantSa.Spec.ForProvider.CustomProperties, gotSa.Spec.ForProvider.CustomProperties)
 
 			// Final overall comparison.
 			r.Equal(wantSa, gotSa)
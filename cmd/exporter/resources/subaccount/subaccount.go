@@ -7,10 +7,12 @@ import (
 
 	"github.com/SAP/xp-clifford/cli/configparam"
 	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/erratt"
 	"github.com/SAP/xp-clifford/yaml"
 
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
 	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/directory"
 )
 
 const KindName = "subaccount"
@@ -37,7 +39,7 @@ func (e exporter) KindName() string {
 	return subaccountParam.GetName()
 }
 
-func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHandler export.EventHandler, _ bool) error {
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
 	cache, err := Get(ctx, btpClient)
 	if err != nil {
 		return fmt.Errorf("failed to get cache with subaccounts: %w", err)
@@ -48,6 +50,7 @@ func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHan
 		eventHandler.Warn(fmt.Errorf("no subaccounts found"))
 	} else {
 		for _, sa := range cache.All() {
+			exportPrerequisiteResources(ctx, btpClient, sa, eventHandler, resolveReferences)
 			eventHandler.Resource(convertSubaccountResource(sa))
 		}
 	}
@@ -55,7 +58,24 @@ func (e exporter) Export(ctx context.Context, btpClient *btpcli.BtpCli, eventHan
 	return nil
 }
 
-func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache[*subaccount], error) {
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, sa *subaccount, eventHandler export.EventHandler, resolveReferences bool) {
+	if !resolveReferences || !sa.hasParentDirectory() {
+		return
+	}
+
+	// Export parent directory, so that it can be referenced.
+	dirName, err := directory.ExportDirectory(ctx, btpClient, sa.ParentGUID, eventHandler, resolveReferences)
+	if err != nil {
+		eventHandler.Warn(erratt.Errorf("cannot resolve directory reference: %w", err).With("subaccount", sa.GetID()))
+		sa.AddComment(resources.WarnCannotResolveDirectory + ": " + sa.ParentGUID)
+		return
+	}
+
+	// Set directory reference.
+	sa.DirectoryK8sName = dirName
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*subaccount], error) {
 	if subaccountCache != nil {
 		return subaccountCache, nil
 	}
@@ -99,7 +119,7 @@ func Get(ctx context.Context, btpClient *btpcli.BtpCli) (resources.ResourceCache
 	return subaccountCache, nil
 }
 
-func GetK8sResourceNameByID(ctx context.Context, btpClient *btpcli.BtpCli, id string) (string, error) {
+func GetK8sResourceNameByID(ctx context.Context, btpClient btpcli.Client, id string) (string, error) {
 	if id == "" {
 		return "", fmt.Errorf("subaccount ID is not set")
 	}
@@ -120,6 +140,9 @@ func GetK8sResourceNameByID(ctx context.Context, btpClient *btpcli.BtpCli, id st
 type subaccount struct {
 	*btpcli.Subaccount
 	*yaml.ResourceWithComment
+	// DirectoryK8sName is the K8s resource name of the parent directory,
+	// resolved during export and used as a reference in the generated manifest.
+	DirectoryK8sName string
 }
 
 var _ resources.BtpResource = &subaccount{}
@@ -149,3 +172,8 @@ func (s *subaccount) GenerateK8sResourceName() string {
 
 	return rn
 }
+
+// hasParentDirectory returns true, if the subaccount is nested in a directory and not directly in the global account.
+func (s *subaccount) hasParentDirectory() bool {
+	return s.ParentGUID != "" && s.ParentGUID != s.GlobalAccountGUID
+}
@@ -0,0 +1,80 @@
+package subaccountapicredential
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/erratt"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
+)
+
+func convertApiCredentialResource(ctx context.Context, btpClient btpcli.Client, c *ApiCredential, eventHandler export.EventHandler, resolveReferences bool) *yaml.ResourceWithComment {
+	resourceName := c.GenerateK8sResourceName()
+	subaccountID := c.SubaccountID
+
+	// The API credential has no external name and no Observe management policy,
+	// because the provider has to create a new API credential to retrieve its secret.
+	credential := yaml.NewResourceWithComment(
+		&v1alpha1.SubaccountApiCredential{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.SubaccountApiCredential_Kind,
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+			},
+			Spec: v1alpha1.SubaccountApiCredentialSpec{
+				ResourceSpec: v1.ResourceSpec{
+					WriteConnectionSecretToReference: &v1.SecretReference{
+						Name:      resourceName,
+						Namespace: resources.DefaultSecretNamespace,
+					},
+				},
+				ForProvider: v1alpha1.SubaccountApiCredentialParameters{
+					SubaccountID: &subaccountID,
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	credential.CloneComment(c)
+
+	// Comment the resource out, if any of the required fields is missing.
+	if subaccountID == "" {
+		credential.AddComment(resources.WarnMissingSubaccountGuid)
+	}
+	if resourceName == resources.UndefinedName {
+		credential.AddComment(resources.WarnUndefinedResourceName)
+	}
+
+	// Reference subaccount resource, if requested.
+	if resolveReferences {
+		if err := resolveReference(ctx, btpClient, &credential.Object.(*v1alpha1.SubaccountApiCredential).Spec.ForProvider); err != nil {
+			eventHandler.Warn(erratt.Errorf("cannot resolve subaccount reference: %w", err).With("subaccount API credential", c.GetID()))
+			credential.AddComment(resources.WarnCannotResolveSubaccount + ": " + subaccountID)
+		}
+	}
+
+	return credential
+}
+
+func resolveReference(ctx context.Context, btpClient btpcli.Client, spec *v1alpha1.SubaccountApiCredentialParameters) error {
+	saName, err := subaccount.GetK8sResourceNameByID(ctx, btpClient, *spec.SubaccountID)
+	if err != nil {
+		return err
+	}
+
+	spec.SubaccountRef = &v1.Reference{
+		Name: saName,
+	}
+	spec.SubaccountID = nil
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package subaccountapicredential
+
+import (
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func TestConvertApiCredentialResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	// Test data
+	subaccountID := "a1b2c3d4-5678-9abc-def0-123456789abc"
+	resourceName := defaultName + "." + subaccountID
+	empty := ""
+
+	tests := []struct {
+		name       string
+		credential *ApiCredential
+		want       *yaml.ResourceWithComment
+	}{
+		{
+			name: "all fields present",
+			credential: &ApiCredential{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				SubaccountID:        subaccountID,
+			},
+			want: yaml.NewResourceWithComment(
+				&v1alpha1.SubaccountApiCredential{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       v1alpha1.SubaccountApiCredential_Kind,
+						APIVersion: v1alpha1.CRDGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+					},
+					Spec: v1alpha1.SubaccountApiCredentialSpec{
+						ResourceSpec: v1.ResourceSpec{
+							WriteConnectionSecretToReference: &v1.SecretReference{
+								Name:      resourceName,
+								Namespace: resources.DefaultSecretNamespace,
+							},
+						},
+						ForProvider: v1alpha1.SubaccountApiCredentialParameters{
+							SubaccountID: &subaccountID,
+						},
+					},
+				}),
+		},
+		{
+			name: "missing subaccount ID",
+			credential: &ApiCredential{
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+			},
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					&v1alpha1.SubaccountApiCredential{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       v1alpha1.SubaccountApiCredential_Kind,
+							APIVersion: v1alpha1.CRDGroupVersion.String(),
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name: resources.UndefinedName,
+						},
+						Spec: v1alpha1.SubaccountApiCredentialSpec{
+							ResourceSpec: v1.ResourceSpec{
+								WriteConnectionSecretToReference: &v1.SecretReference{
+									Name:      resources.UndefinedName,
+									Namespace: resources.DefaultSecretNamespace,
+								},
+							},
+							ForProvider: v1alpha1.SubaccountApiCredentialParameters{
+								SubaccountID: &empty,
+							},
+						},
+					})
+				rwc.AddComment(resources.WarnMissingSubaccountGuid)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertApiCredentialResource(t.Context(), nil, tt.credential, nil, false)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotCredential, gotOk := result.Resource().(*v1alpha1.SubaccountApiCredential)
+			wantCredential, wantOk := tt.want.Resource().(*v1alpha1.SubaccountApiCredential)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.SubaccountApiCredential")
+
+			// Final overall comparison.
+			r.Equal(wantCredential, gotCredential)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package subaccountapicredential
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+const (
+	// defaultName is the API credential name the provider uses, if none is specified.
+	defaultName = "managed-subaccount-api-credential"
+)
+
+var (
+	registry = resources.NewRegistry()
+)
+
+// ExportForSubaccount exports an API credential for the XSUAA API of the given subaccount,
+// unless it has been exported already, and returns its resource name for reference.
+// Existing API credentials cannot be imported, so a new one is always created for each subaccount.
+func ExportForSubaccount(ctx context.Context, btpClient btpcli.Client, subaccountID string, eventHandler export.EventHandler, resolveReferences bool) string {
+	c := &ApiCredential{
+		ResourceWithComment: yaml.NewResourceWithComment(nil),
+		SubaccountID:        subaccountID,
+	}
+
+	if register(ctx, c) {
+		eventHandler.Resource(convertApiCredentialResource(ctx, btpClient, c, eventHandler, resolveReferences))
+	}
+
+	return c.GenerateK8sResourceName()
+}
+
+func register(ctx context.Context, c *ApiCredential) bool {
+	success := registry.Register(c.GetID())
+	if !success {
+		slog.DebugContext(ctx, "Subaccount API credential already exported", "subaccount", c.SubaccountID)
+	}
+	return success
+}
+
+type ApiCredential struct {
+	*yaml.ResourceWithComment
+	SubaccountID string
+}
+
+// GetID returns the subaccount ID, as a single API credential is exported per subaccount.
+func (c *ApiCredential) GetID() string {
+	return c.SubaccountID
+}
+
+func (c *ApiCredential) GenerateK8sResourceName() string {
+	if c.SubaccountID == "" {
+		return resources.UndefinedName
+	}
+
+	resourceName, err := resources.GenerateK8sResourceName(c.SubaccountID, defaultName)
+	if err != nil {
+		c.AddComment(fmt.Sprintf("cannot generate subaccount API credential resource name: %s", err))
+	}
+
+	return resourceName
+}
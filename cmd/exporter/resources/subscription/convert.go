@@ -0,0 +1,71 @@
+package subscription
+
+import (
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func convertSubscriptionResource(s *Subscription) *yaml.ResourceWithComment {
+	resourceName := s.GenerateK8sResourceName()
+	externalName := s.GetExternalName()
+	appName := s.AppName
+	cmName := s.CloudManagementName
+
+	// The subscription parameters are not returned by BTP CLI, so they are left empty.
+	subscription := yaml.NewResourceWithComment(
+		&v1alpha1.Subscription{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.SubscriptionKind,
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.SubscriptionSpec{
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{
+						v1.ManagementActionObserve,
+					},
+				},
+				ForProvider: v1alpha1.SubscriptionParameters{
+					AppName:  appName,
+					PlanName: s.PlanName,
+				},
+				CloudManagementRef: &v1.Reference{
+					Name: cmName,
+				},
+			},
+		})
+
+	// Copy comments from the original resource.
+	subscription.CloneComment(s)
+
+	// Comment the resource out, if any of the required fields is missing.
+	if appName == "" {
+		subscription.AddComment(resources.WarnMissingAppName)
+	}
+	if resourceName == resources.UndefinedName {
+		subscription.AddComment(resources.WarnUndefinedResourceName)
+	}
+	if externalName == resources.UndefinedExternalName {
+		subscription.AddComment(resources.WarnUndefinedExternalName)
+	}
+	if cmName == "" {
+		subscription.AddComment(resources.WarnMissingCloudManagementName)
+	}
+
+	// Subscriptions in progress or failed cannot be observed reliably.
+	if s.State != btpcli.SubscriptionStateSubscribed {
+		subscription.AddComment(resources.WarnSubscriptionNotSubscribed + ": " + s.State)
+	}
+
+	return subscription
+}
@@ -0,0 +1,130 @@
+package subscription
+
+import (
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+)
+
+func TestConvertSubscriptionResource(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	// Test data
+	subaccountID := "a1b2c3d4-5678-9abc-def0-123456789abc"
+	appName := "feature-flags-dashboard"
+	planName := "dashboard"
+	cmName := "cloud-management-ref"
+
+	newSubscription := func(appName, planName, state, cmName string) *Subscription {
+		return &Subscription{
+			Subscription: &btpcli.Subscription{
+				AppName:  appName,
+				PlanName: planName,
+				State:    state,
+			},
+			ResourceWithComment: yaml.NewResourceWithComment(nil),
+			SubaccountID:        subaccountID,
+			CloudManagementName: cmName,
+		}
+	}
+
+	newWant := func(resourceName, externalName, appName, planName, cmName string) *v1alpha1.Subscription {
+		return &v1alpha1.Subscription{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha1.SubscriptionKind,
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: resourceName,
+				Annotations: map[string]string{
+					"crossplane.io/external-name": externalName,
+				},
+			},
+			Spec: v1alpha1.SubscriptionSpec{
+				ResourceSpec: v1.ResourceSpec{
+					ManagementPolicies: []v1.ManagementAction{v1.ManagementActionObserve},
+				},
+				ForProvider: v1alpha1.SubscriptionParameters{
+					AppName:  appName,
+					PlanName: planName,
+				},
+				CloudManagementRef: &v1.Reference{
+					Name: cmName,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		subscription *Subscription
+		want         *yaml.ResourceWithComment
+	}{
+		{
+			name:         "all fields present",
+			subscription: newSubscription(appName, planName, btpcli.SubscriptionStateSubscribed, cmName),
+			want: yaml.NewResourceWithComment(
+				newWant(appName+"."+planName+"."+subaccountID, appName+"/"+planName, appName, planName, cmName)),
+		},
+		{
+			name:         "default plan",
+			subscription: newSubscription(appName, "", btpcli.SubscriptionStateSubscribed, cmName),
+			want: yaml.NewResourceWithComment(
+				newWant(appName+"."+subaccountID, appName+"/", appName, "", cmName)),
+		},
+		{
+			name:         "subscription failed",
+			subscription: newSubscription(appName, planName, "SUBSCRIBE_FAILED", cmName),
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					newWant(appName+"."+planName+"."+subaccountID, appName+"/"+planName, appName, planName, cmName))
+				rwc.AddComment(resources.WarnSubscriptionNotSubscribed + ": SUBSCRIBE_FAILED")
+				return rwc
+			}(),
+		},
+		{
+			name:         "all fields missing",
+			subscription: newSubscription("", "", btpcli.SubscriptionStateSubscribed, ""),
+			want: func() *yaml.ResourceWithComment {
+				rwc := yaml.NewResourceWithComment(
+					newWant(resources.UndefinedName, resources.UndefinedExternalName, "", "", ""))
+				rwc.AddComment(resources.WarnMissingAppName)
+				rwc.AddComment(resources.WarnUndefinedResourceName)
+				rwc.AddComment(resources.WarnUndefinedExternalName)
+				rwc.AddComment(resources.WarnMissingCloudManagementName)
+				return rwc
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := convertSubscriptionResource(tt.subscription)
+			r.NotNil(result)
+
+			// Verify comments.
+			gotComment, gotHasComment := result.Comment()
+			wantComment, wantHasComment := tt.want.Comment()
+			r.Equal(wantHasComment, gotHasComment, "comment presence mismatch")
+			if wantHasComment {
+				r.Equal(wantComment, gotComment, "comment mismatch")
+			}
+
+			// Verify resource type.
+			gotSubscription, gotOk := result.Resource().(*v1alpha1.Subscription)
+			wantSubscription, wantOk := tt.want.Resource().(*v1alpha1.Subscription)
+			r.True(gotOk && wantOk, "expected resource type to be *v1alpha1.Subscription")
+
+			// Final overall comparison.
+			r.Equal(wantSubscription, gotSubscription)
+		})
+	}
+}
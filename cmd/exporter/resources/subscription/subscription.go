@@ -0,0 +1,184 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/SAP/xp-clifford/cli/configparam"
+	"github.com/SAP/xp-clifford/cli/export"
+	"github.com/SAP/xp-clifford/erratt"
+	"github.com/SAP/xp-clifford/yaml"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/btpcli"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/cloudmanagement"
+	"github.com/sap/crossplane-provider-btp/cmd/exporter/resources/subaccount"
+	subscriptionclient "github.com/sap/crossplane-provider-btp/internal/clients/subscription"
+)
+
+const (
+	KindName = "subscription"
+)
+
+var (
+	subscriptionCache resources.ResourceCache[*Subscription]
+	subscriptionParam = configparam.StringSlice(KindName, "Subscribed application name or regex expression for name.").
+		WithFlagName(KindName)
+)
+
+func init() {
+	resources.RegisterKind(exporter{})
+}
+
+type exporter struct{}
+
+var _ resources.Kind = exporter{}
+
+func (e exporter) Param() configparam.ConfigParam {
+	return subscriptionParam
+}
+
+func (e exporter) KindName() string {
+	return KindName
+}
+
+func (e exporter) Export(ctx context.Context, btpClient btpcli.Client, eventHandler export.EventHandler, resolveReferences bool) error {
+	cache, err := Get(ctx, btpClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache with subscriptions: %w", err)
+	}
+	slog.DebugContext(ctx, "Subscriptions in cache after user selection", "count", cache.Len())
+
+	if cache.Len() == 0 {
+		eventHandler.Warn(fmt.Errorf("no subscriptions found"))
+	} else {
+		for _, s := range cache.All() {
+			exportPrerequisiteResources(ctx, btpClient, s, eventHandler, resolveReferences)
+			eventHandler.Resource(convertSubscriptionResource(s))
+		}
+	}
+
+	return nil
+}
+
+func Get(ctx context.Context, btpClient btpcli.Client) (resources.ResourceCache[*Subscription], error) {
+	if subscriptionCache != nil {
+		return subscriptionCache, nil
+	}
+
+	// Let the user select relevant subaccounts.
+	saCache, err := subaccount.Get(ctx, btpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve subaccount cache: %w", err)
+	}
+	slog.DebugContext(ctx, "Subaccounts in cache after user selection", "count", saCache.Len())
+
+	// Retrieve subscribed applications from selected subaccounts.
+	subscriptions, err := resources.ListPerSubaccount(ctx, saCache.AllIDs(), func(ctx context.Context, saId string) ([]*Subscription, error) {
+		apps, err := btpClient.ListSubscriptions(ctx, saId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subscriptions for subaccount %s: %w", saId, err)
+		}
+		var result []*Subscription
+		for _, app := range apps {
+			// The list contains all applications the subaccount is entitled to, subscribed or not.
+			if !isSubscribed(&app) {
+				continue
+			}
+			result = append(result, &Subscription{
+				Subscription:        &app,
+				ResourceWithComment: yaml.NewResourceWithComment(nil),
+				SubaccountID:        saId,
+			})
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.DebugContext(ctx, "Total subscriptions returned by BTP CLI", "count", len(subscriptions))
+
+	// Create a cache and store all subscriptions.
+	cache := resources.NewResourceCache[*Subscription]()
+	cache.Store(subscriptions...)
+
+	// Let the user select subscriptions to export.
+	widgetValues := cache.ValuesForSelection()
+	subscriptionParam.WithPossibleValuesFn(func() ([]string, error) {
+		return widgetValues.Values(), nil
+	})
+
+	selectedSubscriptions, err := subscriptionParam.ValueOrAsk(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter value: %s, %w", subscriptionParam.GetName(), err)
+	}
+	slog.DebugContext(ctx, "Selected subscriptions", "subscriptions", selectedSubscriptions)
+
+	// Keep only selected subscriptions in the cache.
+	cache.KeepSelectedOnly(selectedSubscriptions)
+	subscriptionCache = cache
+
+	return subscriptionCache, nil
+}
+
+func isSubscribed(app *btpcli.Subscription) bool {
+	return app.State != "" && app.State != btpcli.SubscriptionStateNotSubscribed
+}
+
+func exportPrerequisiteResources(ctx context.Context, btpClient btpcli.Client, s *Subscription, eventHandler export.EventHandler, resolveReferences bool) {
+	// The Subscription resource retrieves the SaaS manager credentials from the Cloud Management resource of its subaccount.
+	cmName, err := cloudmanagement.ExportInstanceForSubaccount(ctx, btpClient, s.SubaccountID, eventHandler, resolveReferences)
+	if err != nil {
+		eventHandler.Warn(erratt.Errorf("cannot export cloud management: %w", err).With("subaccount", s.SubaccountID))
+	}
+
+	// Set Cloud Management name in subscription resource for reference.
+	s.CloudManagementName = cmName
+}
+
+type Subscription struct {
+	*btpcli.Subscription
+	*yaml.ResourceWithComment
+	SubaccountID        string
+	CloudManagementName string
+}
+
+var _ resources.BtpResource = &Subscription{}
+
+// GetID returns the ID of the subscription, which is unique across subaccounts.
+func (s *Subscription) GetID() string {
+	return s.SubaccountID + "/" + s.AppName + "/" + s.PlanName
+}
+
+func (s *Subscription) GetDisplayName() string {
+	return s.AppName
+}
+
+// GetExternalName returns the external name in the `<appName>/<planName>` format.
+// The plan name may be empty, which is shown as "default" in the BTP cockpit.
+func (s *Subscription) GetExternalName() string {
+	if s.AppName == "" {
+		return resources.UndefinedExternalName
+	}
+
+	return subscriptionclient.FormExternalName(s.AppName, s.PlanName)
+}
+
+func (s *Subscription) GenerateK8sResourceName() string {
+	if s.AppName == "" || s.SubaccountID == "" {
+		return resources.UndefinedName
+	}
+
+	name := s.AppName
+	if s.PlanName != "" {
+		name = fmt.Sprintf("%s.%s", s.AppName, s.PlanName)
+	}
+
+	// The same application is usually subscribed in several subaccounts, so the subaccount ID is part of the name.
+	resourceName, err := resources.GenerateK8sResourceName(s.SubaccountID, name)
+	if err != nil {
+		s.AddComment(fmt.Sprintf("cannot generate subscription resource name: %s", err))
+	}
+
+	return resourceName
+}
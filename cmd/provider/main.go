@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -11,8 +12,10 @@ import (
 	"github.com/crossplane/upjet/v2/pkg/terraform"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/config"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
 	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
 	"github.com/sap/crossplane-provider-btp/internal/features"
+	"github.com/sap/crossplane-provider-btp/internal/tracing"
 	"github.com/sap/crossplane-provider-btp/internal/version"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -62,6 +65,70 @@ func main() {
 
 		enableManagementPolicies = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
 
+		tracingEndpoint = app.Flag(
+			"tracing-endpoint",
+			"Host and port of an OTLP HTTP receiver, e.g. otel-collector:4318. Tracing is disabled if not set.",
+		).String()
+		tracingInsecure = app.Flag(
+			"tracing-insecure",
+			"Connect to the OTLP HTTP receiver without TLS.",
+		).Default("false").Bool()
+		tracingSampleRatio = app.Flag(
+			"tracing-sample-ratio",
+			"Fraction of reconciles that are traced, between 0 and 1.",
+		).Default("1").Float64()
+
+		rateLimitAccounts = app.Flag(
+			"rate-limit-accounts",
+			"Maximum rate of requests per second per credential to the accounts service of SAP Cloud Management. Not limited if 0.",
+		).Default("0").Float64()
+		rateLimitAccountsBurst = app.Flag(
+			"rate-limit-accounts-burst",
+			"Maximum burst of requests per credential to the accounts service of SAP Cloud Management.",
+		).Default("10").Int()
+		rateLimitEntitlements = app.Flag(
+			"rate-limit-entitlements",
+			"Maximum rate of requests per second per credential to the entitlements service of SAP Cloud Management. Not limited if 0.",
+		).Default("0").Float64()
+		rateLimitEntitlementsBurst = app.Flag(
+			"rate-limit-entitlements-burst",
+			"Maximum burst of requests per credential to the entitlements service of SAP Cloud Management.",
+		).Default("10").Int()
+		rateLimitProvisioning = app.Flag(
+			"rate-limit-provisioning",
+			"Maximum rate of requests per second per credential to the provisioning service of SAP Cloud Management. Not limited if 0.",
+		).Default("0").Float64()
+		rateLimitProvisioningBurst = app.Flag(
+			"rate-limit-provisioning-burst",
+			"Maximum burst of requests per credential to the provisioning service of SAP Cloud Management.",
+		).Default("10").Int()
+		rateLimitXsuaa = app.Flag(
+			"rate-limit-xsuaa",
+			"Maximum rate of requests per second per credential to the Authorization and Trust Management service. Not limited if 0.",
+		).Default("0").Float64()
+		rateLimitXsuaaBurst = app.Flag(
+			"rate-limit-xsuaa-burst",
+			"Maximum burst of requests per credential to the Authorization and Trust Management service.",
+		).Default("10").Int()
+
+		auditSink = app.Flag(
+			"audit-sink",
+			"Where audit records of the mutating requests to BTP are written to. One of stdout, file and webhook. Requests are not audited if not set.",
+		).Default("").Enum("", audit.SinkStdout, audit.SinkFile, audit.SinkWebhook)
+		auditFile = app.Flag(
+			"audit-file",
+			"Path of the file the audit records are appended to as JSON lines, if the audit sink is file.",
+		).String()
+		auditWebhookURL = app.Flag(
+			"audit-webhook-url",
+			"URL the audit records are posted to as JSON, if the audit sink is webhook.",
+		).String()
+
+		providerConfigCheckInterval = app.Flag(
+			"providerconfig-check-interval",
+			"How often the credentials of ProviderConfigs are checked. They are only checked when a ProviderConfig changes if 0.",
+		).Default("10m").Duration()
+
 		terraformVersion = app.Flag("terraform-version", "Terraform version.").Required().Envar("TERRAFORM_VERSION").String()
 		providerSource   = app.Flag("terraform-provider-source", "Terraform provider source.").Required().Envar("TERRAFORM_PROVIDER_SOURCE").String()
 		providerVersion  = app.Flag("terraform-provider-version", "Terraform provider version.").Required().Envar("TERRAFORM_PROVIDER_VERSION").String()
@@ -83,6 +150,42 @@ func main() {
 	ctrl.SetLogger(zl)
 	btp.SetLogger(log)
 	btp.SetDebug(*debug)
+	btp.SetRateLimit(btp.APIAccounts, btp.RateLimit{RequestsPerSecond: *rateLimitAccounts, Burst: *rateLimitAccountsBurst})
+	btp.SetRateLimit(btp.APIEntitlements, btp.RateLimit{RequestsPerSecond: *rateLimitEntitlements, Burst: *rateLimitEntitlementsBurst})
+	btp.SetRateLimit(btp.APIProvisioning, btp.RateLimit{RequestsPerSecond: *rateLimitProvisioning, Burst: *rateLimitProvisioningBurst})
+	btp.SetRateLimit(btp.APIXsuaa, btp.RateLimit{RequestsPerSecond: *rateLimitXsuaa, Burst: *rateLimitXsuaaBurst})
+
+	sink, err := audit.NewSink(audit.Options{
+		Sink:       *auditSink,
+		File:       *auditFile,
+		WebhookURL: *auditWebhookURL,
+		OnError: func(err error, r audit.Record) {
+			log.Info("Cannot send audit record", "error", err, "method", r.Method, "endpoint", r.Endpoint, "operation", r.Operation)
+		},
+	})
+	kingpin.FatalIfError(err, "Cannot set up audit log")
+	if sink != nil {
+		audit.SetSink(sink)
+		log.Info("Audit log enabled", "sink", *auditSink)
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+
+	if *tracingEndpoint != "" {
+		shutdown, err := tracing.Setup(ctx, tracing.Options{
+			Endpoint:    *tracingEndpoint,
+			Insecure:    *tracingInsecure,
+			SampleRatio: *tracingSampleRatio,
+			Version:     version.ProviderVersion,
+		})
+		kingpin.FatalIfError(err, "Cannot set up tracing")
+		defer func() {
+			if err := shutdown(context.Background()); err != nil {
+				log.Info("Cannot flush traces", "error", err)
+			}
+		}()
+		log.Info("Tracing enabled", "endpoint", *tracingEndpoint)
+	}
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
@@ -112,13 +215,18 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Template APIs to scheme")
 
-	setupTerraformControllers(mgr, log, maxReconcileRate, *pollInterval, backoffBase, backoffMax, enableManagementPolicies, terraformVersion, providerSource, providerVersion)
+	setupTerraformControllers(mgr, log, maxReconcileRate, *pollInterval, backoffBase, backoffMax, *providerConfigCheckInterval, enableManagementPolicies, terraformVersion, providerSource, providerVersion)
 	setupNativeControllers(mgr, log, maxReconcileRate, pollInterval, backoffBase, backoffMax, enableManagementPolicies)
 
-	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
+	startErr := mgr.Start(ctx)
+	if webhook, ok := sink.(*audit.WebhookSink); ok {
+		// send the queued audit records before the provider exits
+		webhook.Close()
+	}
+	kingpin.FatalIfError(startErr, "Cannot start controller manager")
 }
 
-func setupTerraformControllers(mgr manager.Manager, log logging.Logger, maxReconcileRate *int, pollInterval time.Duration, backoffBase *time.Duration, backoffMax *time.Duration, enableManagementPolicies *bool, terraformVersion *string, providerSource *string, providerVersion *string) {
+func setupTerraformControllers(mgr manager.Manager, log logging.Logger, maxReconcileRate *int, pollInterval time.Duration, backoffBase *time.Duration, backoffMax *time.Duration, providerConfigCheckInterval time.Duration, enableManagementPolicies *bool, terraformVersion *string, providerSource *string, providerVersion *string) {
 	o := internalopts.UpjetOptions{
 		Options: tjcontroller.Options{
 			Options: controller.Options{
@@ -134,8 +242,9 @@ func setupTerraformControllers(mgr manager.Manager, log logging.Logger, maxRecon
 			WorkspaceStore: terraform.NewWorkspaceStore(log),
 			SetupFn:        tfclient.TerraformSetupBuilder(*terraformVersion, *providerSource, *providerVersion),
 		},
-		BackoffBase: *backoffBase,
-		BackoffMax:  *backoffMax,
+		BackoffBase:                 *backoffBase,
+		BackoffMax:                  *backoffMax,
+		ProviderConfigCheckInterval: providerConfigCheckInterval,
 	}
 
 	if *enableManagementPolicies {
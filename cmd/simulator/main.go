@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	"github.com/sap/crossplane-provider-btp/internal/simulator"
+)
+
+func main() {
+	var (
+		app    = kingpin.New(filepath.Base(os.Args[0]), "Simulates the BTP APIs of the provider for local development and tests.").DefaultEnvars()
+		listen = app.Flag("listen", "Address the simulator listens on.").Default("localhost:8090").String()
+		delay  = app.Flag("delay", "How long asynchronous operations take.").Default(simulator.DefaultDelay.String()).Duration()
+
+		globalAccount = app.Flag("global-account-subdomain", "Subdomain of the simulated global account.").Default("simulated-global-account").String()
+		entitlements  = app.Flag("entitlement", "Service plan entitled to the global account, as service:plan or service:plan=quota. Can be repeated.").Strings()
+		applications  = app.Flag("application", "SaaS application subaccounts can subscribe to, as application:plan. Can be repeated.").Strings()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	o := simulator.Options{
+		Delay:                  *delay,
+		GlobalAccountSubdomain: *globalAccount,
+	}
+	for _, e := range *entitlements {
+		entitlement, err := parseEntitlement(e)
+		kingpin.FatalIfError(err, "Cannot parse entitlement")
+		o.Entitlements = append(o.Entitlements, entitlement)
+	}
+	for _, a := range *applications {
+		name, plan, ok := strings.Cut(a, ":")
+		if !ok || name == "" || plan == "" {
+			kingpin.Fatalf("Cannot parse application %q: expected application:plan", a)
+		}
+		o.Applications = append(o.Applications, simulator.Application{Name: name, Plan: plan})
+	}
+
+	log.Printf("Simulating BTP APIs on http://%s", *listen)
+	kingpin.FatalIfError(http.ListenAndServe(*listen, simulator.New(o)), "Cannot serve BTP APIs")
+}
+
+// parseEntitlement parses an entitlement of the form service:plan or service:plan=quota.
+func parseEntitlement(s string) (simulator.Entitlement, error) {
+	plan, quota, hasQuota := strings.Cut(s, "=")
+	service, plan, ok := strings.Cut(plan, ":")
+	if !ok || service == "" || plan == "" {
+		return simulator.Entitlement{}, fmt.Errorf("expected service:plan or service:plan=quota, got %q", s)
+	}
+	e := simulator.Entitlement{Service: service, Plan: plan}
+	if hasQuota {
+		q, err := strconv.ParseFloat(quota, 32)
+		if err != nil || q <= 0 {
+			return simulator.Entitlement{}, fmt.Errorf("invalid quota %q of %s", quota, s)
+		}
+		e.Quota = float32(q)
+	}
+	return e, nil
+}
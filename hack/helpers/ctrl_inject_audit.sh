@@ -0,0 +1,15 @@
+#!/usr/bin/env bash
+# This script wraps the connectors of upjet based controllers in the operation connector of the audit package.
+# Their requests to BTP are sent by the Terraform provider, so they are recorded per Create, Update and Delete
+# instead of per request. Since the controller generation is part of upjet we can't do that while generating,
+# so we amend this code afterwards
+set -euo pipefail
+
+REPO_ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/../.." && pwd)"
+
+find "${REPO_ROOT}" -name "zz_controller.go" -print0 | xargs -0 perl -i -0777 -pe \
+  'unless (/audit\.NewOperationConnector/) {
+     my ($gvk) = /xpresource\.ManagedKind\(([\w.]+_GroupVersionKind)\)/;
+     s/managed\.WithExternalConnecter\((tjcontroller\.NewConnector(\((?:[^()]++|(?2))*\)))\)/managed.WithExternalConnecter(audit.NewOperationConnector($gvk, $1))/s;
+     s/(\ttfclient "github\.com\/sap\/crossplane-provider-btp\/internal\/clients\/tfclient")/\t"github.com\/sap\/crossplane-provider-btp\/internal\/audit"\n$1/;
+   }'
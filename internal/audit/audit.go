@@ -0,0 +1,460 @@
+// Package audit records every mutating request the provider sends to BTP.
+//
+// The connector of this package stores the managed resource an ExternalClient
+// works on in the context of its methods. The instrumented clients of the btp
+// package read it from the context of their requests, and write a Record of
+// every POST, PUT, PATCH and DELETE request to the Sink set with SetSink.
+// Kinds whose requests are sent by the Terraform provider instead are recorded
+// per Create, Update and Delete by the operation connector of this package.
+// Records are written as JSON, to a file, to stdout or to a webhook.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Kinds of sinks.
+const (
+	SinkNone    = ""
+	SinkStdout  = "stdout"
+	SinkFile    = "file"
+	SinkWebhook = "webhook"
+)
+
+const (
+	errUnknownSink   = "unknown audit sink %q"
+	errNoFile        = "audit sink file requires a file path"
+	errNoWebhook     = "audit sink webhook requires a webhook URL"
+	errOpenFile      = "cannot open audit log file"
+	errMarshalRecord = "cannot marshal audit record"
+	errWriteRecord   = "cannot write audit record"
+	errSendRecord    = "cannot send audit record to webhook"
+	errWebhookStatus = "audit webhook responded with status %s"
+	errQueueFull     = "audit webhook queue is full, record dropped"
+	errSinkClosed    = "audit sink is closed, record dropped"
+)
+
+// APITerraform is the API of the records of operations whose requests to BTP are sent by the Terraform provider.
+const APITerraform = "terraform"
+
+// Operations recorded for kinds whose requests are sent by the Terraform provider.
+const (
+	OperationCreate = "Create"
+	OperationUpdate = "Update"
+	OperationDelete = "Delete"
+)
+
+// Reasons of records that could not be written.
+const (
+	reasonWrite   = "write"
+	reasonSend    = "send"
+	reasonDropped = "dropped"
+)
+
+const (
+	webhookQueueSize = 1000
+	webhookRetries   = 3
+	webhookBackoff   = time.Second
+)
+
+var failedRecords = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "btp_audit_records_failed_total",
+	Help: "Number of audit records that could not be written, partitioned by sink and reason.",
+}, []string{"sink", "reason"})
+
+func init() {
+	metrics.Registry.MustRegister(failedRecords)
+}
+
+var (
+	sinkMu sync.RWMutex
+	sink   Sink
+)
+
+// SetSink sets the sink that receives the audit records. Nothing is audited if s is nil.
+func SetSink(s Sink) {
+	sinkMu.Lock()
+	defer sinkMu.Unlock()
+	sink = s
+}
+
+// CurrentSink returns the sink set with SetSink, nil if nothing is audited.
+func CurrentSink() Sink {
+	sinkMu.RLock()
+	defer sinkMu.RUnlock()
+	return sink
+}
+
+// Resource identifies the managed resource a request was sent for.
+type Resource struct {
+	GroupVersionKind string `json:"gvk"`
+	Name             string `json:"name"`
+	Namespace        string `json:"namespace,omitempty"`
+	UID              string `json:"uid"`
+}
+
+// Record is the audit record of a mutating request to BTP.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Resource is the managed resource the request was sent for, nil if the request
+	// was not sent by an ExternalClient, e.g. by the exporter.
+	Resource *Resource `json:"resource,omitempty"`
+	// API is the BTP API of the request, e.g. accounts or entitlements, or APITerraform.
+	API string `json:"api,omitempty"`
+	// Operation is the Create, Update or Delete of the managed resource, for kinds whose
+	// requests are sent by the Terraform provider. Method and Endpoint are empty then.
+	Operation string `json:"operation,omitempty"`
+	Method    string `json:"method,omitempty"`
+	Endpoint  string `json:"endpoint,omitempty"`
+	// RequestBody is the body of the request. It is redacted as a whole if it contains
+	// credentials, and JWT tokens in it are redacted.
+	RequestBody string `json:"requestBody,omitempty"`
+	// Status is the HTTP status code of the response, 0 if no response was received.
+	Status        int    `json:"status,omitempty"`
+	CorrelationID string `json:"correlationId,omitempty"`
+	// Error is the reason no response was received, or the error of the operation.
+	Error string `json:"error,omitempty"`
+}
+
+// A Sink receives the audit records.
+type Sink interface {
+	Write(ctx context.Context, r Record) error
+}
+
+// Options select and configure the sink of the audit records.
+type Options struct {
+	// Sink is one of SinkNone, SinkStdout, SinkFile and SinkWebhook.
+	Sink string
+	// File is the path of the file SinkFile appends the records to.
+	File string
+	// WebhookURL is the URL SinkWebhook posts the records to.
+	WebhookURL string
+	// OnError is called with the records SinkWebhook could not send, since they are sent in the background.
+	OnError func(err error, r Record)
+}
+
+// NewSink returns the sink selected by o, or nil for SinkNone.
+func NewSink(o Options) (Sink, error) {
+	switch o.Sink {
+	case SinkNone:
+		return nil, nil
+	case SinkStdout:
+		return NewWriterSink(os.Stdout), nil
+	case SinkFile:
+		if o.File == "" {
+			return nil, errors.New(errNoFile)
+		}
+		return NewFileSink(o.File)
+	case SinkWebhook:
+		if o.WebhookURL == "" {
+			return nil, errors.New(errNoWebhook)
+		}
+		s := NewWebhookSink(o.WebhookURL, &http.Client{Timeout: 10 * time.Second})
+		s.onError = o.OnError
+		return s, nil
+	}
+	return nil, errors.Errorf(errUnknownSink, o.Sink)
+}
+
+// WriterSink writes the records to a writer as JSON lines.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink returns a sink that writes the records to w, one JSON object per line.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// NewFileSink returns a sink that appends the records to the file at path, which is created if it does not exist.
+func NewFileSink(path string) (*WriterSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // the path is configured by the operator of the provider
+	if err != nil {
+		return nil, errors.Wrap(err, errOpenFile)
+	}
+	return NewWriterSink(f), nil
+}
+
+// Write writes r as a line of JSON.
+func (s *WriterSink) Write(_ context.Context, r Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, errMarshalRecord)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err = s.w.Write(append(line, '\n')); err != nil {
+		failedRecords.WithLabelValues(SinkFile, reasonWrite).Inc()
+		return errors.Wrap(err, errWriteRecord)
+	}
+	return nil
+}
+
+// WebhookSink posts every record as JSON to a webhook. Records are queued and sent in the
+// background, so that a slow webhook does not delay the requests to BTP.
+type WebhookSink struct {
+	url     string
+	client  *http.Client
+	backoff time.Duration
+	onError func(err error, r Record)
+
+	mu      sync.RWMutex
+	closed  bool
+	records chan Record
+	done    chan struct{}
+}
+
+// NewWebhookSink returns a sink that posts the records to url with client.
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	return newWebhookSink(url, client, webhookQueueSize, webhookBackoff)
+}
+
+func newWebhookSink(url string, client *http.Client, size int, backoff time.Duration) *WebhookSink {
+	s := &WebhookSink{
+		url:     url,
+		client:  client,
+		backoff: backoff,
+		records: make(chan Record, size),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write queues r to be posted to the webhook. It does not wait for the webhook, and
+// drops r if the queue is full.
+func (s *WebhookSink) Write(_ context.Context, r Record) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		failedRecords.WithLabelValues(SinkWebhook, reasonDropped).Inc()
+		return errors.New(errSinkClosed)
+	}
+	select {
+	case s.records <- r:
+		return nil
+	default:
+		failedRecords.WithLabelValues(SinkWebhook, reasonDropped).Inc()
+		return errors.New(errQueueFull)
+	}
+}
+
+// Close stops accepting records and waits until the queued records are sent.
+func (s *WebhookSink) Close() {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.records)
+	}
+	s.mu.Unlock()
+	<-s.done
+}
+
+func (s *WebhookSink) run() {
+	defer close(s.done)
+	for r := range s.records {
+		if err := s.send(r); err != nil {
+			failedRecords.WithLabelValues(SinkWebhook, reasonSend).Inc()
+			if s.onError != nil {
+				s.onError(err, r)
+			}
+		}
+	}
+}
+
+// send posts r to the webhook, and retries with an exponential backoff unless the webhook rejects r.
+func (s *WebhookSink) send(r Record) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, errMarshalRecord)
+	}
+	backoff := s.backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(body)
+		if err == nil || !retry || attempt == webhookRetries {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post posts body to the webhook, and fails unless it responds with a 2xx status. It returns whether a failed post should be retried.
+func (s *WebhookSink) post(body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, errSendRecord)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, errSendRecord)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		return retry, errors.Errorf(errWebhookStatus, resp.Status)
+	}
+	return false, nil
+}
+
+type resourceKey struct{}
+
+// WithResource returns a copy of ctx that carries r.
+func WithResource(ctx context.Context, r Resource) context.Context {
+	return context.WithValue(ctx, resourceKey{}, r)
+}
+
+// ResourceFrom returns the managed resource carried by ctx, if any.
+func ResourceFrom(ctx context.Context) (Resource, bool) {
+	r, ok := ctx.Value(resourceKey{}).(Resource)
+	return r, ok
+}
+
+// NewConnector wraps c, so that the requests of Connect and of the methods of the connected
+// ExternalClient are attributed to the managed resource of kind gvk they are called with.
+func NewConnector(gvk schema.GroupVersionKind, c managed.ExternalConnector) managed.ExternalConnector {
+	return &connector{connector: c, gvk: gvk}
+}
+
+type connector struct {
+	connector managed.ExternalConnector
+	gvk       schema.GroupVersionKind
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.connector.Connect(withManaged(ctx, c.gvk, mg), mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{external: ext, gvk: c.gvk}, nil
+}
+
+type external struct {
+	external managed.ExternalClient
+	gvk      schema.GroupVersionKind
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return e.external.Observe(withManaged(ctx, e.gvk, mg), mg)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return e.external.Create(withManaged(ctx, e.gvk, mg), mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return e.external.Update(withManaged(ctx, e.gvk, mg), mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return e.external.Delete(withManaged(ctx, e.gvk, mg), mg)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.external.Disconnect(ctx)
+}
+
+func withManaged(ctx context.Context, gvk schema.GroupVersionKind, mg resource.Managed) context.Context {
+	return WithResource(ctx, resourceOf(gvk, mg))
+}
+
+func resourceOf(gvk schema.GroupVersionKind, mg resource.Managed) Resource {
+	return Resource{
+		GroupVersionKind: gvk.String(),
+		Name:             mg.GetName(),
+		Namespace:        mg.GetNamespace(),
+		UID:              string(mg.GetUID()),
+	}
+}
+
+// NewOperationConnector wraps c, so that a record is written for every Create, Update and Delete
+// of the connected ExternalClient. It is meant for kinds whose requests to BTP are sent by the
+// Terraform provider, and thus are not recorded by the clients of the btp package. The operations
+// are attributed to the managed resource in their context, or to the one of kind gvk they are called with.
+func NewOperationConnector(gvk schema.GroupVersionKind, c managed.ExternalConnector) managed.ExternalConnector {
+	return &operationConnector{connector: c, gvk: gvk}
+}
+
+type operationConnector struct {
+	connector managed.ExternalConnector
+	gvk       schema.GroupVersionKind
+}
+
+func (c *operationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &operationExternal{external: ext, gvk: c.gvk}, nil
+}
+
+type operationExternal struct {
+	external managed.ExternalClient
+	gvk      schema.GroupVersionKind
+}
+
+func (e *operationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return e.external.Observe(ctx, mg)
+}
+
+func (e *operationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.external.Create(ctx, mg)
+	e.record(ctx, mg, OperationCreate, err)
+	return c, err
+}
+
+func (e *operationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.external.Update(ctx, mg)
+	e.record(ctx, mg, OperationUpdate, err)
+	return u, err
+}
+
+func (e *operationExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := e.external.Delete(ctx, mg)
+	e.record(ctx, mg, OperationDelete, err)
+	return d, err
+}
+
+func (e *operationExternal) Disconnect(ctx context.Context) error {
+	return e.external.Disconnect(ctx)
+}
+
+// record writes the record of an operation. Failures are not returned, since the operation
+// has been sent already, but counted by the sinks.
+func (e *operationExternal) record(ctx context.Context, mg resource.Managed, operation string, err error) {
+	s := CurrentSink()
+	if s == nil {
+		return
+	}
+	res, ok := ResourceFrom(ctx)
+	if !ok {
+		res = resourceOf(e.gvk, mg)
+	}
+	r := Record{
+		Timestamp: time.Now().UTC(),
+		Resource:  &res,
+		API:       APITerraform,
+		Operation: operation,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	_ = s.Write(ctx, r)
+}
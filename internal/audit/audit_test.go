@@ -0,0 +1,290 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+var record = Record{
+	Timestamp:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	Resource:      &Resource{GroupVersionKind: "account.btp.sap.crossplane.io/v1alpha1, Kind=Subaccount", Name: "my-subaccount", UID: "uid-1"},
+	API:           "accounts",
+	Method:        http.MethodPost,
+	Endpoint:      "https://accounts-service/accounts/v1/subaccounts",
+	RequestBody:   `{"displayName":"my-subaccount"}`,
+	Status:        http.StatusAccepted,
+	CorrelationID: "correlation-1",
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+	require.NoError(t, sink.Write(context.Background(), record))
+	require.NoError(t, sink.Write(context.Background(), Record{Method: http.MethodDelete, Endpoint: "https://accounts-service/accounts/v1/subaccounts/guid", CorrelationID: "correlation-2"}))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	var got Record
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	assert.Equal(t, record, got)
+	assert.NotContains(t, lines[1], "resource")
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte("{}\n"), 0o600))
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), record))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	require.Len(t, lines, 2, "records must be appended")
+	assert.Contains(t, lines[1], `"correlationId":"correlation-1"`)
+}
+
+func TestWebhookSink(t *testing.T) {
+	tests := map[string]struct {
+		statuses  []int
+		wantPosts int
+		wantErr   string
+	}{
+		"Accepted":  {statuses: []int{http.StatusAccepted}, wantPosts: 1},
+		"Rejected":  {statuses: []int{http.StatusBadRequest}, wantPosts: 1, wantErr: "audit webhook responded with status 400 Bad Request"},
+		"Retried":   {statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, wantPosts: 3},
+		"GivenUpOn": {statuses: []int{http.StatusBadGateway}, wantPosts: webhookRetries + 1, wantErr: "audit webhook responded with status 502 Bad Gateway"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []Record
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				var rec Record
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&rec))
+				got = append(got, rec)
+				w.WriteHeader(tc.statuses[min(len(got), len(tc.statuses))-1])
+			}))
+			defer server.Close()
+
+			var errs []string
+			failed := failedCount(t, SinkWebhook, reasonSend)
+			sink := newWebhookSink(server.URL, server.Client(), 10, time.Millisecond)
+			sink.onError = func(err error, r Record) {
+				assert.Equal(t, record, r)
+				errs = append(errs, err.Error())
+			}
+			require.NoError(t, sink.Write(context.Background(), record))
+			sink.Close()
+
+			require.Len(t, got, tc.wantPosts)
+			for _, r := range got {
+				assert.Equal(t, record, r)
+			}
+			if tc.wantErr != "" {
+				assert.Equal(t, []string{tc.wantErr}, errs)
+				assert.Equal(t, failed+1, failedCount(t, SinkWebhook, reasonSend))
+			} else {
+				assert.Empty(t, errs)
+				assert.Equal(t, failed, failedCount(t, SinkWebhook, reasonSend))
+			}
+		})
+	}
+}
+
+func TestWebhookSinkDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	dropped := failedCount(t, SinkWebhook, reasonDropped)
+	sink := newWebhookSink(server.URL, server.Client(), 1, time.Millisecond)
+
+	// the first record is sent and waits for the webhook, the second one is queued
+	require.NoError(t, sink.Write(context.Background(), record))
+	require.Eventually(t, func() bool { return len(sink.records) == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, sink.Write(context.Background(), record))
+
+	err := sink.Write(context.Background(), record)
+	assert.EqualError(t, err, errQueueFull)
+	assert.Equal(t, dropped+1, failedCount(t, SinkWebhook, reasonDropped))
+
+	close(release)
+	sink.Close()
+	assert.EqualError(t, sink.Write(context.Background(), record), errSinkClosed)
+}
+
+func failedCount(t *testing.T, sink, reason string) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, failedRecords.WithLabelValues(sink, reason).Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func TestNewSink(t *testing.T) {
+	tests := map[string]struct {
+		o        Options
+		wantNil  bool
+		wantErr  string
+		wantType Sink
+	}{
+		"None":          {wantNil: true},
+		"Stdout":        {o: Options{Sink: SinkStdout}, wantType: &WriterSink{}},
+		"File":          {o: Options{Sink: SinkFile, File: filepath.Join(t.TempDir(), "audit.log")}, wantType: &WriterSink{}},
+		"FileNoPath":    {o: Options{Sink: SinkFile}, wantErr: errNoFile},
+		"Webhook":       {o: Options{Sink: SinkWebhook, WebhookURL: "https://audit.example.com"}, wantType: &WebhookSink{}},
+		"WebhookNoURL":  {o: Options{Sink: SinkWebhook}, wantErr: errNoWebhook},
+		"UnknownSink":   {o: Options{Sink: "syslog"}, wantErr: `unknown audit sink "syslog"`},
+		"FileNoParents": {o: Options{Sink: SinkFile, File: filepath.Join(t.TempDir(), "missing", "audit.log")}, wantErr: errOpenFile},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewSink(tc.o)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.IsType(t, tc.wantType, got)
+		})
+	}
+}
+
+func TestConnector(t *testing.T) {
+	cr := &v1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "my-subaccount", UID: "uid-1"}}
+	want := Resource{GroupVersionKind: v1alpha1.SubaccountGroupVersionKind.String(), Name: "my-subaccount", UID: "uid-1"}
+
+	var got []Resource
+	capture := func(ctx context.Context) {
+		r, ok := ResourceFrom(ctx)
+		assert.True(t, ok)
+		got = append(got, r)
+	}
+	inner := &managed.ExternalClientFns{
+		ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+			capture(ctx)
+			return managed.ExternalObservation{}, nil
+		},
+		CreateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			capture(ctx)
+			return managed.ExternalCreation{}, nil
+		},
+		UpdateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			capture(ctx)
+			return managed.ExternalUpdate{}, nil
+		},
+		DeleteFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+			capture(ctx)
+			return managed.ExternalDelete{}, nil
+		},
+		DisconnectFn: func(ctx context.Context) error {
+			_, ok := ResourceFrom(ctx)
+			assert.False(t, ok)
+			return nil
+		},
+	}
+	connector := NewConnector(v1alpha1.SubaccountGroupVersionKind, managed.ExternalConnectorFn(func(ctx context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		capture(ctx)
+		return inner, nil
+	}))
+
+	ctx := context.Background()
+	ext, err := connector.Connect(ctx, cr)
+	require.NoError(t, err)
+	_, err = ext.Observe(ctx, cr)
+	require.NoError(t, err)
+	_, err = ext.Create(ctx, cr)
+	require.NoError(t, err)
+	_, err = ext.Update(ctx, cr)
+	require.NoError(t, err)
+	_, err = ext.Delete(ctx, cr)
+	require.NoError(t, err)
+	require.NoError(t, ext.Disconnect(ctx))
+
+	assert.Equal(t, []Resource{want, want, want, want, want}, got)
+}
+
+func TestOperationConnector(t *testing.T) {
+	cr := &v1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "my-subaccount", UID: "uid-1"}}
+	own := Resource{GroupVersionKind: v1alpha1.SubaccountGroupVersionKind.String(), Name: "my-subaccount", UID: "uid-1"}
+	outer := Resource{GroupVersionKind: "account.btp.m.sap.crossplane.io/v1alpha1, Kind=Subaccount", Name: "my-subaccount", Namespace: "team-a", UID: "uid-2"}
+
+	inner := &managed.ExternalClientFns{
+		ObserveFn: func(context.Context, resource.Managed) (managed.ExternalObservation, error) {
+			return managed.ExternalObservation{}, nil
+		},
+		CreateFn: func(context.Context, resource.Managed) (managed.ExternalCreation, error) {
+			return managed.ExternalCreation{}, nil
+		},
+		UpdateFn: func(context.Context, resource.Managed) (managed.ExternalUpdate, error) {
+			return managed.ExternalUpdate{}, errors.New("apply failed")
+		},
+		DeleteFn: func(context.Context, resource.Managed) (managed.ExternalDelete, error) {
+			return managed.ExternalDelete{}, nil
+		},
+	}
+	connector := NewOperationConnector(v1alpha1.SubaccountGroupVersionKind, managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+		return inner, nil
+	}))
+
+	var buf bytes.Buffer
+	SetSink(NewWriterSink(&buf))
+	defer SetSink(nil)
+
+	ctx := context.Background()
+	ext, err := connector.Connect(ctx, cr)
+	require.NoError(t, err)
+	_, err = ext.Observe(ctx, cr)
+	require.NoError(t, err)
+	_, err = ext.Create(ctx, cr)
+	require.NoError(t, err)
+	_, err = ext.Update(ctx, cr)
+	require.EqualError(t, err, "apply failed")
+	_, err = ext.Delete(WithResource(ctx, outer), cr)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3, "observations must not be recorded")
+	var got []Record
+	for _, l := range lines {
+		var r Record
+		require.NoError(t, json.Unmarshal([]byte(l), &r))
+		assert.Equal(t, APITerraform, r.API)
+		assert.Empty(t, r.Method)
+		r.Timestamp = time.Time{}
+		r.API = ""
+		got = append(got, r)
+	}
+	assert.Equal(t, []Record{
+		{Resource: &own, Operation: OperationCreate},
+		{Resource: &own, Operation: OperationUpdate, Error: "apply failed"},
+		{Resource: &outer, Operation: OperationDelete},
+	}, got)
+}
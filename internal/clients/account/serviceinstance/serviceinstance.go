@@ -14,10 +14,15 @@ import (
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// TfNamePrefix prefixes the name of the terraform resource of a ServiceInstance, since terraform resources are not
+// allowed to start with a number.
+const TfNamePrefix = "TF-"
+
 // NewServiceInstanceConnector creates a connector for the service instance client using the generic TfProxyConnector
 func NewServiceInstanceConnector(saveConditionsCallback tfclient.SaveConditionsFn, kube client.Client) tfclient.TfProxyConnectorI[*v1alpha1.ServiceInstance] {
 	con := &ServiceInstanceConnector{
@@ -48,6 +53,9 @@ type ServiceInstanceMapper struct {
 
 func (s *ServiceInstanceMapper) TfResource(ctx context.Context, si *v1alpha1.ServiceInstance, kube client.Client) (*v1alpha1.SubaccountServiceInstance, error) {
 	sInstance := buildBaseTfResource(si)
+	// upjet runs the async callbacks without the context of the reconcile, so the namespace of a namespaced
+	// ServiceInstance is passed to them as the namespace of its terraform resource
+	sInstance.SetNamespace(providerconfig.NamespaceFrom(ctx))
 
 	// combine parameters
 	parameterJson, err := BuildComplexParameterJson(ctx, kube, si.Spec.ForProvider.ParameterSecretRefs, si.Spec.ForProvider.Parameters.Raw)
@@ -98,8 +106,7 @@ func buildBaseTfResource(si *v1alpha1.ServiceInstance) *v1alpha1.SubaccountServi
 			APIVersion: v1alpha1.CRDGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			// since terraform resources are not allowed to start with a number we ensure it by prefixing them with "TF-"
-			Name: "TF-" + si.Name,
+			Name: TfNamePrefix + si.Name,
 			// make sure no naming conflicts are there for upjet tmp folder creation
 			UID:               si.UID + "-service-instance",
 			DeletionTimestamp: si.DeletionTimestamp,
@@ -10,8 +10,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/google/uuid"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -28,6 +30,16 @@ type DirectoryClientI interface {
 	NeedsUpdate(ctx context.Context) (bool, error)
 	SyncStatus(ctx context.Context) error
 	IsAvailable() bool
+	// CreatePayload returns the request CreateDirectory sends.
+	CreatePayload() accountclient.CreateDirectoryRequestPayload
+	// UpdatePayloads returns the requests UpdateDirectory sends, and the directory in BTP in their shape.
+	UpdatePayloads(ctx context.Context) (desired DirectoryUpdate, observed DirectoryUpdate, err error)
+}
+
+// DirectoryUpdate holds the requests for the properties and for the features of a directory, which UpdateDirectory sends one after another.
+type DirectoryUpdate struct {
+	Directory accountclient.UpdateDirectoryRequestPayload     `json:"directory"`
+	Features  accountclient.UpdateDirectoryTypeRequestPayload `json:"features"`
 }
 
 func NewDirectoryClient(btpClient *btp.Client, cr *v1alpha1.Directory) *DirectoryClient {
@@ -52,7 +64,7 @@ func (d *DirectoryClient) UpdateDirectory(ctx context.Context) (*v1alpha1.Direct
 
 	params := d.toUpdateApiPayload()
 
-	_, _, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
+	_, resp, err := d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
 		UpdateDirectory(ctx, d.externalID()).
 		UpdateDirectoryRequestPayload(params).
 		Execute()
@@ -60,11 +72,15 @@ func (d *DirectoryClient) UpdateDirectory(ctx context.Context) (*v1alpha1.Direct
 	if err != nil {
 		return d.cr, specifyAPIError(err)
 	}
+	jobs.Start(d.cr, providerv1alpha1.JobUpdate, jobs.IDFromResponse(resp))
 
-	_, _, err = d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
+	_, resp, err = d.btpClient.AccountsServiceClient.DirectoryOperationsAPI.
 		UpdateDirectoryFeatures(ctx, d.externalID()).
 		UpdateDirectoryTypeRequestPayload(d.toUpdateFeaturesApiPayload()).
 		Execute()
+	if err == nil {
+		jobs.Start(d.cr, providerv1alpha1.JobUpdate, jobs.IDFromResponse(resp))
+	}
 
 	return d.cr, specifyAPIError(err)
 }
@@ -81,6 +97,9 @@ func (d *DirectoryClient) DeleteDirectory(ctx context.Context) error {
 	if resp != nil && resp.StatusCode == 404 {
 		return errors.New(errDirectoryNotFound)
 	}
+	if err == nil {
+		jobs.Start(d.cr, providerv1alpha1.JobDelete, jobs.IDFromResponse(resp))
+	}
 
 	return specifyAPIError(err)
 }
@@ -146,6 +165,7 @@ func (d *DirectoryClient) CreateDirectory(ctx context.Context) (*v1alpha1.Direct
 	guid := directory.Guid
 	ctrl.Log.Info(fmt.Sprintf("directory (%s) created", guid))
 	meta.SetExternalName(d.cr, guid)
+	jobs.Start(d.cr, providerv1alpha1.JobCreate, jobs.IDFromResponse(resp))
 	return d.cr, nil
 }
 
@@ -167,6 +187,39 @@ func (d *DirectoryClient) SyncStatus(ctx context.Context) error {
 	return nil
 }
 
+func (d *DirectoryClient) CreatePayload() accountclient.CreateDirectoryRequestPayload {
+	return d.toCreateApiPayload()
+}
+
+func (d *DirectoryClient) UpdatePayloads(ctx context.Context) (DirectoryUpdate, DirectoryUpdate, error) {
+	desired := DirectoryUpdate{Directory: d.toUpdateApiPayload(), Features: d.toUpdateFeaturesApiPayload()}
+	if d.cachedApi == nil {
+		var err error
+		d.cachedApi, err = d.getDirectory(ctx)
+		if err != nil {
+			return desired, DirectoryUpdate{}, err
+		}
+	}
+	if d.cachedApi == nil {
+		return desired, DirectoryUpdate{}, nil
+	}
+
+	observed := DirectoryUpdate{
+		Directory: accountclient.UpdateDirectoryRequestPayload{
+			Description: d.cachedApi.Description,
+			DisplayName: &d.cachedApi.DisplayName,
+			Labels:      d.cachedApi.Labels,
+		},
+		Features: accountclient.UpdateDirectoryTypeRequestPayload{
+			// the API does not return the admins of a directory
+			DirectoryAdmins:   desired.Features.DirectoryAdmins,
+			DirectoryFeatures: d.cachedApi.DirectoryFeatures,
+			Subdomain:         d.cachedApi.Subdomain,
+		},
+	}
+	return desired, observed, nil
+}
+
 func (d *DirectoryClient) IsAvailable() bool {
 	if d.cr.Status.AtProvider.EntityState == nil {
 		return false
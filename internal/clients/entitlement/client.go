@@ -10,6 +10,7 @@ import (
 
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 )
 
 const (
@@ -23,6 +24,9 @@ type Client interface {
 	CreateInstance(ctx context.Context, key ExternalNameKey, cr *apisv1alpha1.Entitlement) error
 	UpdateInstance(ctx context.Context, key ExternalNameKey, cr *apisv1alpha1.Entitlement) error
 	DeleteInstance(ctx context.Context, key ExternalNameKey, cr *apisv1alpha1.Entitlement) error
+	// JobStatus returns the status of the job applying an entitlement, which the
+	// write methods record in the status of the Entitlement.
+	JobStatus(ctx context.Context, id string) (jobs.Status, error)
 }
 
 type Instance struct {
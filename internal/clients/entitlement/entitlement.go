@@ -3,13 +3,16 @@ package entitlement
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
 	"golang.org/x/sync/singleflight"
 )
@@ -46,6 +49,29 @@ var (
 
 const describeCacheT = 30 * time.Second
 
+func init() {
+	btp.OnEvict(evictDescribeCache)
+}
+
+// describeKey scopes the cache key of key to the credential of the client,
+// so that the entries of a credential can be evicted when it is rotated.
+func (c EntitlementsClient) describeKey(key ExternalNameKey) string {
+	if credential := c.btp.CacheKey(); credential != "" {
+		return credential + "|" + key.CacheKey()
+	}
+	return key.CacheKey()
+}
+
+// evictDescribeCache drops the cached responses of the credential with the given btp.Client CacheKey.
+func evictDescribeCache(credential string) {
+	describeCache.Range(func(k, _ any) bool {
+		if strings.HasPrefix(k.(string), credential+"|") {
+			describeCache.Delete(k)
+		}
+		return true
+	})
+}
+
 // describeEntry caches one GetDirectoryAssignments response; at records
 // when it was issued, for TTL expiry and to reject stale overwrites.
 type describeEntry struct {
@@ -155,7 +181,7 @@ func (c EntitlementsClient) fetchAssignments(
 	key ExternalNameKey,
 	fresh bool,
 ) (*entclient.EntitledAndAssignedServicesResponseObject, error) {
-	cacheKey := key.CacheKey()
+	cacheKey := c.describeKey(key)
 	flightKey := cacheKey
 	if fresh {
 		flightKey = "fresh|" + cacheKey
@@ -186,7 +212,7 @@ func (c EntitlementsClient) fetchAssignments(
 }
 
 func (c EntitlementsClient) CreateInstance(ctx context.Context, key ExternalNameKey, cr *v1alpha1.Entitlement) error {
-	return c.UpdateInstance(ctx, key, cr)
+	return c.setServicePlans(ctx, key, cr, providerv1alpha1.JobCreate)
 }
 
 func (c EntitlementsClient) DeleteInstance(ctx context.Context, key ExternalNameKey, cr *v1alpha1.Entitlement) error {
@@ -215,10 +241,21 @@ func (c EntitlementsClient) DeleteInstance(ctx context.Context, key ExternalName
 		enabled := false
 		cr.Status.AtProvider.Required.Enable = &enabled
 	}
-	return c.UpdateInstance(ctx, key, cr)
+	return c.setServicePlans(ctx, key, cr, providerv1alpha1.JobDelete)
 }
 
 func (c EntitlementsClient) UpdateInstance(ctx context.Context, key ExternalNameKey, cr *v1alpha1.Entitlement) error {
+	return c.setServicePlans(ctx, key, cr, providerv1alpha1.JobUpdate)
+}
+
+// JobStatus returns the status of a job of the entitlements service.
+func (c EntitlementsClient) JobStatus(ctx context.Context, id string) (jobs.Status, error) {
+	return jobs.EntitlementsJobStatus(ctx, c.btp.EntitlementsJobsClient, id)
+}
+
+// setServicePlans sends the required amount or enablement of cr to BTP, and records the
+// job applying it as op on cr.
+func (c EntitlementsClient) setServicePlans(ctx context.Context, key ExternalNameKey, cr *v1alpha1.Entitlement, op providerv1alpha1.JobOperation) error {
 	// AutoAssigned entitlements aren't removable by admin action; Create and
 	// Delete both funnel through here, so this guard alone covers all three
 	// write paths. AutoAssign (user intent) is separate and keeps writing.
@@ -226,18 +263,40 @@ func (c EntitlementsClient) UpdateInstance(ctx context.Context, key ExternalName
 		return nil
 	}
 
-	var amount *float32
-	if cr.Status.AtProvider.Required.Amount != nil {
-		amount = internal.Ptr(float32(*cr.Status.AtProvider.Required.Amount))
+	payload := ServicePlansPayload(key, cr.Status.AtProvider.Required.Amount, cr.Status.AtProvider.Required.Enable)
+
+	jobID, resp, err := c.btp.EntitlementsServiceClient.SetServicePlans(ctx).SubaccountServicePlansRequestPayloadCollection(*payload).Execute()
+
+	if err != nil {
+		return specifyAPIError(err, errors.Wrapf(err, errFailedSetEntitlements, key.ServiceName, key.ServicePlanName))
+	}
+	// the job is referenced by the Location header, and its ID is also the response body
+	if id := jobs.IDFromResponse(resp); id != "" {
+		jobID = id
+	}
+	jobs.Start(cr, op, jobID)
+
+	// Invalidate the singleflight TTL cache so the next Observe reads
+	// fresh state instead of pre-write data.
+	describeCache.Delete(c.describeKey(key))
+
+	return nil
+}
+
+// ServicePlansPayload returns the request that sets the amount or enablement of the service plan of key in its subaccount.
+func ServicePlansPayload(key ExternalNameKey, amount *int, enable *bool) *entclient.SubaccountServicePlansRequestPayloadCollection {
+	var quota *float32
+	if amount != nil {
+		quota = internal.Ptr(float32(*amount))
 	}
 
-	payload := entclient.NewSubaccountServicePlansRequestPayloadCollection(
+	return entclient.NewSubaccountServicePlansRequestPayloadCollection(
 		[]entclient.ServicePlanAssignmentRequestPayload{
 			{
 				AssignmentInfo: []entclient.SubaccountServicePlanRequestPayload{
 					{
-						Amount:         amount,
-						Enable:         cr.Status.AtProvider.Required.Enable,
+						Amount:         quota,
+						Enable:         enable,
 						Resources:      nil,
 						SubaccountGUID: key.SubaccountGUID,
 					},
@@ -248,18 +307,6 @@ func (c EntitlementsClient) UpdateInstance(ctx context.Context, key ExternalName
 			},
 		},
 	)
-
-	_, _, err := c.btp.EntitlementsServiceClient.SetServicePlans(ctx).SubaccountServicePlansRequestPayloadCollection(*payload).Execute()
-
-	if err != nil {
-		return specifyAPIError(err, errors.Wrapf(err, errFailedSetEntitlements, key.ServiceName, key.ServicePlanName))
-	}
-
-	// Invalidate the singleflight TTL cache so the next Observe reads
-	// fresh state instead of pre-write data.
-	describeCache.Delete(key.CacheKey())
-
-	return nil
 }
 
 // findAssignedServicePlan returns the assignment for the given service and service plan, if it exists
@@ -1834,3 +1834,24 @@ func TestDescribeCacheStoreNewestWins(t *testing.T) {
 		}
 	}
 }
+
+// TestEvictDescribeCache pins that evicting a credential drops only the
+// entries cached through clients of that credential, so that a rotation
+// of one ProviderConfig's secret does not cold-start the others.
+func TestEvictDescribeCache(t *testing.T) {
+	resetDescribeState()
+	t.Cleanup(resetDescribeState)
+
+	response := &entclient.EntitledAndAssignedServicesResponseObject{}
+	describeCacheStore("rotated|sa-1|svc|plan", response, time.Now())
+	describeCacheStore("other|sa-1|svc|plan", response, time.Now())
+
+	evictDescribeCache("rotated")
+
+	if _, ok := describeCache.Load("rotated|sa-1|svc|plan"); ok {
+		t.Error("describeCache after eviction: want the entry of the rotated credential evicted, got it retained")
+	}
+	if _, ok := describeCache.Load("other|sa-1|svc|plan"); !ok {
+		t.Error("describeCache after eviction: want the entry of another credential retained, got it evicted")
+	}
+}
@@ -26,6 +26,10 @@ type Client interface {
 	// DeleteInstance deletes the Kyma environment using the external-name.
 	// Returns the HTTP response (for status code checking) and any error.
 	DeleteInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) (*http.Response, error)
+	// CreatePayload returns the request CreateInstance sends.
+	CreatePayload(cr v1alpha1.KymaEnvironment) (provisioningclient.CreateEnvironmentInstanceRequestPayload, error)
+	// UpdatePayload returns the request UpdateInstance sends.
+	UpdatePayload(cr v1alpha1.KymaEnvironment) (provisioningclient.UpdateEnvironmentInstanceRequestPayload, error)
 }
 
 func GenerateObservation(
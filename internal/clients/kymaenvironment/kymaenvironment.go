@@ -57,8 +57,7 @@ func (c KymaEnvironments) DescribeInstance(
 
 func (c KymaEnvironments) CreateInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, error) {
 
-	parameters, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
-	parameters = AddKymaDefaultParameters(parameters, GetKymaEnvironmentName(cr), string(cr.UID))
+	parameters, err := instanceParameters(cr)
 	if err != nil {
 		return "", err
 	}
@@ -97,8 +96,7 @@ func (c KymaEnvironments) UpdateInstance(ctx context.Context, cr v1alpha1.KymaEn
 		return errors.New(errExternalNameNotFound)
 	}
 
-	parameters, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
-	parameters = AddKymaDefaultParameters(parameters, GetKymaEnvironmentName(cr), string(cr.UID))
+	parameters, err := instanceParameters(cr)
 	if err != nil {
 		return err
 	}
@@ -113,6 +111,37 @@ func (c KymaEnvironments) UpdateInstance(ctx context.Context, cr v1alpha1.KymaEn
 	return errors.Wrap(err, errKymaInstanceUpdateFailed)
 }
 
+// CreatePayload returns the request CreateInstance sends.
+func (c KymaEnvironments) CreatePayload(cr v1alpha1.KymaEnvironment) (provisioningclient.CreateEnvironmentInstanceRequestPayload, error) {
+	parameters, err := instanceParameters(cr)
+	if err != nil {
+		return provisioningclient.CreateEnvironmentInstanceRequestPayload{}, err
+	}
+	return btp.NewKymaEnvironmentCreatePayload(
+		GetKymaEnvironmentName(cr),
+		cr.Spec.ForProvider.PlanName,
+		parameters,
+		c.btp.Credential.UserCredential.Email,
+		cr.Spec.ForProvider.LandscapeLabel,
+	), nil
+}
+
+// UpdatePayload returns the request UpdateInstance sends.
+func (c KymaEnvironments) UpdatePayload(cr v1alpha1.KymaEnvironment) (provisioningclient.UpdateEnvironmentInstanceRequestPayload, error) {
+	parameters, err := instanceParameters(cr)
+	if err != nil {
+		return provisioningclient.UpdateEnvironmentInstanceRequestPayload{}, err
+	}
+	return btp.NewKymaEnvironmentUpdatePayload(cr.Spec.ForProvider.PlanName, parameters), nil
+}
+
+// instanceParameters returns the parameters of the spec together with the default parameters of Kyma environments.
+func instanceParameters(cr v1alpha1.KymaEnvironment) (btp.InstanceParameters, error) {
+	parameters, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
+	parameters = AddKymaDefaultParameters(parameters, GetKymaEnvironmentName(cr), string(cr.UID))
+	return parameters, err
+}
+
 func AddKymaDefaultParameters(parameters btp.InstanceParameters, instanceName string, resourceUID string) btp.InstanceParameters {
 	parameters[btp.KymaenvironmentParameterInstanceName] = instanceName
 	return parameters
@@ -9,6 +9,7 @@ import (
 type MockKymaModuleClient struct {
 	err         error
 	apiResponse *v1alpha1.ModuleStatus
+	modules     []Module
 }
 
 // ObserveModule implements KymaModuleClient.ObserveModule
@@ -26,4 +27,9 @@ func (m *MockKymaModuleClient) DeleteModule(ctx context.Context, moduleName stri
 	return m.err
 }
 
+// ListModules implements KymaModuleClient.ListModules
+func (m *MockKymaModuleClient) ListModules(ctx context.Context) ([]Module, error) {
+	return m.modules, m.err
+}
+
 var _ Client = &MockKymaModuleClient{}
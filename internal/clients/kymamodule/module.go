@@ -28,6 +28,7 @@ type Client interface {
 	ObserveModule(ctx context.Context, moduleName string) (*v1alpha1.ModuleStatus, error)
 	CreateModule(ctx context.Context, moduleName string, moduleChannel string, customResourcePolicy string) error
 	DeleteModule(ctx context.Context, moduleName string) error
+	ListModules(ctx context.Context) ([]Module, error)
 }
 
 type KymaModuleClient struct {
@@ -103,6 +104,16 @@ func (c *KymaModuleClient) DeleteModule(ctx context.Context, moduleName string)
 	return updateDefaultKyma(ctx, c, kymaCR)
 }
 
+// ListModules returns the modules enabled in the default Kyma CR in the kyma-system namespace
+func (c *KymaModuleClient) ListModules(ctx context.Context) ([]Module, error) {
+	kymaCR, err := getDefaultKyma(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return kymaCR.Spec.Modules, nil
+}
+
 // getDefaultKyma gets the default Kyma CR from the kyma-system namespace and cast it to the Kyma structure.
 func getDefaultKyma(ctx context.Context, c *KymaModuleClient) (*KymaCr, error) {
 
@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/security"
 	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
@@ -25,7 +26,7 @@ func NewXsuaaRoleCollectionMaintainer(ctx context.Context, clientId, clientSecre
 	apiClientConfig := xsuaa.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
+	apiClientConfig.HTTPClient = config.Client(btp.AddDebugPrintHTTPClientToContext(ctx, btp.WithAPI(btp.APIXsuaa), btp.WithRateLimit(btp.RateLimitKey(clientId, clientSecret, tokenUrl, apiUrl))))
 
 	roleCollectionApi := xsuaa.NewAPIClient(apiClientConfig).RolecollectionsAPI
 
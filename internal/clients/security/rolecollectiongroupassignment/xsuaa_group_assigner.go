@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/security"
 	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
@@ -28,7 +29,7 @@ func NewXsuaaGroupRoleAssigner(ctx context.Context, clientId, clientSecret, toke
 	apiClientConfig := xsuaa.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
+	apiClientConfig.HTTPClient = config.Client(btp.AddDebugPrintHTTPClientToContext(ctx, btp.WithAPI(btp.APIXsuaa), btp.WithRateLimit(btp.RateLimitKey(clientId, clientSecret, tokenUrl, apiUrl))))
 
 	groupApi := xsuaa.NewAPIClient(apiClientConfig).IdpRoleCollectionAPI
 
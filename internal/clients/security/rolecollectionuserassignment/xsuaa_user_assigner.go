@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal/clients/security"
 	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
 	"golang.org/x/oauth2/clientcredentials"
@@ -22,7 +23,7 @@ func NewXsuaaUserRoleAssigner(ctx context.Context, clientId, clientSecret, token
 	apiClientConfig := xsuaa.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
+	apiClientConfig.HTTPClient = config.Client(btp.AddDebugPrintHTTPClientToContext(ctx, btp.WithAPI(btp.APIXsuaa), btp.WithRateLimit(btp.RateLimitKey(clientId, clientSecret, tokenUrl, apiUrl))))
 
 	userApi := xsuaa.NewAPIClient(apiClientConfig).UsercontrollerAPI
 
@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 
 	"github.com/pkg/errors"
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -57,7 +60,7 @@ func NewCredsFromOperatorSecret(secretData map[string][]byte) (BindingCredential
 	if binding.Clientid == nil {
 		return BindingCredentials{}, errors.New(ErrMissingClientId)
 	}
-	if binding.Clientsecret == nil {
+	if binding.Clientsecret == nil && !binding.IsX509() {
 		return BindingCredentials{}, errors.New(ErrMissingClientSecret)
 	}
 	if binding.SmUrl == nil {
@@ -76,6 +79,15 @@ type BindingCredentials struct {
 	SmUrl        *string `json:"sm_url,omitempty"`
 	Url          *string `json:"url,omitempty"`
 	Xsappname    *string `json:"xsappname,omitempty"`
+	// Certificate, Key and Certurl are set instead of Clientsecret for X.509 bindings
+	Certificate *string `json:"certificate,omitempty"`
+	Key         *string `json:"key,omitempty"`
+	Certurl     *string `json:"certurl,omitempty"`
+}
+
+// IsX509 returns true if the binding authenticates with a client certificate over mTLS instead of the client secret.
+func (b *BindingCredentials) IsX509() bool {
+	return internal.Val(b.Certificate) != "" && internal.Val(b.Key) != ""
 }
 
 // ServiceManagerClient is a client for looking up serviceplanID over the service manager API, it requires
@@ -101,6 +113,20 @@ func NewServiceManagerClient(ctx context.Context, creds *BindingCredentials) (*S
 		TokenURL:       internal.Val(creds.Url) + oauthTokenUrlPath,
 		EndpointParams: endPointParams,
 	}
+	httpClient := &http.Client{}
+	if creds.IsX509() {
+		transport, err := btp.NewMTLSTransport(internal.Val(creds.Certificate), internal.Val(creds.Key))
+		if err != nil {
+			log.Error(err, "")
+			return nil, err
+		}
+		httpClient.Transport = transport
+		config.ClientSecret = ""
+		config.AuthStyle = oauth2.AuthStyleInParams
+		if creds.Certurl != nil {
+			config.TokenURL = internal.Val(creds.Certurl) + oauthTokenUrlPath
+		}
+	}
 
 	smURL, err := url.Parse(internal.Val(creds.SmUrl))
 	if err != nil {
@@ -111,7 +137,7 @@ func NewServiceManagerClient(ctx context.Context, creds *BindingCredentials) (*S
 	apiClientConfig := servicemanager.NewConfiguration()
 	apiClientConfig.Host = smURL.Host
 	apiClientConfig.Scheme = smURL.Scheme
-	apiClientConfig.HTTPClient = config.Client(ctx)
+	apiClientConfig.HTTPClient = config.Client(btp.AddDebugPrintHTTPClientToContext(ctx, btp.WithHttpClient(httpClient), btp.WithAPI(btp.APIServiceManager)))
 
 	apiClient := servicemanager.NewAPIClient(apiClientConfig)
 
@@ -27,6 +27,17 @@ func TestNewServiceManagerClient(t *testing.T) {
 			},
 			success: false,
 		},
+		{
+			name: "Invalid client certificate",
+			creds: &BindingCredentials{
+				Clientid:    internal.Ptr("someClientId"),
+				Certificate: internal.Ptr("noCertificate"),
+				Key:         internal.Ptr("noKey"),
+				SmUrl:       internal.Ptr("https://valid.url"),
+				Certurl:     internal.Ptr("https://valid.url"),
+			},
+			success: false,
+		},
 		{
 			name:    "Success",
 			creds:   &BindingCredentials{},
@@ -280,6 +291,25 @@ func TestNewCredsFromOperatorSecret(t *testing.T) {
 				Xsappname:    internal.Ptr("someXsAppName"),
 			},
 		},
+		{
+			name: "Successful Mapping X.509",
+			secret: map[string][]byte{
+				"clientid":    []byte("someClientId"),
+				"certificate": []byte("someCertificate"),
+				"key":         []byte("someKey"),
+				"sm_url":      []byte("https://valid.url"),
+				"tokenurl":    []byte("https://valid.cert.url"),
+				"xsappname":   []byte("someXsAppName"),
+			},
+			o: BindingCredentials{
+				Clientid:    internal.Ptr("someClientId"),
+				Certificate: internal.Ptr("someCertificate"),
+				Key:         internal.Ptr("someKey"),
+				SmUrl:       internal.Ptr("https://valid.url"),
+				Url:         internal.Ptr("https://valid.cert.url"),
+				Xsappname:   internal.Ptr("someXsAppName"),
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -176,9 +176,20 @@ func mapBindingCredentialTypes(in *accountsserviceclient.ServiceManagerBindingRe
 	out.Url = in.Url
 	out.SmUrl = in.SmUrl
 	out.Xsappname = in.Xsappname
+	// X.509 bindings carry their certificate in fields unknown to the generated client
+	out.Certificate = additionalString(in.AdditionalProperties, "certificate")
+	out.Key = additionalString(in.AdditionalProperties, "key")
+	out.Certurl = additionalString(in.AdditionalProperties, "certurl")
 	return out
 }
 
+func additionalString(properties map[string]interface{}, key string) *string {
+	if s, ok := properties[key].(string); ok {
+		return &s
+	}
+	return nil
+}
+
 // specifyAccountsAPIError surfaces the BTP accounts-service error body when present.
 func specifyAccountsAPIError(err error) error {
 	if genericErr, ok := err.(*accountsserviceclient.GenericOpenAPIError); ok {
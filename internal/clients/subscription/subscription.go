@@ -9,6 +9,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
 	"golang.org/x/oauth2/clientcredentials"
 )
@@ -30,11 +31,13 @@ type SubscriptionPut struct {
 
 // SubscriptionApiHandlerI interface that abstracts all API client operations that have to be exposed towards controller
 // represents basic Rest CRUD operations
+// Create, Update and Delete run asynchronously in BTP and return the ID of the job doing so.
 type SubscriptionApiHandlerI interface {
-	CreateSubscription(ctx context.Context, payload SubscriptionPost) (string, error)
-	UpdateSubscription(ctx context.Context, externalName string, payload SubscriptionPut) error
-	DeleteSubscription(ctx context.Context, externalName string) error
+	CreateSubscription(ctx context.Context, payload SubscriptionPost) (externalName string, jobID string, err error)
+	UpdateSubscription(ctx context.Context, externalName string, payload SubscriptionPut) (jobID string, err error)
+	DeleteSubscription(ctx context.Context, externalName string) (jobID string, err error)
 	GetSubscription(ctx context.Context, externalName string) (*SubscriptionGet, error)
+	JobStatus(ctx context.Context, jobID string) (jobs.Status, error)
 }
 
 // SubscriptionTypeMapperI interface to encapsulate all domain logic for making the controller work with otherwise unknown API and its types
@@ -65,8 +68,8 @@ func NewSubscriptionApiHandler(ctx context.Context, clientId string, clientSecre
 		TokenURL:     tokenUrl,
 	}
 
-	//Set a http client that logs the request and response when running in debug
-	btp.AddDebugPrintHTTPClientToContext(ctx)
+	//Set a http client that records metrics and logs the request and response when running in debug
+	ctx = btp.AddDebugPrintHTTPClientToContext(ctx, btp.WithAPI(btp.APISaaS))
 
 	c.HTTPClient = config.Client(ctx)
 	c.Servers = []saas_client.ServerConfiguration{{URL: serviceUrl}}
@@ -78,36 +81,38 @@ type SubscriptionApiHandler struct {
 	client *saas_client.APIClient
 }
 
-func (s *SubscriptionApiHandler) CreateSubscription(ctx context.Context, subPost SubscriptionPost) (string, error) {
-	if _, err := s.client.SubscriptionOperationsForAppConsumersAPI.
+func (s *SubscriptionApiHandler) CreateSubscription(ctx context.Context, subPost SubscriptionPost) (string, string, error) {
+	raw, err := s.client.SubscriptionOperationsForAppConsumersAPI.
 		CreateSubscriptionAsync(ctx, subPost.appName).
 		CreateSubscriptionRequestPayload(subPost.CreateSubscriptionRequestPayload).
-		Execute(); err != nil {
-		return "", specifyAPIError(err)
+		Execute()
+	if err != nil {
+		return "", "", specifyAPIError(err)
 	}
 
-	return formExternalName(subPost.appName, internal.Val(subPost.PlanName)), nil
+	return formExternalName(subPost.appName, internal.Val(subPost.PlanName)), jobs.IDFromResponse(raw), nil
 }
 
-func (s *SubscriptionApiHandler) UpdateSubscription(ctx context.Context, externalName string, subPut SubscriptionPut) error {
+func (s *SubscriptionApiHandler) UpdateSubscription(ctx context.Context, externalName string, subPut SubscriptionPut) (string, error) {
 	appName, _, err := splitExternalName(externalName)
 	if err != nil {
-		return fmt.Errorf("invalid external name %s: %w", externalName, err)
+		return "", fmt.Errorf("invalid external name %s: %w", externalName, err)
 	}
 
-	if _, err := s.client.SubscriptionOperationsForAppConsumersAPI.
+	raw, err := s.client.SubscriptionOperationsForAppConsumersAPI.
 		UpdateSubscriptionParametersAsync(ctx, appName).
 		UpdateSubscriptionRequestPayload(subPut.UpdateSubscriptionRequestPayload).
-		Execute(); err != nil {
-		return specifyAPIError(err)
+		Execute()
+	if err != nil {
+		return "", specifyAPIError(err)
 	}
-	return nil
+	return jobs.IDFromResponse(raw), nil
 }
 
-func (s *SubscriptionApiHandler) DeleteSubscription(ctx context.Context, externalName string) error {
+func (s *SubscriptionApiHandler) DeleteSubscription(ctx context.Context, externalName string) (string, error) {
 	appName, _, err := splitExternalName(externalName)
 	if err != nil {
-		return fmt.Errorf("invalid external name %s: %w", externalName, err)
+		return "", fmt.Errorf("invalid external name %s: %w", externalName, err)
 	}
 
 	raw, err := s.client.SubscriptionOperationsForAppConsumersAPI.
@@ -116,11 +121,16 @@ func (s *SubscriptionApiHandler) DeleteSubscription(ctx context.Context, externa
 	if err != nil {
 		// Handle 404 as success - resource was already deleted externally
 		if raw != nil && (raw.StatusCode == 404 || raw.StatusCode == 429) {
-			return nil
+			return "", nil
 		}
-		return specifyAPIError(err)
+		return "", specifyAPIError(err)
 	}
-	return nil
+	return jobs.IDFromResponse(raw), nil
+}
+
+// JobStatus returns the status of a job of the SaaS provisioning service.
+func (s *SubscriptionApiHandler) JobStatus(ctx context.Context, jobID string) (jobs.Status, error) {
+	return jobs.SaaSJobStatus(ctx, s.client.JobManagementAPI, jobID)
 }
 
 func (s *SubscriptionApiHandler) GetSubscription(ctx context.Context, externalName string) (*SubscriptionGet, error) {
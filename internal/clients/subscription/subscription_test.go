@@ -97,6 +97,7 @@ func TestSubscriptionApiHandler_CreateSubscription(t *testing.T) {
 
 		wantErr      error
 		wantResponse string
+		wantJobID    string
 	}{
 		{
 			name: "APIerror",
@@ -134,6 +135,7 @@ func TestSubscriptionApiHandler_CreateSubscription(t *testing.T) {
 			),
 			wantErr:      nil,
 			wantResponse: "name1/plan2",
+			wantJobID:    "job-1",
 		},
 	}
 	for _, tc := range tests {
@@ -143,7 +145,7 @@ func TestSubscriptionApiHandler_CreateSubscription(t *testing.T) {
 					SubscriptionOperationsForAppConsumersAPI: tc.mockSubscriptionApi,
 				},
 			}
-			sub, err := uut.CreateSubscription(context.TODO(), tc.payload)
+			sub, jobID, err := uut.CreateSubscription(context.TODO(), tc.payload)
 
 			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\nGetSubscription(...): -want error, +got error:\n%s\n", diff)
@@ -151,6 +153,9 @@ func TestSubscriptionApiHandler_CreateSubscription(t *testing.T) {
 			if diff := cmp.Diff(tc.wantResponse, sub); diff != "" {
 				t.Errorf("\nGetSubscription(...): -want, +got:\n%s\n", diff)
 			}
+			if diff := cmp.Diff(tc.wantJobID, jobID); diff != "" {
+				t.Errorf("\nCreateSubscription(...): -want job, +got job:\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -189,7 +194,7 @@ func TestSubscriptionApiHandler_DeleteSubscription(t *testing.T) {
 					SubscriptionOperationsForAppConsumersAPI: tc.mockSubscriptionApi,
 				},
 			}
-			err := uut.DeleteSubscription(context.TODO(), tc.externalName)
+			_, err := uut.DeleteSubscription(context.TODO(), tc.externalName)
 
 			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\nGetSubscription(...): -want error, +got error:\n%s\n", diff)
@@ -246,7 +251,7 @@ func TestSubscriptionApiHandler_UpdateSubscription(t *testing.T) {
 				},
 			}
 
-			err := uut.UpdateSubscription(context.TODO(), tc.externalName, tc.payload)
+			_, err := uut.UpdateSubscription(context.TODO(), tc.externalName, tc.payload)
 
 			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\nGetSubscription(...): -want error, +got error:\n%s\n", diff)
@@ -443,7 +448,7 @@ func apiMockPOST(statusCode int, apiError error) *MockSubscriptionOperationsCons
 	apiMock := &MockSubscriptionOperationsConsumer{}
 	apiMock.
 		On("CreateSubscriptionAsyncExecute", mock.Anything).
-		Return(&http.Response{StatusCode: statusCode}, apiError)
+		Return(&http.Response{StatusCode: statusCode, Header: http.Header{"Location": {"/jobs-management/v1/jobs/job-1/status"}}}, apiError)
 	return apiMock
 }
 
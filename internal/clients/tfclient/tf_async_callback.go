@@ -28,7 +28,7 @@ type APICallbacks struct {
 // Create makes sure the error is saved in async operation condition.
 func (ac *APICallbacks) Create(name types.NamespacedName, _ bool) terraform.CallbackFn {
 	return func(err error, ctx context.Context) error {
-		uErr := ac.saveCallbackFn(ctx, ac.kube, name.String(), ujresource.LastAsyncOperationCondition(err), ujresource.AsyncOperationFinishedCondition())
+		uErr := ac.saveCallbackFn(ctx, ac.kube, name, ujresource.LastAsyncOperationCondition(err), ujresource.AsyncOperationFinishedCondition())
 		return errors.Wrapf(uErr, errUpdateStatusFmt, name, "create")
 	}
 }
@@ -36,7 +36,7 @@ func (ac *APICallbacks) Create(name types.NamespacedName, _ bool) terraform.Call
 // Update makes sure the error is saved in async operation condition.
 func (ac *APICallbacks) Update(name types.NamespacedName, _ bool) terraform.CallbackFn {
 	return func(err error, ctx context.Context) error {
-		uErr := ac.saveCallbackFn(ctx, ac.kube, name.String(), ujresource.LastAsyncOperationCondition(err), ujresource.AsyncOperationFinishedCondition())
+		uErr := ac.saveCallbackFn(ctx, ac.kube, name, ujresource.LastAsyncOperationCondition(err), ujresource.AsyncOperationFinishedCondition())
 		return errors.Wrapf(uErr, errUpdateStatusFmt, name, "update")
 	}
 }
@@ -44,7 +44,7 @@ func (ac *APICallbacks) Update(name types.NamespacedName, _ bool) terraform.Call
 // Destroy makes sure the error is saved in async operation condition.
 func (ac *APICallbacks) Destroy(name types.NamespacedName, _ bool) terraform.CallbackFn {
 	return func(err error, ctx context.Context) error {
-		uErr := ac.saveCallbackFn(ctx, ac.kube, name.String(), ujresource.LastAsyncOperationCondition(err), ujresource.AsyncOperationFinishedCondition())
+		uErr := ac.saveCallbackFn(ctx, ac.kube, name, ujresource.LastAsyncOperationCondition(err), ujresource.AsyncOperationFinishedCondition())
 		return errors.Wrapf(uErr, errUpdateStatusFmt, name, "destroy")
 	}
 }
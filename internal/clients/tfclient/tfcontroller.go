@@ -12,6 +12,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -43,7 +44,9 @@ type TfProxyControllerI interface {
 	GetTfResource() resource.Managed
 }
 
-type SaveConditionsFn func(ctx context.Context, kube client.Client, name string, conditions ...xpv1.Condition) error
+// SaveConditionsFn persists the conditions of an async operation in the CR. name is the name and, for namespaced
+// resources, the namespace of the terraform resource the operation ran for.
+type SaveConditionsFn func(ctx context.Context, kube client.Client, name types.NamespacedName, conditions ...xpv1.Condition) error
 
 // ObservationData is the bridge struct that carries data from the Terraform resource to the Crossplane CR.
 // It is filled by QueryAsyncData() and then saved to the CR status by saveInstanceData().
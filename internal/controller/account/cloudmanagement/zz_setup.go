@@ -6,6 +6,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
 	cmClient "github.com/sap/crossplane-provider-btp/internal/clients/cis"
 	smClient "github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
 	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
@@ -26,7 +27,7 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 	name := managed.ControllerName(apisv1beta1.CloudManagementKind)
 	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name)) //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
 	return providerconfig.DefaultSetupWithoutDefaultInitializer(mgr, o, &apisv1beta1.CloudManagement{}, apisv1beta1.CloudManagementKind, apisv1beta1.CloudManagementGroupVersionKind, func(kube client.Client, usage providerconfig.LegacyTracker, resourcetracker tracking.ReferenceResolverTracker) managed.ExternalConnector {
-		return &connector{
+		return audit.NewOperationConnector(apisv1beta1.CloudManagementGroupVersionKind, &connector{
 			kube:                kube,
 			usage:               usage,
 			resourcetracker:     resourcetracker,
@@ -51,6 +52,6 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 				return proxy.EnsureSemanticLookuper(ctx, cr.Spec.ForProvider.SubaccountGuid)
 			},
 			recorder: recorder,
-		}
+		})
 	})
 }
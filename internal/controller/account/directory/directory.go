@@ -8,10 +8,13 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/directory"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -79,6 +82,11 @@ func (c *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// JobStatus returns the status of a job of the accounts service.
+func (c *external) JobStatus(ctx context.Context, id string) (jobs.Status, error) {
+	return jobs.AccountsJobStatus(ctx, c.btpClient.AccountsServiceClient.JobManagementAPI, id)
+}
+
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	cr, ok := mg.(*v1alpha1.Directory)
 	if !ok {
@@ -177,6 +185,25 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}, nil
 }
 
+// Plan returns the requests Create or Update would send to BTP, for the dry-run of the directory.
+func (c *external) Plan(ctx context.Context, mg resource.Managed, op providerv1alpha1.DryRunOperation) (dryrun.Plan, error) {
+	cr, ok := mg.(*v1alpha1.Directory)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errNotDirectory)
+	}
+
+	directoryHandler := c.handler(cr)
+	if op == providerv1alpha1.DryRunCreate {
+		return dryrun.Plan{Desired: directoryHandler.CreatePayload()}, nil
+	}
+
+	desired, observed, err := directoryHandler.UpdatePayloads(ctx)
+	if err != nil {
+		return dryrun.Plan{}, errors.Wrap(err, errNeedsUpdate)
+	}
+	return dryrun.Plan{Desired: desired, Observed: observed}, nil
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*v1alpha1.Directory)
 	if !ok {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal/clients/directory"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 )
 
 type MockClient struct {
@@ -23,6 +24,19 @@ type MockClient struct {
 	syncErr error
 
 	available bool
+
+	createPayload   accountclient.CreateDirectoryRequestPayload
+	desiredUpdate   directory.DirectoryUpdate
+	observedUpdate  directory.DirectoryUpdate
+	updatePlanError error
+}
+
+func (d MockClient) CreatePayload() accountclient.CreateDirectoryRequestPayload {
+	return d.createPayload
+}
+
+func (d MockClient) UpdatePayloads(ctx context.Context) (directory.DirectoryUpdate, directory.DirectoryUpdate, error) {
+	return d.desiredUpdate, d.observedUpdate, d.updatePlanError
 }
 
 func (d MockClient) IsAvailable() bool {
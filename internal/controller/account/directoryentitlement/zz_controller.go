@@ -31,6 +31,7 @@ import (
 	"github.com/crossplane/upjet/v2/pkg/controller/handler"
 	"github.com/crossplane/upjet/v2/pkg/terraform"
 	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
 	tfclient "github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
 	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -65,9 +66,9 @@ func Setup(mgr ctrl.Manager, o internalopts.UpjetOptions) error {
 	eventHandler := handler.NewEventHandler(handler.WithLogger(o.Logger.WithValues("gvk", v1alpha1.DirectoryEntitlement_GroupVersionKind)))
 	ac := tjcontroller.NewAPICallbacks(mgr, xpresource.ManagedKind(v1alpha1.DirectoryEntitlement_GroupVersionKind), tjcontroller.WithEventHandler(eventHandler))
 	opts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(tjcontroller.NewConnector(mgr.GetClient(), tfclient.NewIdentityInjectingStore(o.WorkspaceStore, o.Logger), o.SetupFn, o.Provider.Resources["btp_directory_entitlement"], tjcontroller.WithLogger(o.Logger), tjcontroller.WithConnectorEventHandler(eventHandler),
+		managed.WithExternalConnecter(audit.NewOperationConnector(v1alpha1.DirectoryEntitlement_GroupVersionKind, tjcontroller.NewConnector(mgr.GetClient(), tfclient.NewIdentityInjectingStore(o.WorkspaceStore, o.Logger), o.SetupFn, o.Provider.Resources["btp_directory_entitlement"], tjcontroller.WithLogger(o.Logger), tjcontroller.WithConnectorEventHandler(eventHandler),
 			tjcontroller.WithCallbackProvider(ac),
-		)),
+		))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithFinalizer(terraform.NewWorkspaceFinalizer(o.WorkspaceStore, xpresource.NewAPIFinalizer(mgr.GetClient(), managed.FinalizerName))),
@@ -19,6 +19,8 @@ import (
 	"github.com/sap/crossplane-provider-btp/btp"
 	entitlementclient "github.com/sap/crossplane-provider-btp/internal/clients/entitlement"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 )
 
@@ -102,6 +104,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}, nil
 }
 
+// JobStatus returns the status of a job of the entitlements service.
+func (c *external) JobStatus(ctx context.Context, id string) (jobs.Status, error) {
+	return c.client.JobStatus(ctx, id)
+}
+
 // Disconnect is a no-op for the external client to close its connection.
 // Since we dont need this, we only have it to fullfil the interface.
 func (c *external) Disconnect(ctx context.Context) error {
@@ -656,6 +663,38 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}, nil
 }
 
+// Plan returns the request Create or Update would send to BTP, for the
+// dry-run of cr. Like UpdateInstance it sends the aggregate of all
+// Entitlements of the plan (status.atProvider.required), not cr's own spec.
+func (c *external) Plan(ctx context.Context, mg resource.Managed, op providerv1alpha1.DryRunOperation) (dryrun.Plan, error) {
+	cr, ok := mg.(*apisv1alpha1.Entitlement)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errNotEntitlement)
+	}
+
+	key, _, err := keyForObserve(cr)
+	if err != nil {
+		return dryrun.Plan{}, errors.Wrap(err, errResolveIdentity)
+	}
+
+	var required apisv1alpha1.EntitlementSummary
+	if cr.Status.AtProvider != nil && cr.Status.AtProvider.Required != nil {
+		required = *cr.Status.AtProvider.Required
+	}
+	plan := dryrun.Plan{Desired: entitlementclient.ServicePlansPayload(key, required.Amount, required.Enable)}
+
+	if op == providerv1alpha1.DryRunUpdate && cr.Status.AtProvider != nil && cr.Status.AtProvider.Assigned != nil {
+		assigned := cr.Status.AtProvider.Assigned
+		// like calculateDiff, enablement is only compared for entitlements that set it
+		var enable *bool
+		if required.Enable != nil {
+			enable = &assigned.UnlimitedAmountAssigned
+		}
+		plan.Observed = entitlementclient.ServicePlansPayload(key, assigned.Amount, enable)
+	}
+	return plan, nil
+}
+
 // Delete resolves cr's identity via keyForObserve, not the strict
 // currentExternalNameKey Update uses: a deleting CR may still carry an
 // empty or legacy annotation from adopting mid-deletion, which keyForObserve accepts.
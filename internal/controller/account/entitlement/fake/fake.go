@@ -5,6 +5,7 @@ import (
 
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal/clients/entitlement"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 )
 
 type MockClient struct {
@@ -13,6 +14,7 @@ type MockClient struct {
 	MockCreateInstanceFn        func(ctx context.Context, key entitlement.ExternalNameKey, cr *apisv1alpha1.Entitlement) error
 	MockUpdateInstanceFn        func(ctx context.Context, key entitlement.ExternalNameKey, cr *apisv1alpha1.Entitlement) error
 	MockDeleteInstanceFn        func(ctx context.Context, key entitlement.ExternalNameKey, cr *apisv1alpha1.Entitlement) error
+	MockJobStatusFn             func(ctx context.Context, id string) (jobs.Status, error)
 }
 
 func (c MockClient) DescribeInstance(ctx context.Context, key entitlement.ExternalNameKey) (
@@ -58,3 +60,9 @@ func (c MockClient) DeleteInstance(ctx context.Context, key entitlement.External
 	}
 	return nil
 }
+func (c MockClient) JobStatus(ctx context.Context, id string) (jobs.Status, error) {
+	if c.MockJobStatusFn != nil {
+		return c.MockJobStatusFn(ctx, id)
+	}
+	return jobs.Status{}, nil
+}
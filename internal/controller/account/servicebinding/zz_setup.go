@@ -11,6 +11,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
 	smClient "github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
 	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
@@ -23,7 +24,7 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name)) //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
 	return providerconfig.DefaultSetup(mgr, o, &v1alpha1.ServiceBinding{}, v1alpha1.ServiceBindingGroupKind, v1alpha1.ServiceBindingGroupVersionKind, func(kube client.Client, usage providerconfig.LegacyTracker, resourcetracker tracking.ReferenceResolverTracker) managed.ExternalConnector {
 		tfConnector := newTfConnectorFn(kube)
-		return &connector{
+		return audit.NewOperationConnector(v1alpha1.ServiceBindingGroupVersionKind, &connector{
 			kube:              kube,
 			usage:             usage,
 			resourcetracker:   resourcetracker,
@@ -43,6 +44,6 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 				return proxy.EnsureSemanticLookuper(ctx, internal.Val(cr.Spec.ForProvider.SubaccountID))
 			},
 			recorder: recorder,
-		}
+		})
 	})
 }
@@ -23,6 +23,7 @@ import (
 	"regexp"
 
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1"
 	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
 	siClient "github.com/sap/crossplane-provider-btp/internal/clients/account/serviceinstance"
@@ -30,6 +31,7 @@ import (
 	tfClient "github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 	"github.com/sap/crossplane-provider-btp/internal/di"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
 	"github.com/sap/crossplane-provider-btp/internal/recovery"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -50,6 +52,10 @@ const (
 	errInitServicePlan = "while initializing service plan"
 	errConnectClient   = "while connecting to service"
 	errDeleteInstance  = "cannot delete serviceinstance"
+	errPlanInstance    = "cannot plan serviceinstance without its terraform resource"
+
+	redactedParameters        = "(redacted, contains parameterSecretRefs)"
+	redactedChangedParameters = "(redacted, differs from spec)"
 )
 
 var uuidRegex = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
@@ -68,12 +74,23 @@ var newServicePlanInitializerFn = func() Initializer {
 	}
 }
 
-// SaveConditionsFn Callback for persisting conditions in the CR
-var saveCallback tfClient.SaveConditionsFn = func(ctx context.Context, kube client.Client, name string, conditions ...xpv1.Condition) error {
+// asyncConditioned is a ServiceInstance of either scope, whose async operation conditions are saved.
+type asyncConditioned interface {
+	client.Object
+	resource.Conditioned
+}
 
-	si := &v1alpha1.ServiceInstance{}
+// SaveConditionsFn Callback for persisting conditions in the CR. The name is the one of the terraform resource,
+// which carries the namespace of namespaced ServiceInstances, since upjet runs the callback without the context
+// of the reconcile.
+var saveCallback tfClient.SaveConditionsFn = func(ctx context.Context, kube client.Client, name types.NamespacedName, conditions ...xpv1.Condition) error {
 
-	nn := types.NamespacedName{Name: name}
+	var si asyncConditioned = &v1alpha1.ServiceInstance{}
+	if name.Namespace != "" {
+		si = &namespacedv1alpha1.ServiceInstance{}
+	}
+
+	nn := types.NamespacedName{Namespace: name.Namespace, Name: strings.TrimPrefix(name.Name, siClient.TfNamePrefix)}
 	if kErr := kube.Get(ctx, nn, si); kErr != nil {
 		return errors.Wrap(kErr, errGetInstance)
 	}
@@ -81,7 +98,7 @@ var saveCallback tfClient.SaveConditionsFn = func(ctx context.Context, kube clie
 	// Store the CR's current generation on each condition so that Observe() can
 	// detect whether the spec has changed since the async operation was triggered.
 	for i := range conditions {
-		conditions[i].ObservedGeneration = si.Generation
+		conditions[i].ObservedGeneration = si.GetGeneration()
 	}
 	si.SetConditions(conditions...)
 
@@ -447,8 +464,23 @@ func (e *external) calculateDiff(cr *v1alpha1.ServiceInstance) string {
 		return fmt.Sprintf("Drift detected: unexpected resource type %T", tfResource)
 	}
 
-	// Build desired state from Spec.ForProvider (what user wants)
-	desired := map[string]any{
+	// Compare all fields between desired and observed state
+	diff := cmp.Diff(desiredState(upjettedSI), observedState(upjettedSI))
+
+	if diff == "" {
+		// If no structural diff found, check async operation message
+		if asyncCond := cr.GetCondition(ujresource.TypeAsyncOperation); asyncCond.Message != "" {
+			return fmt.Sprintf("Drift detected. Terraform message: %s", asyncCond.Message)
+		}
+		return "Drift detected: external resource differs from desired state"
+	}
+
+	return diff
+}
+
+// desiredState builds the desired state from Spec.ForProvider (what user wants)
+func desiredState(upjettedSI *v1alpha1.SubaccountServiceInstance) map[string]any {
+	return map[string]any{
 		"name":           upjettedSI.Spec.ForProvider.Name,
 		"subaccount_id":  upjettedSI.Spec.ForProvider.SubaccountID,
 		"shared":         upjettedSI.Spec.ForProvider.Shared,
@@ -456,9 +488,11 @@ func (e *external) calculateDiff(cr *v1alpha1.ServiceInstance) string {
 		"serviceplan_id": upjettedSI.Spec.ForProvider.ServiceplanID,
 		"labels":         upjettedSI.Spec.ForProvider.Labels,
 	}
+}
 
-	// Build observed state from Status.AtProvider (what API returned)
-	observed := map[string]any{
+// observedState builds the observed state from Status.AtProvider (what API returned)
+func observedState(upjettedSI *v1alpha1.SubaccountServiceInstance) map[string]any {
+	return map[string]any{
 		"name":           upjettedSI.Status.AtProvider.Name,
 		"subaccount_id":  upjettedSI.Status.AtProvider.SubaccountID,
 		"shared":         upjettedSI.Status.AtProvider.Shared,
@@ -466,19 +500,45 @@ func (e *external) calculateDiff(cr *v1alpha1.ServiceInstance) string {
 		"serviceplan_id": upjettedSI.Status.AtProvider.ServiceplanID,
 		"labels":         upjettedSI.Status.AtProvider.Labels,
 	}
+}
 
-	// Compare all fields between desired and observed state
-	diff := cmp.Diff(desired, observed)
+// Plan returns the state Create or Update would apply through the Terraform provider, for the dry-run of the service instance.
+// Parameters merged with parameterSecretRefs are redacted, since the result is written to the status.
+func (e *external) Plan(ctx context.Context, mg resource.Managed, op providerv1alpha1.DryRunOperation) (dryrun.Plan, error) {
+	cr, ok := mg.(*v1alpha1.ServiceInstance)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errNotServiceInstance)
+	}
+	upjettedSI, ok := e.tfClient.GetTfResource().(*v1alpha1.SubaccountServiceInstance)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errPlanInstance)
+	}
 
-	if diff == "" {
-		// If no structural diff found, check async operation message
-		if asyncCond := cr.GetCondition(ujresource.TypeAsyncOperation); asyncCond.Message != "" {
-			return fmt.Sprintf("Drift detected. Terraform message: %s", asyncCond.Message)
-		}
-		return "Drift detected: external resource differs from desired state"
+	desired := desiredState(upjettedSI)
+	var observed map[string]any
+	if op == providerv1alpha1.DryRunUpdate {
+		observed = observedState(upjettedSI)
+	}
+	if len(cr.Spec.ForProvider.ParameterSecretRefs) > 0 {
+		redactParameters(desired, observed)
+	}
+	plan := dryrun.Plan{Desired: desired}
+	if observed != nil {
+		plan.Observed = observed
 	}
+	return plan, nil
+}
 
-	return diff
+// redactParameters replaces the parameters of desired and observed, only showing whether they differ.
+func redactParameters(desired, observed map[string]any) {
+	if observed != nil {
+		if cmp.Equal(desired["parameters"], observed["parameters"]) {
+			observed["parameters"] = redactedParameters
+		} else {
+			observed["parameters"] = redactedChangedParameters
+		}
+	}
+	desired["parameters"] = redactedParameters
 }
 
 func isValidUUID(s string) bool {
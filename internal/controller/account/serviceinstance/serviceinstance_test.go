@@ -10,11 +10,16 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sap/crossplane-provider-btp/apis"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -948,7 +953,7 @@ func TestCalculateDiff(t *testing.T) {
 func TestSaveCallback(t *testing.T) {
 	type args struct {
 		kube       client.Client
-		name       string
+		name       types.NamespacedName
 		conditions []xpv1.Condition
 	}
 
@@ -965,7 +970,7 @@ func TestSaveCallback(t *testing.T) {
 			reason: "should return an error if the ServiceInstance cannot be retrieved",
 			args: args{
 				kube: &test.MockClient{MockGet: test.NewMockGetFn(errKube)},
-				name: "test-instance",
+				name: types.NamespacedName{Name: "TF-test-instance"},
 			},
 			want: want{
 				err: errKube,
@@ -978,7 +983,7 @@ func TestSaveCallback(t *testing.T) {
 					MockGet:          test.NewMockGetFn(nil),
 					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errKube),
 				},
-				name:       "test-instance",
+				name:       types.NamespacedName{Name: "TF-test-instance"},
 				conditions: []xpv1.Condition{ujresource.AsyncOperationFinishedCondition()},
 			},
 			want: want{
@@ -992,7 +997,7 @@ func TestSaveCallback(t *testing.T) {
 					MockGet:          test.NewMockGetFn(nil),
 					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
 				},
-				name:       "test-instance",
+				name:       types.NamespacedName{Name: "TF-test-instance"},
 				conditions: []xpv1.Condition{ujresource.AsyncOperationFinishedCondition()},
 			},
 			want: want{
@@ -1009,6 +1014,37 @@ func TestSaveCallback(t *testing.T) {
 	}
 }
 
+func TestSaveCallbackNamespaced(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := apis.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	clusterScoped := &v1alpha1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "test-instance"}}
+	namespaced := &namespacedv1alpha1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team-a", Generation: 2}}
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(clusterScoped, namespaced).WithStatusSubresource(clusterScoped, namespaced).Build()
+
+	err := saveCallback(context.Background(), kube, types.NamespacedName{Namespace: "team-a", Name: "TF-test-instance"}, ujresource.AsyncOperationFinishedCondition())
+	if err != nil {
+		t.Fatalf("saveCallback() unexpected error: %v", err)
+	}
+
+	gotNamespaced := &namespacedv1alpha1.ServiceInstance{}
+	if err := kube.Get(context.Background(), types.NamespacedName{Namespace: "team-a", Name: "test-instance"}, gotNamespaced); err != nil {
+		t.Fatal(err)
+	}
+	if got := gotNamespaced.GetCondition(ujresource.TypeAsyncOperation); got.Reason != ujresource.ReasonFinished || got.ObservedGeneration != 2 {
+		t.Errorf("namespaced ServiceInstance: want finished async operation of generation 2, got %+v", got)
+	}
+
+	gotClusterScoped := &v1alpha1.ServiceInstance{}
+	if err := kube.Get(context.Background(), types.NamespacedName{Name: "test-instance"}, gotClusterScoped); err != nil {
+		t.Fatal(err)
+	}
+	if len(gotClusterScoped.Status.Conditions) != 0 {
+		t.Errorf("cluster scoped ServiceInstance with the same name must not be changed, got conditions %+v", gotClusterScoped.Status.Conditions)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type fields struct {
 		client  *TfProxyMock
@@ -8,6 +8,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
 	smClient "github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
 	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
@@ -22,7 +23,7 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 	name := managed.ControllerName(v1alpha1.ServiceInstanceGroupKind)
 	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name)) //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
 	return providerconfig.DefaultSetupWithoutDefaultInitializer(mgr, o, &v1alpha1.ServiceInstance{}, v1alpha1.ServiceInstanceGroupKind, v1alpha1.ServiceInstanceGroupVersionKind, func(kube client.Client, usage providerconfig.LegacyTracker, resourcetracker tracking.ReferenceResolverTracker) managed.ExternalConnector {
-		return &connector{
+		return audit.NewOperationConnector(v1alpha1.ServiceInstanceGroupVersionKind, &connector{
 			kube:  kube,
 			usage: usage,
 
@@ -46,6 +47,6 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 				return proxy.EnsureSemanticLookuper(ctx, internal.Val(cr.Spec.ForProvider.SubaccountID))
 			},
 			recorder: recorder,
-		}
+		})
 	})
 }
@@ -8,6 +8,7 @@ import (
 	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	apisv1beta1 "github.com/sap/crossplane-provider-btp/apis/account/v1beta1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
 	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
 	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
 	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
@@ -33,7 +34,7 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 		func(kube client.Client,
 			usage providerconfig.LegacyTracker,
 			resourcetracker tracking.ReferenceResolverTracker) managed.ExternalConnector {
-			return &connector{
+			return audit.NewOperationConnector(apisv1beta1.ServiceManagerGroupVersionKind, &connector{
 				kube:            kube,
 				newServiceFn:    btp.NewBTPClient,
 				resourcetracker: resourcetracker,
@@ -69,6 +70,6 @@ func Setup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 					return proxy.EnsureSemanticLookuper(ctx, cr.Spec.ForProvider.SubaccountGuid)
 				},
 				recorder: recorder,
-			}
+			})
 		})
 }
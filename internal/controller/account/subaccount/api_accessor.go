@@ -6,13 +6,16 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 )
 
 // AccountsApiAccessor abstraction to handle API operations by coordinating to generated api client
 type AccountsApiAccessor interface {
-	MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) error
-	UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) error
+	// MoveSubaccount and UpdateSubaccount return the ID of the asynchronous BTP job
+	// performing the change, if BTP started one.
+	MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) (string, error)
+	UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) (string, error)
 	// SubaccountGuidBySubdomain looks up a subaccount by subdomain (unique in a
 	// global account) and returns its BTP-reported createdAt for the ownership
 	// check in internal/recovery.
@@ -23,24 +26,24 @@ type AccountsClient struct {
 	btp btp.Client
 }
 
-func (a *AccountsClient) UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) error {
-	_, _, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+func (a *AccountsClient) UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) (string, error) {
+	_, resp, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
 		UpdateSubaccount(ctx, subaccountGuid).
 		UpdateSubaccountRequestPayload(payload).
 		Execute()
-	return err
+	return jobs.IDFromResponse(resp), err
 }
 
-func (a *AccountsClient) MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) error {
+func (a *AccountsClient) MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) (string, error) {
 	if targetId == "" {
-		return errors.New("targetId must be set for move subaccount api call")
+		return "", errors.New("targetId must be set for move subaccount api call")
 	}
-	_, _, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+	_, resp, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
 		MoveSubaccount(ctx, subaccountGuid).
 		MoveSubaccountRequestPayload(
 			accountclient.MoveSubaccountRequestPayload{TargetAccountGUID: targetId}).
 		Execute()
-	return err
+	return jobs.IDFromResponse(resp), err
 }
 
 var _ AccountsApiAccessor = &AccountsClient{}
@@ -11,6 +11,7 @@ import (
 
 type MockAccountsApiAccessor struct {
 	LastMoveTarget string
+	returnJobID    string
 	returnErr      error
 
 	lookupGuid      string
@@ -20,13 +21,13 @@ type MockAccountsApiAccessor struct {
 	lookupCalls     int
 }
 
-func (m *MockAccountsApiAccessor) MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) error {
+func (m *MockAccountsApiAccessor) MoveSubaccount(ctx context.Context, subaccountGuid string, targetId string) (string, error) {
 	m.LastMoveTarget = targetId
-	return m.returnErr
+	return m.returnJobID, m.returnErr
 }
 
-func (m *MockAccountsApiAccessor) UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) error {
-	return m.returnErr
+func (m *MockAccountsApiAccessor) UpdateSubaccount(ctx context.Context, subaccountGuid string, payload accountclient.UpdateSubaccountRequestPayload) (string, error) {
+	return m.returnJobID, m.returnErr
 }
 
 func (m *MockAccountsApiAccessor) SubaccountGuidBySubdomain(ctx context.Context, subdomain string) (string, time.Time, bool, error) {
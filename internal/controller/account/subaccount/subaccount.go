@@ -3,6 +3,7 @@ package subaccount
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 	"reflect"
 	"strings"
@@ -23,6 +24,8 @@ import (
 	"github.com/sap/crossplane-provider-btp/btp"
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 	"github.com/sap/crossplane-provider-btp/internal/recovery"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
@@ -247,6 +250,7 @@ func (c *external) generateObservation(
 	desiredState.Status.AtProvider.StatusMessage = subaccount.StateMessage
 	desiredState.Status.AtProvider.BetaEnabled = &subaccount.BetaEnabled
 	desiredState.Status.AtProvider.Labels = subaccount.Labels
+	desiredState.Status.AtProvider.CustomProperties = toCustomPropertiesMap(subaccount.CustomProperties)
 	desiredState.Status.AtProvider.Description = &subaccount.Description
 	desiredState.Status.AtProvider.Subdomain = &subaccount.Subdomain
 	desiredState.Status.AtProvider.DisplayName = &subaccount.DisplayName
@@ -262,6 +266,11 @@ func resetRemoteState(state *apisv1alpha1.Subaccount) {
 	state.Status.AtProvider = apisv1alpha1.SubaccountObservation{}
 }
 
+// JobStatus returns the status of a job of the accounts service.
+func (c *external) JobStatus(ctx context.Context, id string) (jobs.Status, error) {
+	return jobs.AccountsJobStatus(ctx, c.btp.AccountsServiceClient.JobManagementAPI, id)
+}
+
 func (c *external) needsCreation(cr *apisv1alpha1.Subaccount) bool {
 	if cr.Status.AtProvider.SubaccountGuid == nil {
 		return true
@@ -308,6 +317,10 @@ func needsUpdate(desired apisv1alpha1.SubaccountSpec, actual apisv1alpha1.Subacc
 	// Remove non-diff relevant information
 
 	filter(cleanedActual.Labels, apisv1alpha1.SubaccountOperatorLabel)
+	// BTP lists custom properties as labels as well
+	for k := range cleanedDesired.CustomProperties {
+		filter(cleanedActual.Labels, k)
+	}
 	cleanedDesired.SubaccountAdmins = nil
 
 	if cleanedDesired.Description == "" && cleanedActual.Description == nil {
@@ -326,6 +339,9 @@ func needsUpdate(desired apisv1alpha1.SubaccountSpec, actual apisv1alpha1.Subacc
 	if changedLabels(cleanedDesired.Labels, cleanedActual.Labels) {
 		return true
 	}
+	if !maps.Equal(cleanedDesired.CustomProperties, observedCustomProperties(&desired.ForProvider, &actual.AtProvider)) {
+		return true
+	}
 	if !reflect.DeepEqual(&cleanedDesired.BetaEnabled, cleanedActual.BetaEnabled) {
 		return true
 	}
@@ -366,6 +382,36 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}, nil
 }
 
+// Plan returns the request Create or Update would send to BTP, for the dry-run of the subaccount.
+func (c *external) Plan(ctx context.Context, mg resource.Managed, op providerv1alpha1.DryRunOperation) (dryrun.Plan, error) {
+	cr, ok := mg.(*apisv1alpha1.Subaccount)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errNotSubaccount)
+	}
+
+	if op == providerv1alpha1.DryRunCreate {
+		return dryrun.Plan{Desired: toCreateApiPayload(cr)}, nil
+	}
+
+	observed := cr.Status.AtProvider
+	if directoryParentChanged(&cr.Spec.ForProvider, &observed) {
+		return dryrun.Plan{
+			Desired:  accountclient.MoveSubaccountRequestPayload{TargetAccountGUID: moveTarget(cr)},
+			Observed: accountclient.MoveSubaccountRequestPayload{TargetAccountGUID: internal.Val(observed.ParentGuid)},
+		}, nil
+	}
+	return dryrun.Plan{
+		Desired: toUpdateApiPayload(cr),
+		Observed: accountclient.UpdateSubaccountRequestPayload{
+			BetaEnabled:       observed.BetaEnabled,
+			Description:       observed.Description,
+			DisplayName:       internal.Val(observed.DisplayName),
+			Labels:            observed.Labels,
+			UsedForProduction: observed.UsedForProduction,
+		},
+	}, nil
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*apisv1alpha1.Subaccount)
 	if !ok {
@@ -408,6 +454,7 @@ func deleteBTPSubaccount(
 		return errors.Wrap(specifyAPIError(err), "deletion of subaccount failed")
 	}
 
+	jobs.Start(subaccount, providerv1alpha1.JobDelete, jobs.IDFromResponse(raw))
 	return nil
 }
 
@@ -424,36 +471,22 @@ func (c *external) updateBTPSubaccount(
 func (c *external) moveSubaccountAPI(ctx context.Context, subaccount *apisv1alpha1.Subaccount) error {
 	guid := meta.GetExternalName(subaccount)
 
-	targetID := subaccount.Spec.ForProvider.DirectoryGuid
-	// if not specified we need to set the global account as parent
-	if emptyDirectoryRef(&subaccount.Spec.ForProvider) {
-		targetID = internal.Val(subaccount.Status.AtProvider.GlobalAccountGUID)
-	}
-
-	err := c.accountsAccessor.MoveSubaccount(ctx, guid, targetID)
+	jobID, err := c.accountsAccessor.MoveSubaccount(ctx, guid, moveTarget(subaccount))
 	if err != nil {
 		return errors.Wrap(specifyAPIError(err), errMoveSubaccount)
 	}
+	jobs.Start(subaccount, providerv1alpha1.JobUpdate, jobID)
 	return nil
 }
 
 func (c *external) updateSubaccountAPI(ctx context.Context, subaccount *apisv1alpha1.Subaccount) error {
 	guid := meta.GetExternalName(subaccount)
 
-	label := addOperatorLabel(subaccount)
-
-	params := accountclient.UpdateSubaccountRequestPayload{
-		BetaEnabled:       &subaccount.Spec.ForProvider.BetaEnabled,
-		Description:       &subaccount.Spec.ForProvider.Description,
-		DisplayName:       subaccount.Spec.ForProvider.DisplayName,
-		Labels:            &label,
-		UsedForProduction: &subaccount.Spec.ForProvider.UsedForProduction,
-	}
-
-	err := c.accountsAccessor.UpdateSubaccount(ctx, guid, params)
+	jobID, err := c.accountsAccessor.UpdateSubaccount(ctx, guid, toUpdateApiPayload(subaccount))
 	if err != nil {
 		return errors.Wrap(specifyAPIError(err), errUpdateAPI)
 	}
+	jobs.Start(subaccount, providerv1alpha1.JobUpdate, jobID)
 	return nil
 }
 
@@ -484,6 +517,7 @@ func (c *external) createBTPSubaccount(
 	subaccount.Status.AtProvider.ParentGuid = &createdSubaccount.ParentGUID
 
 	meta.SetExternalName(subaccount, guid)
+	jobs.Start(subaccount, providerv1alpha1.JobCreate, jobs.IDFromResponse(resp))
 
 	return nil
 }
@@ -550,14 +584,62 @@ func toCreateApiPayload(subaccount *apisv1alpha1.Subaccount) accountclient.Creat
 	}
 }
 
+func toUpdateApiPayload(subaccount *apisv1alpha1.Subaccount) accountclient.UpdateSubaccountRequestPayload {
+	label := addOperatorLabel(subaccount)
+
+	return accountclient.UpdateSubaccountRequestPayload{
+		BetaEnabled:       &subaccount.Spec.ForProvider.BetaEnabled,
+		Description:       &subaccount.Spec.ForProvider.Description,
+		DisplayName:       subaccount.Spec.ForProvider.DisplayName,
+		Labels:            &label,
+		UsedForProduction: &subaccount.Spec.ForProvider.UsedForProduction,
+	}
+}
+
+func toCustomPropertiesMap(properties []accountclient.PropertyResponseObject) map[string]string {
+	if len(properties) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(properties))
+	for _, property := range properties {
+		result[property.Key] = property.Value
+	}
+	return result
+}
+
+// observedCustomProperties returns the observed custom properties that are not labels of the spec. Since
+// BTP lists labels as custom properties as well, those are managed through the labels of the spec.
+func observedCustomProperties(spec *apisv1alpha1.SubaccountParameters, status *apisv1alpha1.SubaccountObservation) map[string]string {
+	result := maps.Clone(status.CustomProperties)
+	maps.DeleteFunc(result, func(k, _ string) bool {
+		_, isLabel := spec.Labels[k]
+		return isLabel || k == apisv1alpha1.SubaccountOperatorLabel
+	})
+	return result
+}
+
+// moveTarget returns the GUID of the directory or global account the subaccount belongs in.
+func moveTarget(subaccount *apisv1alpha1.Subaccount) string {
+	// if not specified we need to set the global account as parent
+	if emptyDirectoryRef(&subaccount.Spec.ForProvider) {
+		return internal.Val(subaccount.Status.AtProvider.GlobalAccountGUID)
+	}
+	return subaccount.Spec.ForProvider.DirectoryGuid
+}
+
 func addOperatorLabel(subaccount *apisv1alpha1.Subaccount) map[string][]string {
-	if subaccount.Spec.ForProvider.Labels == nil {
+	if subaccount.Spec.ForProvider.Labels == nil && subaccount.Spec.ForProvider.CustomProperties == nil {
 		return map[string][]string{}
 	}
 	labels := map[string][]string{}
 	for k, v := range subaccount.Spec.ForProvider.Labels {
 		labels[k] = v
 	}
+	// BTP ignores custom properties of a request that has labels, so they are sent as single-valued labels,
+	// which replace those of the subaccount, including the custom properties removed from the spec
+	for k, v := range subaccount.Spec.ForProvider.CustomProperties {
+		labels[k] = []string{v}
+	}
 	labels[apisv1alpha1.SubaccountOperatorLabel] = []string{string(subaccount.UID)}
 	return labels
 }
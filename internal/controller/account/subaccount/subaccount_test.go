@@ -2,8 +2,10 @@ package subaccount
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -15,7 +17,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
 	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
 	"github.com/sap/crossplane-provider-btp/internal/testutils"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
@@ -915,6 +919,140 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"CustomPropertiesUpToDate": {
+			reason: "Custom properties listed as labels by BTP should not require Update",
+			args: args{
+				cr: NewSubaccount("unittest-sa",
+					WithExternalName(SAMPLE_GUID),
+					WithData(v1alpha1.SubaccountParameters{
+						Subdomain:        "sub1",
+						Region:           "eu12",
+						DisplayName:      "unittest-sa",
+						Labels:           map[string]v1alpha1.SubaccountLabelValueList{"team": {"a", "b"}},
+						CustomProperties: map[string]string{"costCenter": "4711"},
+					}), WithProviderConfig(xpv1.Reference{
+						Name: "unittest-pc",
+					})),
+				mockAPIClient: &MockSubaccountClient{
+					returnSubaccount: &accountclient.SubaccountResponseObject{
+						Guid:         SAMPLE_GUID,
+						Subdomain:    "sub1",
+						Region:       "eu12",
+						State:        "OK",
+						StateMessage: internal.Ptr("OK"),
+						DisplayName:  "unittest-sa",
+						Labels: &map[string][]string{
+							"team":       {"a", "b"},
+							"costCenter": {"4711"},
+						},
+						CustomProperties: []accountclient.PropertyResponseObject{
+							{AccountGUID: SAMPLE_GUID, Key: "team", Value: "a"},
+							{AccountGUID: SAMPLE_GUID, Key: "team", Value: "b"},
+							{AccountGUID: SAMPLE_GUID, Key: "costCenter", Value: "4711"},
+						},
+					},
+				},
+				mockKube: func() test.MockClient {
+					mockClient := testutils.NewFakeKubeClientBuilder().
+						AddResources(testutils.NewProviderConfig("unittest-pc", "", "")).
+						Build()
+					// Mock the Update function to not panic
+					mockClient.MockUpdate = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						return nil
+					}
+					return mockClient
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				crChanges: func(cr *v1alpha1.Subaccount) {
+					cr.Status.AtProvider.SubaccountGuid = internal.Ptr(SAMPLE_GUID)
+					cr.Status.AtProvider.Status = internal.Ptr("OK")
+					cr.Status.AtProvider.Region = internal.Ptr("eu12")
+					cr.Status.AtProvider.Subdomain = internal.Ptr("sub1")
+					cr.Status.AtProvider.Labels = &map[string][]string{"team": {"a", "b"}, "costCenter": {"4711"}}
+					cr.Status.AtProvider.CustomProperties = map[string]string{"team": "b", "costCenter": "4711"}
+					cr.Status.AtProvider.Description = internal.Ptr("")
+					cr.Status.AtProvider.StatusMessage = internal.Ptr("OK")
+					cr.Status.AtProvider.DisplayName = internal.Ptr("unittest-sa")
+					cr.Status.AtProvider.UsedForProduction = internal.Ptr("")
+					cr.Status.AtProvider.BetaEnabled = internal.Ptr(false)
+					cr.Status.AtProvider.ParentGuid = internal.Ptr("")
+					cr.Status.AtProvider.GlobalAccountGUID = internal.Ptr("")
+				},
+			},
+		},
+		"NeedsUpdateCustomProperty": {
+			reason: "Changed custom property should require Update",
+			args: args{
+				cr: NewSubaccount("unittest-sa",
+					WithExternalName(SAMPLE_GUID),
+					WithData(v1alpha1.SubaccountParameters{
+						Subdomain:        "sub1",
+						Region:           "eu12",
+						DisplayName:      "unittest-sa",
+						Labels:           map[string]v1alpha1.SubaccountLabelValueList{"team": {"a", "b"}},
+						CustomProperties: map[string]string{"costCenter": "4712"},
+					}), WithProviderConfig(xpv1.Reference{
+						Name: "unittest-pc",
+					})),
+				mockAPIClient: &MockSubaccountClient{
+					returnSubaccount: &accountclient.SubaccountResponseObject{
+						Guid:         SAMPLE_GUID,
+						Subdomain:    "sub1",
+						Region:       "eu12",
+						State:        "OK",
+						StateMessage: internal.Ptr("OK"),
+						DisplayName:  "unittest-sa",
+						Labels: &map[string][]string{
+							"team":       {"a", "b"},
+							"costCenter": {"4711"},
+						},
+						CustomProperties: []accountclient.PropertyResponseObject{
+							{AccountGUID: SAMPLE_GUID, Key: "team", Value: "a"},
+							{AccountGUID: SAMPLE_GUID, Key: "team", Value: "b"},
+							{AccountGUID: SAMPLE_GUID, Key: "costCenter", Value: "4711"},
+						},
+					},
+				},
+				mockKube: func() test.MockClient {
+					mockClient := testutils.NewFakeKubeClientBuilder().
+						AddResources(testutils.NewProviderConfig("unittest-pc", "", "")).
+						Build()
+					// Mock the Update function to not panic
+					mockClient.MockUpdate = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						return nil
+					}
+					return mockClient
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				crChanges: func(cr *v1alpha1.Subaccount) {
+					cr.Status.AtProvider.SubaccountGuid = internal.Ptr(SAMPLE_GUID)
+					cr.Status.AtProvider.Status = internal.Ptr("OK")
+					cr.Status.AtProvider.Region = internal.Ptr("eu12")
+					cr.Status.AtProvider.Subdomain = internal.Ptr("sub1")
+					cr.Status.AtProvider.Labels = &map[string][]string{"team": {"a", "b"}, "costCenter": {"4711"}}
+					cr.Status.AtProvider.CustomProperties = map[string]string{"team": "b", "costCenter": "4711"}
+					cr.Status.AtProvider.Description = internal.Ptr("")
+					cr.Status.AtProvider.StatusMessage = internal.Ptr("OK")
+					cr.Status.AtProvider.DisplayName = internal.Ptr("unittest-sa")
+					cr.Status.AtProvider.UsedForProduction = internal.Ptr("")
+					cr.Status.AtProvider.BetaEnabled = internal.Ptr(false)
+					cr.Status.AtProvider.ParentGuid = internal.Ptr("")
+					cr.Status.AtProvider.GlobalAccountGUID = internal.Ptr("")
+				},
+			},
+		},
 		"ExternalNameMigrationSuccess": {
 			reason: "Resource with name as external-name should be found by subdomain+region and external-name should be updated to GUID",
 			args: args{
@@ -1960,6 +2098,210 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestPlan(t *testing.T) {
+	type want struct {
+		plan dryrun.Plan
+		err  error
+	}
+
+	tests := map[string]struct {
+		reason string
+		cr     *v1alpha1.Subaccount
+		op     providerv1alpha1.DryRunOperation
+		want   want
+	}{
+		"Create": {
+			reason: "The create payload of the spec should be planned",
+			cr:     NewSubaccount("unittest-sa", WithData(v1alpha1.SubaccountParameters{DisplayName: "dev", Subdomain: "dev-sub", Region: "eu10"})),
+			op:     providerv1alpha1.DryRunCreate,
+			want: want{
+				plan: dryrun.Plan{Desired: accountclient.CreateSubaccountRequestPayload{
+					BetaEnabled:       internal.Ptr(false),
+					Description:       internal.Ptr(""),
+					DisplayName:       "dev",
+					Labels:            &map[string][]string{},
+					Region:            "eu10",
+					Subdomain:         internal.Ptr("dev-sub"),
+					UsedForProduction: internal.Ptr(""),
+					ParentGUID:        internal.Ptr(""),
+				}},
+			},
+		},
+		"Move": {
+			reason: "A changed directory should be planned as move",
+			cr: NewSubaccount("unittest-sa",
+				WithData(v1alpha1.SubaccountParameters{DisplayName: "dev", DirectoryGuid: "dir-guid"}),
+				WithStatus(v1alpha1.SubaccountObservation{ParentGuid: internal.Ptr("ga-guid"), GlobalAccountGUID: internal.Ptr("ga-guid")})),
+			op: providerv1alpha1.DryRunUpdate,
+			want: want{
+				plan: dryrun.Plan{
+					Desired:  accountclient.MoveSubaccountRequestPayload{TargetAccountGUID: "dir-guid"},
+					Observed: accountclient.MoveSubaccountRequestPayload{TargetAccountGUID: "ga-guid"},
+				},
+			},
+		},
+		"Update": {
+			reason: "Changed fields should be planned as update against the observed subaccount",
+			cr: NewSubaccount("unittest-sa",
+				WithData(v1alpha1.SubaccountParameters{DisplayName: "dev", Description: "new"}),
+				WithStatus(v1alpha1.SubaccountObservation{
+					ParentGuid:        internal.Ptr("ga-guid"),
+					GlobalAccountGUID: internal.Ptr("ga-guid"),
+					DisplayName:       internal.Ptr("dev"),
+					Description:       internal.Ptr("old"),
+				})),
+			op: providerv1alpha1.DryRunUpdate,
+			want: want{
+				plan: dryrun.Plan{
+					Desired: accountclient.UpdateSubaccountRequestPayload{
+						BetaEnabled:       internal.Ptr(false),
+						Description:       internal.Ptr("new"),
+						DisplayName:       "dev",
+						Labels:            &map[string][]string{},
+						UsedForProduction: internal.Ptr(""),
+					},
+					Observed: accountclient.UpdateSubaccountRequestPayload{
+						Description: internal.Ptr("old"),
+						DisplayName: "dev",
+					},
+				},
+			},
+		},
+		"CreateWithCustomProperties": {
+			reason: "Custom properties should be planned as labels only, because BTP ignores custom properties sent with labels",
+			cr: NewSubaccount("unittest-sa", WithData(v1alpha1.SubaccountParameters{
+				DisplayName:      "dev",
+				Subdomain:        "dev-sub",
+				Region:           "eu10",
+				CustomProperties: map[string]string{"costCenter": "4711"},
+			})),
+			op: providerv1alpha1.DryRunCreate,
+			want: want{
+				plan: dryrun.Plan{Desired: accountclient.CreateSubaccountRequestPayload{
+					BetaEnabled: internal.Ptr(false),
+					Description: internal.Ptr(""),
+					DisplayName: "dev",
+					Labels: &map[string][]string{
+						"costCenter":                     {"4711"},
+						v1alpha1.SubaccountOperatorLabel: {""},
+					},
+					Region:            "eu10",
+					Subdomain:         internal.Ptr("dev-sub"),
+					UsedForProduction: internal.Ptr(""),
+					ParentGUID:        internal.Ptr(""),
+				}},
+			},
+		},
+		"UpdateCustomProperties": {
+			reason: "Custom properties should be planned as labels, custom properties removed from the spec are removed from the labels",
+			cr: NewSubaccount("unittest-sa",
+				WithData(v1alpha1.SubaccountParameters{
+					DisplayName:      "dev",
+					Labels:           map[string]v1alpha1.SubaccountLabelValueList{"team": {"a"}},
+					CustomProperties: map[string]string{"costCenter": "4712"},
+				}),
+				WithStatus(v1alpha1.SubaccountObservation{
+					ParentGuid:        internal.Ptr("ga-guid"),
+					GlobalAccountGUID: internal.Ptr("ga-guid"),
+					DisplayName:       internal.Ptr("dev"),
+					Labels:            &map[string][]string{"team": {"a"}, "costCenter": {"4711"}, "owner": {"jane"}},
+					CustomProperties:  map[string]string{"team": "a", "costCenter": "4711", "owner": "jane"},
+				})),
+			op: providerv1alpha1.DryRunUpdate,
+			want: want{
+				plan: dryrun.Plan{
+					Desired: accountclient.UpdateSubaccountRequestPayload{
+						BetaEnabled: internal.Ptr(false),
+						Description: internal.Ptr(""),
+						DisplayName: "dev",
+						Labels: &map[string][]string{
+							"team":                           {"a"},
+							"costCenter":                     {"4712"},
+							v1alpha1.SubaccountOperatorLabel: {""},
+						},
+						UsedForProduction: internal.Ptr(""),
+					},
+					Observed: accountclient.UpdateSubaccountRequestPayload{
+						DisplayName: "dev",
+						Labels:      &map[string][]string{"team": {"a"}, "costCenter": {"4711"}, "owner": {"jane"}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := external{}
+			got, err := ctrl.Plan(context.Background(), tc.cr, tc.op)
+			if contained := testutils.ContainsError(err, tc.want.err); !contained {
+				t.Errorf("\ne.Plan(...): error \"%v\" not part of \"%v\"", err, tc.want.err)
+			}
+			if diff := cmp.Diff(tc.want.plan, got); diff != "" {
+				t.Errorf("\n%s\ne.Plan(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// TestRequestBodyCustomProperties checks the request bodies sent to the accounts service, which ignores the
+// custom properties of a request that has labels.
+func TestRequestBodyCustomProperties(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("cannot decode request body: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	config := accountclient.NewConfiguration()
+	config.Servers = accountclient.ServerConfigurations{{URL: server.URL}}
+	api := accountclient.NewAPIClient(config).SubaccountOperationsAPI
+
+	cr := NewSubaccount("unittest-sa",
+		WithData(v1alpha1.SubaccountParameters{
+			DisplayName:      "dev",
+			Subdomain:        "dev-sub",
+			Region:           "eu10",
+			Labels:           map[string]v1alpha1.SubaccountLabelValueList{"team": {"a", "b"}},
+			CustomProperties: map[string]string{"costCenter": "4712"},
+		}),
+		WithStatus(v1alpha1.SubaccountObservation{
+			Labels:           &map[string][]string{"team": {"a", "b"}, "costCenter": {"4711"}, "owner": {"jane"}},
+			CustomProperties: map[string]string{"team": "a", "costCenter": "4711", "owner": "jane"},
+		}))
+	wantLabels := map[string]any{
+		"team":                           []any{"a", "b"},
+		"costCenter":                     []any{"4712"},
+		v1alpha1.SubaccountOperatorLabel: []any{""},
+	}
+
+	tests := map[string]func() error{
+		"Create": func() error {
+			_, _, err := api.CreateSubaccount(context.Background()).CreateSubaccountRequestPayload(toCreateApiPayload(cr)).Execute()
+			return err
+		},
+		"Update": func() error {
+			_, _, err := api.UpdateSubaccount(context.Background(), SAMPLE_GUID).UpdateSubaccountRequestPayload(toUpdateApiPayload(cr)).Execute()
+			return err
+		},
+	}
+	for name, send := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := send(); err != nil {
+				t.Fatalf("sending request: %v", err)
+			}
+			if _, ok := body["customProperties"]; ok {
+				t.Errorf("request body must not contain customProperties besides labels: %v", body)
+			}
+			if diff := cmp.Diff(wantLabels, body["labels"]); diff != "" {
+				t.Errorf("labels of the request body: -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func NewSubaccount(name string, m ...SubaccountModifier) *v1alpha1.Subaccount {
 	cr := &v1alpha1.Subaccount{
 		ObjectMeta: metav1.ObjectMeta{Name: name},
@@ -0,0 +1,125 @@
+package subaccountsettings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+const errDecodeSettings = "cannot decode settings of subaccount"
+
+// SettingsApiAccessor abstraction to handle API operations by coordinating to generated api client
+type SettingsApiAccessor interface {
+	// GetSettings returns the settings of the subaccount, found is false if the subaccount doesn't exist.
+	GetSettings(ctx context.Context, subaccountGuid string) (settings map[string]string, found bool, err error)
+	// UpdateSettings creates or updates the settings of the subaccount.
+	UpdateSettings(ctx context.Context, subaccountGuid string, payload accountclient.EntitySettingsRequestPayload) error
+	// DeleteSettings deletes the settings with the keys from the subaccount, found is false if the subaccount doesn't exist.
+	DeleteSettings(ctx context.Context, subaccountGuid string, keys []string) (found bool, err error)
+}
+
+// setting is a setting in the requests and responses of the settings API. The generated models type the
+// value of a setting as an object, while the accounts service expects and returns a string, so requests
+// carry the settings as additional properties and responses are decoded from their body.
+type setting struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type settingsResponse struct {
+	Values []struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	} `json:"values"`
+}
+
+type SettingsClient struct {
+	btp btp.Client
+}
+
+var _ SettingsApiAccessor = &SettingsClient{}
+
+func (a *SettingsClient) GetSettings(ctx context.Context, subaccountGuid string) (map[string]string, bool, error) {
+	_, resp, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+		GetSubaccountSettings(ctx, subaccountGuid).
+		Execute()
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if resp == nil || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, false, specifyAPIError(err)
+	}
+
+	var body settingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, false, errors.Wrap(err, errDecodeSettings)
+	}
+	settings := map[string]string{}
+	for _, v := range body.Values {
+		var value string
+		if err := json.Unmarshal(v.Value, &value); err != nil {
+			// settings of other types than string are kept in their JSON form
+			value = string(v.Value)
+		}
+		settings[v.Key] = value
+	}
+	return settings, true, nil
+}
+
+func (a *SettingsClient) UpdateSettings(ctx context.Context, subaccountGuid string, payload accountclient.EntitySettingsRequestPayload) error {
+	_, resp, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+		CreateOrUpdateSubaccountSettings(ctx, subaccountGuid).
+		EntitySettingsRequestPayload(payload).
+		Execute()
+	if resp != nil && resp.StatusCode < http.StatusMultipleChoices {
+		// the response is decoded into the generated model, which fails for string values
+		return nil
+	}
+	return specifyAPIError(err)
+}
+
+func (a *SettingsClient) DeleteSettings(ctx context.Context, subaccountGuid string, keys []string) (bool, error) {
+	_, resp, err := a.btp.AccountsServiceClient.SubaccountOperationsAPI.
+		DeleteSubaccountSettings(ctx, subaccountGuid).
+		Keys(keys).
+		Execute()
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp != nil && resp.StatusCode < http.StatusMultipleChoices {
+		return true, nil
+	}
+	return true, specifyAPIError(err)
+}
+
+// toSettingsPayload returns the request for the settings, sorted by key.
+func toSettingsPayload(settings map[string]string) accountclient.EntitySettingsRequestPayload {
+	list := []setting{}
+	for _, k := range slices.Sorted(maps.Keys(settings)) {
+		list = append(list, setting{Key: k, Value: settings[k]})
+	}
+	return accountclient.EntitySettingsRequestPayload{
+		AdditionalProperties: map[string]interface{}{"entitySettings": list},
+	}
+}
+
+func specifyAPIError(err error) error {
+	if genericErr, ok := err.(*accountclient.GenericOpenAPIError); ok {
+		if accountError, ok := genericErr.Model().(accountclient.ApiExceptionResponseObject); ok {
+			return errors.New(fmt.Sprintf("API Error: %v, Code %v", internal.Val(accountError.Error.Message), internal.Val(accountError.Error.Code)))
+		}
+		if genericErr.Body() != nil {
+			return fmt.Errorf("API Error: %s", string(genericErr.Body()))
+		}
+	}
+	return err
+}
@@ -0,0 +1,93 @@
+package subaccountsettings
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sap/crossplane-provider-btp/btp"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+func newTestSettingsClient(t *testing.T, handler http.HandlerFunc) *SettingsClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := accountclient.NewConfiguration()
+	cfg.Servers = accountclient.ServerConfigurations{{URL: server.URL}}
+	return &SettingsClient{btp: btp.Client{AccountsServiceClient: accountclient.NewAPIClient(cfg)}}
+}
+
+func TestGetSettings(t *testing.T) {
+	client := newTestSettingsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/accounts/v1/subaccounts/"+SAMPLE_GUID+"/settings", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[{"entityType":"SUBACCOUNT","key":"costCenter","value":"4711"},{"entityType":"SUBACCOUNT","key":"limits","value":{"max":3}}]}`))
+	})
+
+	settings, found, err := client.GetSettings(context.Background(), SAMPLE_GUID)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, map[string]string{"costCenter": "4711", "limits": `{"max":3}`}, settings)
+}
+
+func TestGetSettingsNotFound(t *testing.T) {
+	client := newTestSettingsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":11004,"message":"Subaccount not found"}}`))
+	})
+
+	settings, found, err := client.GetSettings(context.Background(), SAMPLE_GUID)
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Nil(t, settings)
+}
+
+func TestUpdateSettings(t *testing.T) {
+	var body string
+	client := newTestSettingsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[{"entityType":"SUBACCOUNT","key":"costCenter","value":"4711"}]}`))
+	})
+
+	err := client.UpdateSettings(context.Background(), SAMPLE_GUID, toSettingsPayload(map[string]string{"owner": "jane", "costCenter": "4711"}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"entitySettings":[{"key":"costCenter","value":"4711"},{"key":"owner","value":"jane"}]}`, body)
+}
+
+func TestUpdateSettingsError(t *testing.T) {
+	client := newTestSettingsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"code":11000,"message":"Invalid setting"}}`))
+	})
+
+	err := client.UpdateSettings(context.Background(), SAMPLE_GUID, toSettingsPayload(map[string]string{"costCenter": "4711"}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid setting")
+}
+
+func TestDeleteSettings(t *testing.T) {
+	var keys []string
+	client := newTestSettingsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		keys = r.URL.Query()["keys"]
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values":[]}`))
+	})
+
+	found, err := client.DeleteSettings(context.Background(), SAMPLE_GUID, []string{"costCenter", "owner"})
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.ElementsMatch(t, []string{"costCenter", "owner"}, keys)
+}
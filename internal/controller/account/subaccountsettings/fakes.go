@@ -0,0 +1,36 @@
+package subaccountsettings
+
+import (
+	"context"
+
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+type MockSettingsApiAccessor struct {
+	returnSettings map[string]string
+	returnNotFound bool
+	returnErr      error
+
+	LastGuid        string
+	LastPayload     *accountclient.EntitySettingsRequestPayload
+	LastDeletedKeys []string
+}
+
+func (m *MockSettingsApiAccessor) GetSettings(ctx context.Context, subaccountGuid string) (map[string]string, bool, error) {
+	m.LastGuid = subaccountGuid
+	return m.returnSettings, !m.returnNotFound, m.returnErr
+}
+
+func (m *MockSettingsApiAccessor) UpdateSettings(ctx context.Context, subaccountGuid string, payload accountclient.EntitySettingsRequestPayload) error {
+	m.LastGuid = subaccountGuid
+	m.LastPayload = &payload
+	return m.returnErr
+}
+
+func (m *MockSettingsApiAccessor) DeleteSettings(ctx context.Context, subaccountGuid string, keys []string) (bool, error) {
+	m.LastGuid = subaccountGuid
+	m.LastDeletedKeys = keys
+	return !m.returnNotFound, m.returnErr
+}
+
+var _ SettingsApiAccessor = &MockSettingsApiAccessor{}
@@ -0,0 +1,252 @@
+package subaccountsettings
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotSubaccountSettings = "managed resource is not a SubaccountSettings custom resource"
+	errConnect               = "while connecting to provider"
+	errInvalidExternalName   = "external-name is not a valid GUID format"
+	errNoSubaccount          = "subaccountGuid is not set, reference a subaccount with subaccountRef or subaccountSelector"
+	errSubaccountMismatch    = "external-name '%s' does not match subaccountGuid '%s'"
+	errObserve               = "while observing subaccount settings"
+	errCreate                = "while creating subaccount settings"
+	errUpdate                = "while updating subaccount settings"
+	errDelete                = "while deleting subaccount settings"
+	errRemoveUnmanaged       = "while removing unmanaged subaccount settings"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube            client.Client
+	usage           providerconfig.LegacyTracker
+	resourcetracker tracking.ReferenceResolverTracker
+
+	newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)
+}
+
+// Connect produces an ExternalClient for the accounts service of the ProviderConfig of the managed resource.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*apisv1alpha1.SubaccountSettings); !ok {
+		return nil, errors.New(errNotSubaccountSettings)
+	}
+
+	btpclient, err := providerconfig.CreateClient(ctx, mg, c.kube, c.usage, c.newServiceFn, c.resourcetracker)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{
+		settingsAccessor: &SettingsClient{btp: *btpclient},
+		tracker:          c.resourcetracker,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes the
+// settings of a subaccount to ensure they reflect the managed resource's desired state.
+type external struct {
+	settingsAccessor SettingsApiAccessor
+	tracker          tracking.ReferenceResolverTracker
+}
+
+// Disconnect is a no-op for the external client to close its connection.
+// Since we dont need this, we only have it to fullfil the interface.
+func (c *external) Disconnect(ctx context.Context) error {
+	return nil
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*apisv1alpha1.SubaccountSettings)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSubaccountSettings)
+	}
+
+	// ADR Step 1: Check if external-name is empty
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// ADR Step 2: External-name is set, check its format (must be valid GUID)
+	if !internal.IsValidUUID(meta.GetExternalName(cr)) {
+		return managed.ExternalObservation{}, errors.Wrap(errors.New(fmt.Sprintf("external-name '%s'", meta.GetExternalName(cr))), errInvalidExternalName)
+	}
+
+	// The settings of the external-name are managed, so it must be the subaccount the spec refers to
+	if guid := cr.Spec.ForProvider.SubaccountGuid; guid != "" && guid != meta.GetExternalName(cr) {
+		return managed.ExternalObservation{}, errors.Errorf(errSubaccountMismatch, meta.GetExternalName(cr), guid)
+	}
+
+	// ADR Step 3: Get the settings of the subaccount from the external-name
+	settings, found, err := c.settingsAccessor.GetSettings(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserve)
+	}
+	cr.Status.AtProvider.Settings = settings
+
+	c.tracker.SetConditions(ctx, cr)
+
+	// The settings exist as long as one of the settings of the spec is set in the subaccount
+	if !found || !hasAnySetting(cr.Spec.ForProvider.Settings, settings) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  !needsUpdate(cr.Spec.ForProvider, settings),
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*apisv1alpha1.SubaccountSettings)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSubaccountSettings)
+	}
+
+	guid := cr.Spec.ForProvider.SubaccountGuid
+	if guid == "" {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errNoSubaccount), errCreate)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	if err := c.apply(ctx, cr, guid); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	// ADR: Successful creation - set external-name to the subaccount the settings belong to
+	meta.SetExternalName(cr, guid)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*apisv1alpha1.SubaccountSettings)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSubaccountSettings)
+	}
+
+	if err := c.apply(ctx, cr, meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// apply sets the settings of the spec in the subaccount and removes unmanaged settings, if requested.
+func (c *external) apply(ctx context.Context, cr *apisv1alpha1.SubaccountSettings, guid string) error {
+	if err := c.settingsAccessor.UpdateSettings(ctx, guid, toSettingsPayload(cr.Spec.ForProvider.Settings)); err != nil {
+		return err
+	}
+
+	unmanaged := unmanagedKeys(cr.Spec.ForProvider, cr.Status.AtProvider.Settings)
+	if len(unmanaged) == 0 {
+		return nil
+	}
+	_, err := c.settingsAccessor.DeleteSettings(ctx, guid, unmanaged)
+	return errors.Wrap(err, errRemoveUnmanaged)
+}
+
+// SettingsUpdate holds the requests Update sends one after another, the settings to create or
+// update and the keys of the settings to delete.
+type SettingsUpdate struct {
+	Settings accountclient.EntitySettingsRequestPayload `json:"settings"`
+	Delete   []string                                   `json:"delete,omitempty"`
+}
+
+// Plan returns the requests Create or Update would send to BTP, for the dry-run of the subaccount settings.
+func (c *external) Plan(ctx context.Context, mg resource.Managed, op providerv1alpha1.DryRunOperation) (dryrun.Plan, error) {
+	cr, ok := mg.(*apisv1alpha1.SubaccountSettings)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errNotSubaccountSettings)
+	}
+
+	if op == providerv1alpha1.DryRunCreate {
+		return dryrun.Plan{Desired: toSettingsPayload(cr.Spec.ForProvider.Settings)}, nil
+	}
+
+	observed := cr.Status.AtProvider.Settings
+	return dryrun.Plan{
+		Desired: SettingsUpdate{
+			Settings: toSettingsPayload(cr.Spec.ForProvider.Settings),
+			Delete:   unmanagedKeys(cr.Spec.ForProvider, observed),
+		},
+		Observed: SettingsUpdate{
+			Settings: toSettingsPayload(observed),
+		},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*apisv1alpha1.SubaccountSettings)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSubaccountSettings)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	// Only the settings of the spec are deleted, unmanaged settings are left to the subaccount
+	keys := slices.Sorted(maps.Keys(cr.Spec.ForProvider.Settings))
+	// ADR: 404 not found means already deleted - not considered as error case
+	if _, err := c.settingsAccessor.DeleteSettings(ctx, meta.GetExternalName(cr), keys); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func hasAnySetting(desired, observed map[string]string) bool {
+	for k := range desired {
+		if _, ok := observed[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func needsUpdate(desired apisv1alpha1.SubaccountSettingsParameters, observed map[string]string) bool {
+	for k, v := range desired.Settings {
+		if actual, ok := observed[k]; !ok || actual != v {
+			return true
+		}
+	}
+	return len(unmanagedKeys(desired, observed)) > 0
+}
+
+// unmanagedKeys returns the sorted keys of the observed settings that are not in the spec, if they are to be removed.
+func unmanagedKeys(desired apisv1alpha1.SubaccountSettingsParameters, observed map[string]string) []string {
+	if !desired.RemoveUnmanagedSettings {
+		return nil
+	}
+	var keys []string
+	for _, k := range slices.Sorted(maps.Keys(observed)) {
+		if _, ok := desired.Settings[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
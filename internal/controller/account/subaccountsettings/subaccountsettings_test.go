@@ -0,0 +1,524 @@
+package subaccountsettings
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/testutils"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
+)
+
+const SAMPLE_GUID = "12340000-0000-0000-0000-000000000000"
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		cr       resource.Managed
+		accessor *MockSettingsApiAccessor
+	}
+	type want struct {
+		o         managed.ExternalObservation
+		err       error
+		crChanges func(cr *v1alpha1.SubaccountSettings)
+	}
+	tests := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NilResource": {
+			reason: "Expect error if used with another resource type",
+			args: args{
+				cr:       nil,
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				err: errors.New(errNotSubaccountSettings),
+			},
+		},
+		"EmptyExternalName": {
+			reason: "Empty external name indicates the settings need to be created",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"InvalidExternalName": {
+			reason: "External name that is not a GUID should return an error",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName("unittest-sa")),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				err: errors.New(errInvalidExternalName),
+			},
+		},
+		"SubaccountMismatch": {
+			reason: "An external-name that differs from the resolved subaccountGuid should return an error instead of managing another subaccount",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSubaccount("11111111-2222-3333-4444-555555555555")),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				err: errors.Errorf(errSubaccountMismatch, SAMPLE_GUID, "11111111-2222-3333-4444-555555555555"),
+			},
+		},
+		"GetSettingsError": {
+			reason: "Errors of the API should be returned",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID)),
+				accessor: &MockSettingsApiAccessor{returnErr: errors.New("apiError")},
+			},
+			want: want{
+				err: errors.New(errObserve),
+			},
+		},
+		"SubaccountNotFound": {
+			reason: "Settings of a subaccount that doesn't exist need to be created",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{returnNotFound: true},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"SettingsNotSet": {
+			reason: "Settings need to be created if none of the settings of the spec is set",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{returnSettings: map[string]string{"owner": "jane"}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+				crChanges: func(cr *v1alpha1.SubaccountSettings) {
+					cr.Status.AtProvider.Settings = map[string]string{"owner": "jane"}
+				},
+			},
+		},
+		"UpToDate": {
+			reason: "Unmanaged settings should be ignored unless they are to be removed",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{returnSettings: map[string]string{"costCenter": "4711", "owner": "jane"}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				crChanges: func(cr *v1alpha1.SubaccountSettings) {
+					cr.Status.AtProvider.Settings = map[string]string{"costCenter": "4711", "owner": "jane"}
+					cr.SetConditions(xpv1.Available())
+				},
+			},
+		},
+		"NeedsUpdateValue": {
+			reason: "Changed value of a setting should require Update",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4712"})),
+				accessor: &MockSettingsApiAccessor{returnSettings: map[string]string{"costCenter": "4711"}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				crChanges: func(cr *v1alpha1.SubaccountSettings) {
+					cr.Status.AtProvider.Settings = map[string]string{"costCenter": "4711"}
+					cr.SetConditions(xpv1.Available())
+				},
+			},
+		},
+		"NeedsUpdateMissingSetting": {
+			reason: "Setting of the spec missing in the subaccount should require Update",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711", "owner": "jane"})),
+				accessor: &MockSettingsApiAccessor{returnSettings: map[string]string{"costCenter": "4711"}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				crChanges: func(cr *v1alpha1.SubaccountSettings) {
+					cr.Status.AtProvider.Settings = map[string]string{"costCenter": "4711"}
+					cr.SetConditions(xpv1.Available())
+				},
+			},
+		},
+		"NeedsUpdateUnmanagedSetting": {
+			reason: "Unmanaged settings should require Update if they are to be removed",
+			args: args{
+				cr: NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"}),
+					WithRemoveUnmanagedSettings()),
+				accessor: &MockSettingsApiAccessor{returnSettings: map[string]string{"costCenter": "4711", "owner": "jane"}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				crChanges: func(cr *v1alpha1.SubaccountSettings) {
+					cr.Status.AtProvider.Settings = map[string]string{"costCenter": "4711", "owner": "jane"}
+					cr.SetConditions(xpv1.Available())
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var before resource.Managed
+			if tc.args.cr != nil {
+				before = tc.args.cr.DeepCopyObject().(resource.Managed)
+			}
+			e := external{settingsAccessor: tc.args.accessor, tracker: trackingtest.NoOpReferenceResolverTracker{}}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if contained := testutils.ContainsError(err, tc.want.err); !contained {
+				t.Errorf("\ne.Observe(...): error \"%v\" not part of \"%v\"", err, tc.want.err)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if tc.args.cr != nil {
+				if tc.want.crChanges != nil {
+					tc.want.crChanges(before.(*v1alpha1.SubaccountSettings))
+				}
+				if diff := cmp.Diff(before, tc.args.cr, test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want cr, +got cr:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		cr       resource.Managed
+		accessor *MockSettingsApiAccessor
+	}
+	type want struct {
+		err          error
+		externalName string
+		payload      *accountclient.EntitySettingsRequestPayload
+	}
+	tests := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NilResource": {
+			reason: "Expect error if used with another resource type",
+			args: args{
+				cr:       nil,
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				err: errors.New(errNotSubaccountSettings),
+			},
+		},
+		"NoSubaccount": {
+			reason: "Settings can't be created without a subaccount",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				err: errors.New(errNoSubaccount),
+			},
+		},
+		"APIError": {
+			reason: "Errors of the API should be returned and the external name should not be set",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithSubaccount(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{returnErr: errors.New("apiError")},
+			},
+			want: want{
+				err:     errors.New("apiError"),
+				payload: internalPayload(setting{Key: "costCenter", Value: "4711"}),
+			},
+		},
+		"Success": {
+			reason: "The settings of the spec should be set and the subaccount should become the external name",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithSubaccount(SAMPLE_GUID), WithSettings(map[string]string{"owner": "jane", "costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				externalName: SAMPLE_GUID,
+				payload:      internalPayload(setting{Key: "costCenter", Value: "4711"}, setting{Key: "owner", Value: "jane"}),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := external{settingsAccessor: tc.args.accessor}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if contained := testutils.ContainsError(err, tc.want.err); !contained {
+				t.Errorf("\ne.Create(...): error \"%v\" not part of \"%v\"", err, tc.want.err)
+			}
+			if diff := cmp.Diff(tc.want.payload, tc.args.accessor.LastPayload); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want payload, +got payload:\n%s\n", tc.reason, diff)
+			}
+			if tc.args.cr != nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.cr)); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		cr       resource.Managed
+		accessor *MockSettingsApiAccessor
+	}
+	type want struct {
+		err         error
+		payload     *accountclient.EntitySettingsRequestPayload
+		deletedKeys []string
+	}
+	tests := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NilResource": {
+			reason: "Expect error if used with another resource type",
+			args: args{
+				cr:       nil,
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				err: errors.New(errNotSubaccountSettings),
+			},
+		},
+		"KeepUnmanaged": {
+			reason: "Unmanaged settings should be kept by default",
+			args: args{
+				cr: NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4712"}),
+					WithObservedSettings(map[string]string{"costCenter": "4711", "owner": "jane"})),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				payload: internalPayload(setting{Key: "costCenter", Value: "4712"}),
+			},
+		},
+		"RemoveUnmanaged": {
+			reason: "Unmanaged settings should be deleted if they are to be removed",
+			args: args{
+				cr: NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"}),
+					WithRemoveUnmanagedSettings(),
+					WithObservedSettings(map[string]string{"costCenter": "4711", "owner": "jane", "team": "a"})),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				payload:     internalPayload(setting{Key: "costCenter", Value: "4711"}),
+				deletedKeys: []string{"owner", "team"},
+			},
+		},
+		"APIError": {
+			reason: "Errors of the API should be returned",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{returnErr: errors.New("apiError")},
+			},
+			want: want{
+				err:     errors.New("apiError"),
+				payload: internalPayload(setting{Key: "costCenter", Value: "4711"}),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := external{settingsAccessor: tc.args.accessor}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if contained := testutils.ContainsError(err, tc.want.err); !contained {
+				t.Errorf("\ne.Update(...): error \"%v\" not part of \"%v\"", err, tc.want.err)
+			}
+			if diff := cmp.Diff(tc.want.payload, tc.args.accessor.LastPayload); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want payload, +got payload:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deletedKeys, tc.args.accessor.LastDeletedKeys); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted keys, +got deleted keys:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		cr       resource.Managed
+		accessor *MockSettingsApiAccessor
+	}
+	type want struct {
+		err         error
+		deletedKeys []string
+	}
+	tests := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NilResource": {
+			reason: "Expect error if used with another resource type",
+			args: args{
+				cr:       nil,
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				err: errors.New(errNotSubaccountSettings),
+			},
+		},
+		"DeleteSpecSettings": {
+			reason: "Only the settings of the spec should be deleted",
+			args: args{
+				cr: NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"owner": "jane", "costCenter": "4711"}),
+					WithRemoveUnmanagedSettings(),
+					WithObservedSettings(map[string]string{"costCenter": "4711", "owner": "jane", "team": "a"})),
+				accessor: &MockSettingsApiAccessor{},
+			},
+			want: want{
+				deletedKeys: []string{"costCenter", "owner"},
+			},
+		},
+		"SubaccountNotFound": {
+			reason: "Settings of a subaccount that doesn't exist are deleted already",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{returnNotFound: true},
+			},
+			want: want{
+				deletedKeys: []string{"costCenter"},
+			},
+		},
+		"APIError": {
+			reason: "Errors of the API should be returned",
+			args: args{
+				cr:       NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+				accessor: &MockSettingsApiAccessor{returnErr: errors.New("apiError")},
+			},
+			want: want{
+				err:         errors.New("apiError"),
+				deletedKeys: []string{"costCenter"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := external{settingsAccessor: tc.args.accessor}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+			if contained := testutils.ContainsError(err, tc.want.err); !contained {
+				t.Errorf("\ne.Delete(...): error \"%v\" not part of \"%v\"", err, tc.want.err)
+			}
+			if diff := cmp.Diff(tc.want.deletedKeys, tc.args.accessor.LastDeletedKeys); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want deleted keys, +got deleted keys:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	type want struct {
+		plan dryrun.Plan
+		err  error
+	}
+
+	tests := map[string]struct {
+		reason string
+		cr     *v1alpha1.SubaccountSettings
+		op     providerv1alpha1.DryRunOperation
+		want   want
+	}{
+		"Create": {
+			reason: "The settings of the spec should be planned",
+			cr:     NewSubaccountSettings("unittest-settings", WithSubaccount(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4711"})),
+			op:     providerv1alpha1.DryRunCreate,
+			want: want{
+				plan: dryrun.Plan{Desired: *internalPayload(setting{Key: "costCenter", Value: "4711"})},
+			},
+		},
+		"Update": {
+			reason: "The settings of the spec and the unmanaged settings to remove should be planned against the observed settings",
+			cr: NewSubaccountSettings("unittest-settings", WithExternalName(SAMPLE_GUID), WithSettings(map[string]string{"costCenter": "4712"}),
+				WithRemoveUnmanagedSettings(),
+				WithObservedSettings(map[string]string{"costCenter": "4711", "owner": "jane"})),
+			op: providerv1alpha1.DryRunUpdate,
+			want: want{
+				plan: dryrun.Plan{
+					Desired: SettingsUpdate{
+						Settings: *internalPayload(setting{Key: "costCenter", Value: "4712"}),
+						Delete:   []string{"owner"},
+					},
+					Observed: SettingsUpdate{
+						Settings: *internalPayload(setting{Key: "costCenter", Value: "4711"}, setting{Key: "owner", Value: "jane"}),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := external{}
+			got, err := e.Plan(context.Background(), tc.cr, tc.op)
+			if contained := testutils.ContainsError(err, tc.want.err); !contained {
+				t.Errorf("\ne.Plan(...): error \"%v\" not part of \"%v\"", err, tc.want.err)
+			}
+			if diff := cmp.Diff(tc.want.plan, got); diff != "" {
+				t.Errorf("\n%s\ne.Plan(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func internalPayload(settings ...setting) *accountclient.EntitySettingsRequestPayload {
+	return &accountclient.EntitySettingsRequestPayload{
+		AdditionalProperties: map[string]interface{}{"entitySettings": settings},
+	}
+}
+
+func NewSubaccountSettings(name string, m ...SubaccountSettingsModifier) *v1alpha1.SubaccountSettings {
+	cr := &v1alpha1.SubaccountSettings{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type SubaccountSettingsModifier func(cr *v1alpha1.SubaccountSettings)
+
+func WithSettings(settings map[string]string) SubaccountSettingsModifier {
+	return func(cr *v1alpha1.SubaccountSettings) {
+		cr.Spec.ForProvider.Settings = settings
+	}
+}
+
+func WithRemoveUnmanagedSettings() SubaccountSettingsModifier {
+	return func(cr *v1alpha1.SubaccountSettings) {
+		cr.Spec.ForProvider.RemoveUnmanagedSettings = true
+	}
+}
+
+func WithSubaccount(guid string) SubaccountSettingsModifier {
+	return func(cr *v1alpha1.SubaccountSettings) {
+		cr.Spec.ForProvider.SubaccountGuid = guid
+	}
+}
+
+func WithObservedSettings(settings map[string]string) SubaccountSettingsModifier {
+	return func(cr *v1alpha1.SubaccountSettings) {
+		cr.Status.AtProvider.Settings = settings
+	}
+}
+
+func WithExternalName(externalName string) SubaccountSettingsModifier {
+	return func(cr *v1alpha1.SubaccountSettings) {
+		meta.SetExternalName(cr, externalName)
+	}
+}
@@ -5,32 +5,39 @@ import (
 
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal/clients/subscription"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 )
 
 type MockApiHandler struct {
 	deleteCounter      int
 	returnExternalName string
+	returnJobID        string
+	returnJobStatus    jobs.Status
 	returnGet          *subscription.SubscriptionGet
 	returnErr          error
 }
 
-func (m *MockApiHandler) CreateSubscription(ctx context.Context, payload subscription.SubscriptionPost) (string, error) {
-	return m.returnExternalName, m.returnErr
+func (m *MockApiHandler) CreateSubscription(ctx context.Context, payload subscription.SubscriptionPost) (string, string, error) {
+	return m.returnExternalName, m.returnJobID, m.returnErr
 }
 
-func (m *MockApiHandler) UpdateSubscription(ctx context.Context, externalName string, payload subscription.SubscriptionPut) error {
-	return m.returnErr
+func (m *MockApiHandler) UpdateSubscription(ctx context.Context, externalName string, payload subscription.SubscriptionPut) (string, error) {
+	return m.returnJobID, m.returnErr
 }
 
-func (m *MockApiHandler) DeleteSubscription(ctx context.Context, externalName string) error {
+func (m *MockApiHandler) DeleteSubscription(ctx context.Context, externalName string) (string, error) {
 	m.deleteCounter += 1
-	return m.returnErr
+	return m.returnJobID, m.returnErr
 }
 
 func (m *MockApiHandler) GetSubscription(ctx context.Context, externalName string) (*subscription.SubscriptionGet, error) {
 	return m.returnGet, m.returnErr
 }
 
+func (m *MockApiHandler) JobStatus(ctx context.Context, jobID string) (jobs.Status, error) {
+	return m.returnJobStatus, m.returnErr
+}
+
 var _ subscription.SubscriptionApiHandlerI = &MockApiHandler{}
 
 type MockTypeMapper struct {
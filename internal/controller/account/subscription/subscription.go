@@ -17,6 +17,8 @@ import (
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/subscription"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,6 +38,7 @@ const (
 	errCreate               = "while creating subscription"
 	errUpdate               = "while updating subscription"
 	errDelete               = "while deleting subscription"
+	errPlanUpdate           = "updates of subscriptions are not supported"
 )
 
 var failureStates = []string{
@@ -160,6 +163,11 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// We observed a subscription in SUBSCRIBE_FAILED
 		// state and recreateOnSubscriptionFailure is turned
 		// on.
+		if dryrun.Enabled(cr) {
+			// Show the subscription that would be created once the
+			// failed one is deleted, without deleting it.
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
 		var err error
 		if !subscriptionBeingDeleted(cr) {
 			// The resource is not being deleted. So let's
@@ -194,13 +202,14 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.SetConditions(xpv1.Creating())
-	externalName, clientErr := c.apiHandler.CreateSubscription(ctx, c.typeMapper.ConvertToCreatePayload(cr))
+	externalName, jobID, clientErr := c.apiHandler.CreateSubscription(ctx, c.typeMapper.ConvertToCreatePayload(cr))
 	if clientErr != nil {
 		return managed.ExternalCreation{}, errors.Wrap(clientErr, errCreate)
 	}
 
 	// set external ID as name to allow proper importing
 	meta.SetExternalName(cr, externalName)
+	jobs.Start(cr, providerv1alpha1.JobCreate, jobID)
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -214,10 +223,11 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotSubscription)
 	}
 
-	err := c.apiHandler.UpdateSubscription(ctx, meta.GetExternalName(cr), c.typeMapper.ConvertToUpdatePayload(cr))
+	jobID, err := c.apiHandler.UpdateSubscription(ctx, meta.GetExternalName(cr), c.typeMapper.ConvertToUpdatePayload(cr))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
+	jobs.Start(cr, providerv1alpha1.JobUpdate, jobID)
 
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
@@ -226,6 +236,19 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}, nil
 }
 
+// Plan returns the request Create would send to BTP, for the dry-run of the subscription.
+// Subscriptions are never updated, see SubscriptionTypeMapper.IsUpToDate.
+func (c *external) Plan(ctx context.Context, mg resource.Managed, op providerv1alpha1.DryRunOperation) (dryrun.Plan, error) {
+	cr, ok := mg.(*v1alpha1.Subscription)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errNotSubscription)
+	}
+	if op != providerv1alpha1.DryRunCreate {
+		return dryrun.Plan{}, errors.New(errPlanUpdate)
+	}
+	return dryrun.Plan{Desired: c.typeMapper.ConvertToCreatePayload(cr)}, nil
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*v1alpha1.Subscription)
 	if !ok {
@@ -238,7 +261,17 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		// api will return 500 if called multiple times, so we will ensure to call it only once
 		return managed.ExternalDelete{}, nil
 	}
-	return managed.ExternalDelete{}, errors.Wrap(c.apiHandler.DeleteSubscription(ctx, meta.GetExternalName(cr)), errDelete)
+	jobID, err := c.apiHandler.DeleteSubscription(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDelete)
+	}
+	jobs.Start(cr, providerv1alpha1.JobDelete, jobID)
+	return managed.ExternalDelete{}, nil
+}
+
+// JobStatus returns the status of a job of the SaaS provisioning service.
+func (c *external) JobStatus(ctx context.Context, id string) (jobs.Status, error) {
+	return c.apiHandler.JobStatus(ctx, id)
 }
 
 // loadSubscription gets a Subscription using the APIHandler if a proper externalName has been set, otherwise returns nil
@@ -29,6 +29,12 @@ func (c MockClient) CreateInstance(ctx context.Context, cr v1alpha1.KymaEnvironm
 func (c MockClient) UpdateInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) error {
 	return nil
 }
+func (c MockClient) CreatePayload(cr v1alpha1.KymaEnvironment) (provisioningclient.CreateEnvironmentInstanceRequestPayload, error) {
+	return provisioningclient.CreateEnvironmentInstanceRequestPayload{PlanName: cr.Spec.ForProvider.PlanName}, nil
+}
+func (c MockClient) UpdatePayload(cr v1alpha1.KymaEnvironment) (provisioningclient.UpdateEnvironmentInstanceRequestPayload, error) {
+	return provisioningclient.UpdateEnvironmentInstanceRequestPayload{PlanName: cr.Spec.ForProvider.PlanName}, nil
+}
 func (c MockClient) DeleteInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) (*http.Response, error) {
 	if c.MockDeleteCluster != nil {
 		return c.MockDeleteCluster(ctx, &cr)
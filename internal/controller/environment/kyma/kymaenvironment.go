@@ -30,6 +30,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/btp"
 	kymaenv "github.com/sap/crossplane-provider-btp/internal/clients/kymaenvironment"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 )
 
@@ -151,6 +152,10 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	cr.Status.AtProvider = kymaenv.GenerateObservation(instance)
 
 	if c.shouldRecreateOnFailure(cr, cr.Status.AtProvider.State) {
+		if dryrun.Enabled(cr) {
+			// Show the environment that would be created once the failed one is deleted, without deleting it.
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
 		var err error
 		if !environmentBeingDeleted(cr) {
 			_, err = c.Delete(ctx, mg)
@@ -267,6 +272,35 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}, nil
 }
 
+// Plan returns the request Create or Update would send to BTP, for the dry-run of the environment.
+func (c *external) Plan(ctx context.Context, mg resource.Managed, op providerv1alpha1.DryRunOperation) (dryrun.Plan, error) {
+	cr, ok := mg.(*v1alpha1.KymaEnvironment)
+	if !ok {
+		return dryrun.Plan{}, errors.New(errNotKymaEnvironment)
+	}
+
+	if op == providerv1alpha1.DryRunCreate {
+		payload, err := c.client.CreatePayload(*cr)
+		if err != nil {
+			return dryrun.Plan{}, errors.Wrap(err, errParameterParsing)
+		}
+		return dryrun.Plan{Desired: payload}, nil
+	}
+
+	payload, err := c.client.UpdatePayload(*cr)
+	if err != nil {
+		return dryrun.Plan{}, errors.Wrap(err, errParameterParsing)
+	}
+	current, err := internal.UnmarshalRawParameters([]byte(ptr.Deref(cr.Status.AtProvider.Parameters, "{}")))
+	if err != nil {
+		return dryrun.Plan{}, errors.Wrap(err, errServiceParsing)
+	}
+	return dryrun.Plan{
+		Desired:  payload,
+		Observed: btp.NewKymaEnvironmentUpdatePayload(ptr.Deref(cr.Status.AtProvider.PlanName, ""), current),
+	}, nil
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*v1alpha1.KymaEnvironment)
 	if !ok {
@@ -320,7 +354,10 @@ func (c *external) needsUpdateWithDiff(cr *v1alpha1.KymaEnvironment) (bool, stri
 
 	diff := cmp.Diff(desired, current)
 
-	updateCircuitBreakerStatus(cr, desired, current, diff, maxRetries)
+	// a dry-run never updates, so it must not count as a failed retry
+	if !dryrun.Enabled(cr) {
+		updateCircuitBreakerStatus(cr, desired, current, diff, maxRetries)
+	}
 
 	return diff != "", diff, nil
 
@@ -14,6 +14,7 @@ type MockKymaModuleClient struct {
 	MockObserve func(moduleName string) (*v1alpha1.ModuleStatus, error)
 	MockCreate  func(moduleName string, moduleChannel string, customResourcePolicy string) error
 	MockDelete  func(moduleName string) error
+	MockList    func() ([]kymamodule.Module, error)
 }
 
 // ObserveModule implements KymaModuleClient.ObserveModule
@@ -31,6 +32,11 @@ func (m *MockKymaModuleClient) DeleteModule(ctx context.Context, moduleName stri
 	return m.MockDelete(moduleName)
 }
 
+// ListModules implements KymaModuleClient.ListModules
+func (m *MockKymaModuleClient) ListModules(ctx context.Context) ([]kymamodule.Module, error) {
+	return m.MockList()
+}
+
 type MockSecretFetcher struct {
 	MockFetch func(ctx context.Context, cr *v1alpha1.KymaModule) ([]byte, error)
 }
@@ -51,6 +51,10 @@ type UpjetOptions struct {
 
 	BackoffBase time.Duration
 	BackoffMax  time.Duration
+
+	// ProviderConfigCheckInterval is the interval at which the credentials of ProviderConfigs are checked.
+	// They are only checked when the ProviderConfig changes if it is 0.
+	ProviderConfigCheckInterval time.Duration
 }
 
 // ForControllerRuntime returns default controller-runtime options. Its basically just an alias.
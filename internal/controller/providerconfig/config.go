@@ -11,10 +11,12 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
 	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
@@ -35,7 +37,7 @@ const (
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage, and a controller that checks their credentials.
 func Setup(mgr ctrl.Manager, o internalopts.UpjetOptions) error {
 	name := providerconfig.ControllerName(v1alpha1.ProviderConfigGroupKind)
 
@@ -51,12 +53,49 @@ func Setup(mgr ctrl.Manager, o internalopts.UpjetOptions) error {
 		providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))), //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntimeWithBackoff()).
 		For(&v1alpha1.ProviderConfig{}).
 		Watches(&v1alpha1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
 		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)); err != nil {
+		return err
+	}
+
+	if err := setupHealthCheck(mgr, o); err != nil {
+		return err
+	}
+
+	if err := setupNamespacedConfig(mgr, o, &namespacedv1alpha1.ProviderConfig{}, namespacedv1alpha1.ProviderConfigGroupVersionKind); err != nil {
+		return err
+	}
+	return setupNamespacedConfig(mgr, o, &namespacedv1alpha1.ClusterProviderConfig{}, namespacedv1alpha1.ClusterProviderConfigGroupVersionKind)
+}
+
+// setupNamespacedConfig adds a controller that reconciles the ProviderConfigs or ClusterProviderConfigs
+// of namespaced managed resources by accounting for their current usage.
+func setupNamespacedConfig(mgr ctrl.Manager, o internalopts.UpjetOptions, config client.Object, gvk schema.GroupVersionKind) error {
+	name := providerconfig.ControllerName(gvk.GroupKind().String())
+
+	of := resource.ProviderConfigKinds{
+		Config:    gvk,
+		Usage:     namespacedv1alpha1.ProviderConfigUsageGroupVersionKind,
+		UsageList: namespacedv1alpha1.ProviderConfigUsageListGroupVersionKind,
+	}
+
+	r := providerconfig.NewReconciler(
+		mgr, of,
+		providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
+		providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))), //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntimeWithBackoff()).
+		For(config).
+		Watches(&namespacedv1alpha1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{Kind: gvk.Kind}).
+		WithEventFilter(resource.DesiredStateChanged()).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
@@ -101,7 +140,7 @@ func CreateClient(
 		return nil, errors.Wrap(err, errTrackRUsage)
 	}
 
-	CISSecretData, cisErr := loadCisCredentials(ctx, kube, pc)
+	CISSecretData, cisErr := loadCisBinding(ctx, kube, pc)
 	if cisErr != nil {
 		return nil, cisErr
 	}
@@ -115,7 +154,12 @@ func CreateClient(
 	return svc, errors.Wrap(err, errNewClient)
 }
 
+// ResolveProviderConfig returns the ProviderConfig referenced by the managed resource. While a namespaced
+// managed resource is reconciled, the ProviderConfig or ClusterProviderConfig referenced by it is returned.
 func ResolveProviderConfig(ctx context.Context, mg LegacyManaged, kube client.Client) (*v1alpha1.ProviderConfig, error) {
+	if nmg, ok := namespacedResourceFrom(ctx); ok {
+		return resolveNamespacedProviderConfig(ctx, kube, nmg, "")
+	}
 	pc := &v1alpha1.ProviderConfig{}
 	ref := mg.GetProviderConfigReference()
 	if ref == nil {
@@ -125,6 +169,23 @@ func ResolveProviderConfig(ctx context.Context, mg LegacyManaged, kube client.Cl
 	return pc, err
 }
 
+// loadCisBinding loads the CIS credentials of the ProviderConfig and, if they are exchanged
+// with an OIDC token, adds the token as JWT bearer assertion.
+func loadCisBinding(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) ([]byte, error) {
+	binding, err := loadCisCredentials(ctx, kube, pc)
+	if err != nil {
+		return nil, err
+	}
+	if pc.Spec.CISSecret.Source != v1alpha1.CredentialsSourceOIDCTokenExchange {
+		return binding, nil
+	}
+	assertion, err := loadOIDCToken(ctx, kube, pc.Spec.CISSecret.OIDCTokenExchange)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCISCreds)
+	}
+	return withJWTBearerAssertion(binding, assertion)
+}
+
 // Resolves CIS credential secret to unified json string format
 // Supports two formats:
 //   - our own format:
@@ -7,8 +7,13 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
 	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
 	"github.com/sap/crossplane-provider-btp/internal/features"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
+	"github.com/sap/crossplane-provider-btp/internal/throttling"
+	"github.com/sap/crossplane-provider-btp/internal/tracing"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,7 +39,7 @@ type ConnectorFn func(
 	resourcetracker tracking.ReferenceResolverTracker,
 ) managed.ExternalConnector
 
-// DefaultSetup supports the creation of a controller for a given managed resource type. Accepts any type that implements the ConnectorFn signature.
+// DefaultSetup supports the creation of a controller for a given managed resource type, and for its namespaced variant if there is one. Accepts any type that implements the ConnectorFn signature.
 // DEPRECATED: use DefaultSetupWithoutDefaultInitializer instead to not have the external-name default initializer added automatically (new external-name handling requires external-name to be empty not defaulted on create).
 func DefaultSetup(mgr ctrl.Manager, o internalopts.CrossplaneOptions, object client.Object, kind string, gvk schema.GroupVersionKind, connectorFn ConnectorFn) error {
 	name := managed.ControllerName(kind)
@@ -47,23 +52,30 @@ func DefaultSetup(mgr ctrl.Manager, o internalopts.CrossplaneOptions, object cli
 			mgr.GetClient(),
 			&providerv1alpha1.ProviderConfigUsage{},
 		)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name)) //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
 
 	r := managed.NewReconciler(
 		mgr,
 		resource.ManagedKind(gvk),
-		managed.WithExternalConnector(connectorFn(mgr.GetClient(), usageTracker, referenceTracker)),
+		managed.WithExternalConnector(tracing.NewConnector(gvk, throttling.NewConnector(audit.NewConnector(gvk, dryrun.NewConnector(jobs.NewConnector(connectorFn(mgr.GetClient(), usageTracker, referenceTracker), mgr.GetClient(), recorder)))))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))), //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
+		managed.WithRecorder(recorder),
 		managed.WithPollInterval(o.PollInterval),
 		enableBetaManagementPolicies(o.Features.Enabled(features.EnableBetaManagementPolicies)),
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntimeWithBackoff()).
 		For(object).
 		WithEventFilter(resource.DesiredStateChanged()).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(gvk, throttling.NewReconciler(mgr.GetClient(), gvk, r)), o.GlobalRateLimiter)); err != nil {
+		return err
+	}
+
+	return setupNamespaced(mgr, o, gvk, connectorFn,
+		enableBetaManagementPolicies(o.Features.Enabled(features.EnableBetaManagementPolicies)),
+	)
 }
 
 // DefaultSetupWithoutDefaultInitializer works like DefaultSetup but without DefaultInitializer (to adhere to new external-name handling). It supports the creation of a controller for a given managed resource type. Accepts any type that implements the ConnectorFn signature.
@@ -78,24 +90,32 @@ func DefaultSetupWithoutDefaultInitializer(mgr ctrl.Manager, o internalopts.Cros
 			mgr.GetClient(),
 			&providerv1alpha1.ProviderConfigUsage{},
 		)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name)) //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
 
 	r := managed.NewReconciler(
 		mgr,
 		resource.ManagedKind(gvk),
-		managed.WithExternalConnector(connectorFn(mgr.GetClient(), usageTracker, referenceTracker)),
+		managed.WithExternalConnector(tracing.NewConnector(gvk, throttling.NewConnector(audit.NewConnector(gvk, dryrun.NewConnector(jobs.NewConnector(connectorFn(mgr.GetClient(), usageTracker, referenceTracker), mgr.GetClient(), recorder)))))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))), //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
+		managed.WithRecorder(recorder),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithInitializers(), // No default initializer
 		enableBetaManagementPolicies(o.Features.Enabled(features.EnableBetaManagementPolicies)),
 	)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntimeWithBackoff()).
 		For(object).
 		WithEventFilter(resource.DesiredStateChanged()).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(gvk, throttling.NewReconciler(mgr.GetClient(), gvk, r)), o.GlobalRateLimiter)); err != nil {
+		return err
+	}
+
+	return setupNamespaced(mgr, o, gvk, connectorFn,
+		managed.WithInitializers(), // No default initializer
+		enableBetaManagementPolicies(o.Features.Enabled(features.EnableBetaManagementPolicies)),
+	)
 }
 
 func enableBetaManagementPolicies(enable bool) managed.ReconcilerOption {
@@ -0,0 +1,321 @@
+package providerconfig
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/tracing"
+)
+
+const (
+	errUpdatePCStatus         = "cannot update status of ProviderConfig"
+	errGlobalAccountMismatch  = "CIS credentials belong to global account %q, but spec.globalAccount is %q"
+	errCannotGetGlobalAccount = "cannot get global account"
+	errCannotGetToken         = "cannot get access token"
+
+	reasonCredentialsRotated event.Reason = "CredentialsRotated"
+)
+
+// regionPattern matches the host label of the region in BTP service URLs, e.g. eu10 or us10-001.
+var regionPattern = regexp.MustCompile(`^[a-z]{2}\d{2,3}(-\d{3})?$`)
+
+// checkedConfig is a ProviderConfig of any kind whose credentials are checked.
+type checkedConfig interface {
+	client.Object
+	resource.Conditioned
+}
+
+// checkedKind is a kind of ProviderConfig whose credentials are checked.
+type checkedKind struct {
+	gvk         schema.GroupVersionKind
+	newObject   func() checkedConfig
+	referencing func(kube client.Client) handler.MapFunc
+}
+
+// checkedKinds are the ProviderConfig kinds of cluster scoped and namespaced managed resources.
+var checkedKinds = []checkedKind{
+	{gvk: v1alpha1.ProviderConfigGroupVersionKind, newObject: func() checkedConfig { return &v1alpha1.ProviderConfig{} }, referencing: referencingProviderConfigs},
+	{gvk: namespacedv1alpha1.ProviderConfigGroupVersionKind, newObject: func() checkedConfig { return &namespacedv1alpha1.ProviderConfig{} }, referencing: referencingNamespacedProviderConfigs},
+	{gvk: namespacedv1alpha1.ClusterProviderConfigGroupVersionKind, newObject: func() checkedConfig { return &namespacedv1alpha1.ClusterProviderConfig{} }, referencing: referencingClusterProviderConfigs},
+}
+
+// setupHealthCheck adds a controller per ProviderConfig kind that checks the credentials of the ProviderConfigs
+// whenever their spec or their secrets change and every ProviderConfigCheckInterval, and reports the results
+// in their status.
+func setupHealthCheck(mgr ctrl.Manager, o internalopts.UpjetOptions) error {
+	for _, kind := range checkedKinds {
+		if err := setupHealthCheckFor(mgr, o, kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setupHealthCheckFor(mgr ctrl.Manager, o internalopts.UpjetOptions, kind checkedKind) error {
+	name := "providerconfig-check/" + strings.ToLower(kind.gvk.GroupKind().String())
+
+	r := &healthReconciler{
+		kube:        mgr.GetClient(),
+		log:         o.Logger.WithValues("controller", name),
+		record:      event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
+		interval:    o.ProviderConfigCheckInterval,
+		newObject:   kind.newObject,
+		newClientFn: btp.ServiceClientFromSecret,
+		credentials: map[string]string{},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntimeWithBackoff()).
+		For(kind.newObject(), builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(kind.referencing(mgr.GetClient()))).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(kind.gvk, r), o.GlobalRateLimiter))
+}
+
+// healthReconciler validates the credentials of a ProviderConfig by parsing its secrets, fetching an access token
+// and reading the global account, so that misconfigurations show up on the ProviderConfig instead of only on
+// the managed resources that use it. When the secrets are rotated, it evicts the cached clients of the
+// previous credential, so that no stale tokens or responses are used.
+type healthReconciler struct {
+	kube     client.Client
+	log      logging.Logger
+	record   event.Recorder
+	interval time.Duration
+
+	newObject   func() checkedConfig
+	newClientFn func(cisSecretData []byte, serviceAccountSecretData []byte) (btp.Client, error)
+
+	// credentials holds the btp.Client CacheKey of the last credential seen per ProviderConfig.
+	mu          sync.Mutex
+	credentials map[string]string
+}
+
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	pc := r.newObject()
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		if kerrors.IsNotFound(err) {
+			r.forget(req.String())
+		}
+		return reconcile.Result{}, errors.Wrap(client.IgnoreNotFound(err), errGetPC)
+	}
+	if pc.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	setObservation(pc, r.check(ctx, pc))
+	r.log.Debug("Checked ProviderConfig", "name", req.String(), "verified", pc.GetCondition(v1alpha1.GlobalAccountVerifiedCondition).Status)
+	if err := r.kube.Status().Update(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdatePCStatus)
+	}
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+// check runs the checks of the ProviderConfig one after another, sets their conditions and returns what was observed.
+func (r *healthReconciler) check(ctx context.Context, cfg checkedConfig) v1alpha1.ProviderConfigObservation {
+	observation := v1alpha1.ProviderConfigObservation{}
+
+	pc, err := asProviderConfig(cfg)
+	var btpClient btp.Client
+	if err == nil {
+		btpClient, err = r.client(ctx, pc)
+	}
+	if err != nil {
+		cfg.SetConditions(
+			v1alpha1.CheckFailed(v1alpha1.CredentialsValidCondition, v1alpha1.InvalidCredentialsReason, err),
+			v1alpha1.CheckSkipped(v1alpha1.AuthenticatedCondition),
+			v1alpha1.CheckSkipped(v1alpha1.GlobalAccountVerifiedCondition),
+		)
+		return observation
+	}
+	cfg.SetConditions(v1alpha1.CheckSucceeded(v1alpha1.CredentialsValidCondition, v1alpha1.CredentialsValidReason, ""))
+	r.evictRotated(cfg, btpClient.CacheKey())
+	observation.Region = regionOf(btpClient.Credential.CISCredential.Endpoints.AccountsServiceUrl)
+
+	token, err := btpClient.Token(ctx)
+	if err != nil {
+		cfg.SetConditions(
+			v1alpha1.CheckFailed(v1alpha1.AuthenticatedCondition, v1alpha1.TokenRequestFailedReason, errors.Wrap(err, errCannotGetToken)),
+			v1alpha1.CheckSkipped(v1alpha1.GlobalAccountVerifiedCondition),
+		)
+		return observation
+	}
+	cfg.SetConditions(v1alpha1.CheckSucceeded(v1alpha1.AuthenticatedCondition, v1alpha1.TokenIssuedReason, ""))
+	if !token.Expiry.IsZero() {
+		observation.TokenExpiry = &metav1.Time{Time: token.Expiry}
+	}
+
+	globalAccount, _, err := btpClient.AccountsServiceClient.GlobalAccountOperationsAPI.GetGlobalAccount(ctx).Execute()
+	if err != nil {
+		cfg.SetConditions(v1alpha1.CheckFailed(v1alpha1.GlobalAccountVerifiedCondition, v1alpha1.CannotGetGlobalAccountReason, errors.Wrap(specifyAPIError(err), errCannotGetGlobalAccount)))
+		return observation
+	}
+	observation.GlobalAccountGUID = globalAccount.Guid
+	if subdomain := globalAccount.GetSubdomain(); pc.Spec.GlobalAccount != "" && subdomain != pc.Spec.GlobalAccount {
+		cfg.SetConditions(v1alpha1.CheckFailed(v1alpha1.GlobalAccountVerifiedCondition, v1alpha1.GlobalAccountMismatchReason, errors.Errorf(errGlobalAccountMismatch, subdomain, pc.Spec.GlobalAccount)))
+		return observation
+	}
+	cfg.SetConditions(v1alpha1.CheckSucceeded(v1alpha1.GlobalAccountVerifiedCondition, v1alpha1.GlobalAccountMatchedReason, ""))
+	return observation
+}
+
+// client loads and parses the secrets of the ProviderConfig like CreateClient.
+func (r *healthReconciler) client(ctx context.Context, pc *v1alpha1.ProviderConfig) (btp.Client, error) {
+	cisSecretData, err := loadCisBinding(ctx, r.kube, pc)
+	if err != nil {
+		return btp.Client{}, err
+	}
+	saSecretData, err := loadSaCredentials(ctx, r.kube, pc)
+	if err != nil {
+		return btp.Client{}, err
+	}
+	return r.newClientFn(cisSecretData, saSecretData)
+}
+
+// evictRotated evicts the cached clients of the credential the ProviderConfig used before, if it changed.
+func (r *healthReconciler) evictRotated(cfg checkedConfig, credential string) {
+	key := client.ObjectKeyFromObject(cfg).String()
+	r.mu.Lock()
+	previous, seen := r.credentials[key]
+	r.credentials[key] = credential
+	r.mu.Unlock()
+
+	if !seen || previous == credential {
+		return
+	}
+	btp.EvictClients(previous)
+	r.log.Debug("Evicted clients of rotated credentials", "name", key)
+	r.record.Event(cfg, event.Normal(reasonCredentialsRotated, "Secrets of the ProviderConfig changed, cached clients and tokens of the previous credentials were evicted"))
+}
+
+func (r *healthReconciler) forget(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.credentials, key)
+}
+
+// setObservation sets the observation of the check in the status of the ProviderConfig.
+func setObservation(cfg checkedConfig, observation v1alpha1.ProviderConfigObservation) {
+	switch pc := cfg.(type) {
+	case *v1alpha1.ProviderConfig:
+		pc.Status.AtProvider = observation
+	case *namespacedv1alpha1.ProviderConfig:
+		pc.Status.AtProvider = observation
+	case *namespacedv1alpha1.ClusterProviderConfig:
+		pc.Status.AtProvider = observation
+	}
+}
+
+// referencingProviderConfigs maps a Secret to the ProviderConfigs that reference it as CIS or service account secret.
+func referencingProviderConfigs(kube client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		pcs := &v1alpha1.ProviderConfigList{}
+		if err := kube.List(ctx, pcs); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, pc := range pcs.Items {
+			if references(pc.Spec.CISSecret, obj) || references(pc.Spec.ServiceAccountSecret, obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.GetName()}})
+			}
+		}
+		return requests
+	}
+}
+
+// referencingNamespacedProviderConfigs maps a Secret to the namespaced ProviderConfigs in its namespace that reference
+// it as CIS or service account secret. Namespaced ProviderConfigs read their secrets from their own namespace, whatever
+// namespace the reference names.
+func referencingNamespacedProviderConfigs(kube client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		pcs := &namespacedv1alpha1.ProviderConfigList{}
+		if err := kube.List(ctx, pcs, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, pc := range pcs.Items {
+			if referencesName(pc.Spec.CISSecret, obj) || referencesName(pc.Spec.ServiceAccountSecret, obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: pc.GetNamespace(), Name: pc.GetName()}})
+			}
+		}
+		return requests
+	}
+}
+
+// referencingClusterProviderConfigs maps a Secret to the ClusterProviderConfigs that reference it as CIS or service account secret.
+func referencingClusterProviderConfigs(kube client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		pcs := &namespacedv1alpha1.ClusterProviderConfigList{}
+		if err := kube.List(ctx, pcs); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, pc := range pcs.Items {
+			if references(pc.Spec.CISSecret, obj) || references(pc.Spec.ServiceAccountSecret, obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.GetName()}})
+			}
+		}
+		return requests
+	}
+}
+
+func references(credentials v1alpha1.ProviderCredentials, secret client.Object) bool {
+	ref := credentials.SecretRef
+	return referencesName(credentials, secret) && ref.Namespace == secret.GetNamespace()
+}
+
+func referencesName(credentials v1alpha1.ProviderCredentials, secret client.Object) bool {
+	ref := credentials.SecretRef
+	return ref != nil && ref.Name == secret.GetName()
+}
+
+func specifyAPIError(err error) error {
+	if genericErr, ok := err.(*accountclient.GenericOpenAPIError); ok {
+		if accountError, ok := genericErr.Model().(accountclient.ApiExceptionResponseObject); ok {
+			return errors.Errorf("API Error: %v, Code %v", internal.Val(accountError.Error.Message), internal.Val(accountError.Error.Code))
+		}
+		if genericErr.Body() != nil {
+			return errors.Errorf("API Error: %s", string(genericErr.Body()))
+		}
+	}
+	return err
+}
+
+// regionOf returns the region in the host of a BTP service URL, or an empty string if it has none.
+func regionOf(serviceURL string) string {
+	u, err := url.Parse(serviceURL)
+	if err != nil {
+		return ""
+	}
+	for _, label := range strings.Split(u.Hostname(), ".") {
+		if regionPattern.MatchString(label) {
+			return label
+		}
+	}
+	return ""
+}
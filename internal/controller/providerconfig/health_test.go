@@ -0,0 +1,338 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+// newHealthServer starts a fake BTP API that issues tokens for valid-client and returns the CIS binding of a client for it.
+func newHealthServer(t *testing.T) func(clientID string) []byte {
+	btp.SetLogger(logging.NewNopLogger())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/token":
+			clientID, _, ok := r.BasicAuth()
+			if !ok {
+				clientID = r.PostFormValue("client_id")
+			}
+			if clientID != "valid-client" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`))
+		case "/accounts/v1/globalAccount":
+			ga := accountclient.NewGlobalAccountResponseObject("", false, 0, "", "My Global Account", "", "ga-guid", "ga-guid", "", "", "")
+			ga.SetSubdomain("my-global-account")
+			_ = json.NewEncoder(w).Encode(ga)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return func(clientID string) []byte {
+		return []byte(`{"endpoints":{"accounts_service_url":"` + server.URL + `","entitlements_service_url":"` + server.URL + `","provisioning_service_url":"` + server.URL + `"},` +
+			`"grant_type":"client_credentials","uaa":{"clientid":"` + clientID + `","clientsecret":"secret","url":"` + server.URL + `"}}`)
+	}
+}
+
+func TestHealthReconcile(t *testing.T) {
+	binding := newHealthServer(t)
+
+	tests := map[string]struct {
+		cisSecret     []byte
+		globalAccount string
+		want          map[xpv1.ConditionType]xpv1.ConditionReason
+		wantGUID      string
+		wantToken     bool
+	}{
+		"Verified": {
+			cisSecret:     binding("valid-client"),
+			globalAccount: "my-global-account",
+			want: map[xpv1.ConditionType]xpv1.ConditionReason{
+				v1alpha1.CredentialsValidCondition:      v1alpha1.CredentialsValidReason,
+				v1alpha1.AuthenticatedCondition:         v1alpha1.TokenIssuedReason,
+				v1alpha1.GlobalAccountVerifiedCondition: v1alpha1.GlobalAccountMatchedReason,
+			},
+			wantGUID:  "ga-guid",
+			wantToken: true,
+		},
+		"GlobalAccountMismatch": {
+			cisSecret:     binding("valid-client"),
+			globalAccount: "other-global-account",
+			want: map[xpv1.ConditionType]xpv1.ConditionReason{
+				v1alpha1.CredentialsValidCondition:      v1alpha1.CredentialsValidReason,
+				v1alpha1.AuthenticatedCondition:         v1alpha1.TokenIssuedReason,
+				v1alpha1.GlobalAccountVerifiedCondition: v1alpha1.GlobalAccountMismatchReason,
+			},
+			wantGUID:  "ga-guid",
+			wantToken: true,
+		},
+		"TokenRequestFailed": {
+			cisSecret:     binding("invalid-client"),
+			globalAccount: "my-global-account",
+			want: map[xpv1.ConditionType]xpv1.ConditionReason{
+				v1alpha1.CredentialsValidCondition:      v1alpha1.CredentialsValidReason,
+				v1alpha1.AuthenticatedCondition:         v1alpha1.TokenRequestFailedReason,
+				v1alpha1.GlobalAccountVerifiedCondition: v1alpha1.CheckSkippedReason,
+			},
+		},
+		"InvalidCredentials": {
+			cisSecret:     []byte(`{"uaa":{"clientid":"valid-client"}}`),
+			globalAccount: "my-global-account",
+			want: map[xpv1.ConditionType]xpv1.ConditionReason{
+				v1alpha1.CredentialsValidCondition:      v1alpha1.InvalidCredentialsReason,
+				v1alpha1.AuthenticatedCondition:         v1alpha1.CheckSkippedReason,
+				v1alpha1.GlobalAccountVerifiedCondition: v1alpha1.CheckSkippedReason,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := fakeProviderConfig(&v1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+			pc.Spec.GlobalAccount = tc.globalAccount
+			secrets := []*corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "Namespace"}, Data: map[string][]byte{"data": tc.cisSecret}},
+				{ObjectMeta: metav1.ObjectMeta{Name: secretNameSM, Namespace: "Namespace"}, Data: smSecret},
+			}
+			scheme := newScheme(t)
+			require.NoError(t, corev1.AddToScheme(scheme))
+			kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pc, secrets[0], secrets[1]).WithStatusSubresource(pc).Build()
+
+			r := &healthReconciler{kube: kube, log: logging.NewNopLogger(), record: event.NewNopRecorder(), interval: time.Minute, newObject: checkedKinds[0].newObject, newClientFn: btp.ServiceClientFromSecret, credentials: map[string]string{}}
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.GetName()}}
+			result, err := r.Reconcile(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, reconcile.Result{RequeueAfter: time.Minute}, result)
+
+			got := &v1alpha1.ProviderConfig{}
+			require.NoError(t, kube.Get(context.Background(), req.NamespacedName, got))
+			for ct, reason := range tc.want {
+				assert.Equal(t, reason, got.GetCondition(ct).Reason, ct)
+			}
+			assert.Equal(t, tc.wantGUID, got.Status.AtProvider.GlobalAccountGUID)
+			assert.Equal(t, tc.wantToken, got.Status.AtProvider.TokenExpiry != nil)
+		})
+	}
+}
+
+func TestHealthReconcileNamespaced(t *testing.T) {
+	binding := newHealthServer(t)
+	spec := fakeProviderConfig(&v1alpha1.ProviderConfig{}).Spec
+	spec.GlobalAccount = "my-global-account"
+
+	tests := map[string]struct {
+		kind            checkedKind
+		pc              checkedConfig
+		req             types.NamespacedName
+		secretNamespace string
+	}{
+		"ProviderConfig": {
+			kind: checkedKinds[1],
+			// the secrets are read from the namespace of the ProviderConfig, not from Namespace
+			pc:              &namespacedv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team-a"}, Spec: spec},
+			req:             types.NamespacedName{Namespace: "team-a", Name: "default"},
+			secretNamespace: "team-a",
+		},
+		"ClusterProviderConfig": {
+			kind:            checkedKinds[2],
+			pc:              &namespacedv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Spec: spec},
+			req:             types.NamespacedName{Name: "default"},
+			secretNamespace: "Namespace",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cisSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: tc.secretNamespace}, Data: map[string][]byte{"data": binding("valid-client")}}
+			saSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameSM, Namespace: tc.secretNamespace}, Data: smSecret}
+			scheme := newScheme(t)
+			require.NoError(t, corev1.AddToScheme(scheme))
+			kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.pc, cisSecret, saSecret).WithStatusSubresource(tc.pc).Build()
+
+			r := &healthReconciler{kube: kube, log: logging.NewNopLogger(), record: event.NewNopRecorder(), interval: time.Minute, newObject: tc.kind.newObject, newClientFn: btp.ServiceClientFromSecret, credentials: map[string]string{}}
+			_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: tc.req})
+			require.NoError(t, err)
+
+			got := tc.kind.newObject()
+			require.NoError(t, kube.Get(context.Background(), tc.req, got))
+			assert.Equal(t, v1alpha1.GlobalAccountMatchedReason, got.GetCondition(v1alpha1.GlobalAccountVerifiedCondition).Reason)
+			var observation v1alpha1.ProviderConfigObservation
+			switch pc := got.(type) {
+			case *namespacedv1alpha1.ProviderConfig:
+				observation = pc.Status.AtProvider
+			case *namespacedv1alpha1.ClusterProviderConfig:
+				observation = pc.Status.AtProvider
+			}
+			assert.Equal(t, "ga-guid", observation.GlobalAccountGUID)
+		})
+	}
+}
+
+type recordedEvents struct {
+	event.NopRecorder
+	reasons []event.Reason
+}
+
+func (r *recordedEvents) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func TestHealthReconcileEvictsRotatedCredentials(t *testing.T) {
+	btp.SetLogger(logging.NewNopLogger())
+	binding := func(secret string) []byte {
+		return []byte(`{"endpoints":{"accounts_service_url":"http://127.0.0.1:1","entitlements_service_url":"http://127.0.0.1:1","provisioning_service_url":"http://127.0.0.1:1"},` +
+			`"grant_type":"client_credentials","uaa":{"clientid":"rotating-client","clientsecret":"` + secret + `","url":"http://127.0.0.1:1"}}`)
+	}
+	pc := fakeProviderConfig(&v1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "rotating"}})
+	cisSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "Namespace"}, Data: map[string][]byte{"data": binding("old")}}
+	saSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameSM, Namespace: "Namespace"}, Data: smSecret}
+	scheme := newScheme(t)
+	require.NoError(t, corev1.AddToScheme(scheme))
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pc, cisSecret, saSecret).WithStatusSubresource(pc).Build()
+
+	var clients []btp.Client
+	newClientFn := func(cis, sa []byte) (btp.Client, error) {
+		c, err := btp.ServiceClientFromSecret(cis, sa)
+		clients = append(clients, c)
+		return c, err
+	}
+	recorder := &recordedEvents{}
+	r := &healthReconciler{kube: kube, log: logging.NewNopLogger(), record: recorder, newObject: checkedKinds[0].newObject, newClientFn: newClientFn, credentials: map[string]string{}}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.GetName()}}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, recorder.reasons, "unchanged credentials must not be evicted")
+
+	cisSecret.Data = map[string][]byte{"data": binding("rotated")}
+	require.NoError(t, kube.Update(context.Background(), cisSecret))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, []event.Reason{reasonCredentialsRotated}, recorder.reasons)
+
+	// the client of the previous credential was evicted, so it is created anew
+	again, err := btp.ServiceClientFromSecret(binding("old"), smSecret["credentials"])
+	require.NoError(t, err)
+	assert.NotSame(t, clients[0].AccountsServiceClient, again.AccountsServiceClient)
+	assert.Same(t, clients[0].AccountsServiceClient, clients[1].AccountsServiceClient)
+}
+
+func TestReferencingProviderConfigs(t *testing.T) {
+	pc := fakeProviderConfig(&v1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	scheme := newScheme(t)
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pc).Build()
+	mapFn := referencingProviderConfigs(kube)
+
+	tests := map[string]struct {
+		secret client.Object
+		want   []reconcile.Request
+	}{
+		"CISSecret": {
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "Namespace"}},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "default"}}},
+		},
+		"ServiceAccountSecret": {
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameSM, Namespace: "Namespace"}},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "default"}}},
+		},
+		"OtherNamespace": {
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "other"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, mapFn(context.Background(), tc.secret))
+		})
+	}
+}
+
+func TestReferencingNamespacedProviderConfigs(t *testing.T) {
+	spec := fakeProviderConfig(&v1alpha1.ProviderConfig{}).Spec
+	pc := &namespacedv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team-a"}, Spec: spec}
+	cpc := &namespacedv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Spec: spec}
+	kube := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(pc, cpc).Build()
+
+	tests := map[string]struct {
+		kind   checkedKind
+		secret client.Object
+		want   []reconcile.Request
+	}{
+		"ProviderConfigCISSecret": {
+			kind: checkedKinds[1],
+			// the secret is resolved in the namespace of the ProviderConfig, not in Namespace of the reference
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "team-a"}},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "default"}}},
+		},
+		"ProviderConfigServiceAccountSecret": {
+			kind:   checkedKinds[1],
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameSM, Namespace: "team-a"}},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "default"}}},
+		},
+		"ProviderConfigOtherNamespace": {
+			kind:   checkedKinds[1],
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "Namespace"}},
+		},
+		"ClusterProviderConfigCISSecret": {
+			kind:   checkedKinds[2],
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "Namespace"}},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "default"}}},
+		},
+		"ClusterProviderConfigServiceAccountSecret": {
+			kind:   checkedKinds[2],
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameSM, Namespace: "Namespace"}},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "default"}}},
+		},
+		"ClusterProviderConfigOtherNamespace": {
+			kind:   checkedKinds[2],
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameCIS, Namespace: "team-a"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.kind.referencing(kube)(context.Background(), tc.secret))
+		})
+	}
+}
+
+func TestRegionOf(t *testing.T) {
+	tests := map[string]struct {
+		url  string
+		want string
+	}{
+		"Region":          {url: "https://accounts-service.cfapps.eu10.hana.ondemand.com", want: "eu10"},
+		"ExtensionRegion": {url: "https://accounts-service.cfapps.us10-001.hana.ondemand.com/", want: "us10-001"},
+		"NoRegion":        {url: "http://127.0.0.1:8080", want: ""},
+		"Invalid":         {url: "://", want: ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, regionOf(tc.url))
+		})
+	}
+}
@@ -0,0 +1,415 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal/audit"
+	internalopts "github.com/sap/crossplane-provider-btp/internal/controller/options"
+	"github.com/sap/crossplane-provider-btp/internal/dryrun"
+	"github.com/sap/crossplane-provider-btp/internal/jobs"
+	"github.com/sap/crossplane-provider-btp/internal/throttling"
+	"github.com/sap/crossplane-provider-btp/internal/tracing"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+// The namespaced variants of the managed resources (Crossplane v2) are served under the
+// ".m." API groups, e.g. account.btp.m.sap.crossplane.io. They share the controller
+// implementation of their cluster scoped counterpart: every call of the external client
+// works on a cluster scoped "twin" of the namespaced resource, which is converted back
+// afterwards. Kubernetes API calls of the controllers are translated to the namespaced
+// resources by namespacedClient.
+
+const (
+	clusterGroupSuffix    = ".btp.sap.crossplane.io"
+	namespacedGroupSuffix = ".btp.m.sap.crossplane.io"
+
+	errNotNamespaced       = "managed resource is not a namespaced managed resource"
+	errNoTwin              = "cannot create cluster scoped counterpart of managed resource"
+	errConvertToCluster    = "cannot convert namespaced managed resource to its cluster scoped counterpart"
+	errConvertToNamespaced = "cannot convert cluster scoped managed resource to its namespaced counterpart"
+)
+
+type namespacedResourceKey struct{}
+
+// withNamespacedResource stores the namespaced managed resource, which is reconciled through its
+// cluster scoped twin, in the context.
+func withNamespacedResource(ctx context.Context, mg resource.ModernManaged) context.Context {
+	return context.WithValue(ctx, namespacedResourceKey{}, mg)
+}
+
+// namespacedResourceFrom returns the namespaced managed resource stored in the context, if any.
+func namespacedResourceFrom(ctx context.Context) (resource.ModernManaged, bool) {
+	mg, ok := ctx.Value(namespacedResourceKey{}).(resource.ModernManaged)
+	return mg, ok
+}
+
+// NamespaceFrom returns the namespace of the namespaced managed resource that is reconciled through its
+// cluster scoped twin, or "" while a cluster scoped managed resource is reconciled.
+func NamespaceFrom(ctx context.Context) string {
+	if mg, ok := namespacedResourceFrom(ctx); ok {
+		return mg.GetNamespace()
+	}
+	return ""
+}
+
+// namespacedGVK returns the kind of the namespaced variant of a cluster scoped kind, if the scheme knows it.
+// The namespaced variant may be served in a different version than the cluster scoped kind.
+func namespacedGVK(s *runtime.Scheme, gvk schema.GroupVersionKind) (schema.GroupVersionKind, bool) {
+	if !strings.HasSuffix(gvk.Group, clusterGroupSuffix) {
+		return schema.GroupVersionKind{}, false
+	}
+	group := strings.TrimSuffix(gvk.Group, clusterGroupSuffix) + namespacedGroupSuffix
+	for known := range s.AllKnownTypes() {
+		if known.Group == group && known.Kind == gvk.Kind {
+			return known, true
+		}
+	}
+	return schema.GroupVersionKind{}, false
+}
+
+// setupNamespaced adds a controller for the namespaced variant of the given cluster scoped kind,
+// if there is one. The controller uses the connector of the cluster scoped kind.
+func setupNamespaced(mgr ctrl.Manager, o internalopts.CrossplaneOptions, gvk schema.GroupVersionKind, connectorFn ConnectorFn, opts ...managed.ReconcilerOption) error {
+	nsGVK, ok := namespacedGVK(mgr.GetScheme(), gvk)
+	if !ok {
+		return nil
+	}
+	obj, err := mgr.GetScheme().New(nsGVK)
+	if err != nil {
+		return errors.Wrap(err, errNoTwin)
+	}
+	object, ok := obj.(client.Object)
+	if !ok {
+		return errors.New(errNotNamespaced)
+	}
+
+	name := managed.ControllerName(nsGVK.GroupKind().String())
+	kube := &namespacedClient{Client: mgr.GetClient()}
+	usageTracker := &namespacedUsageTracker{
+		tracker: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &namespacedv1alpha1.ProviderConfigUsage{}),
+	}
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name)) //nolint:staticcheck // NewAPIRecorder requires the legacy event recorder type.
+	connector := &namespacedConnector{
+		connector: dryrun.NewConnector(jobs.NewConnector(connectorFn(kube, usageTracker, noopResourceTracker{}), kube, recorder)),
+		scheme:    mgr.GetScheme(),
+		gvk:       gvk,
+	}
+
+	r := managed.NewReconciler(
+		mgr,
+		resource.ManagedKind(nsGVK),
+		append([]managed.ReconcilerOption{
+			managed.WithExternalConnector(tracing.NewConnector(nsGVK, throttling.NewConnector(audit.NewConnector(nsGVK, connector)))),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(recorder),
+			managed.WithPollInterval(o.PollInterval),
+		}, opts...)...,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntimeWithBackoff()).
+		For(object).
+		WithEventFilter(resource.DesiredStateChanged()).
+		Complete(ratelimiter.NewReconciler(name, tracing.NewReconciler(nsGVK, throttling.NewReconciler(mgr.GetClient(), nsGVK, r)), o.GlobalRateLimiter))
+}
+
+// namespacedConnector connects to the external API of a namespaced managed resource
+// using the connector of its cluster scoped counterpart.
+type namespacedConnector struct {
+	connector managed.ExternalConnector
+	scheme    *runtime.Scheme
+	gvk       schema.GroupVersionKind
+}
+
+func (c *namespacedConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if err := checkAPICredentials(mg); err != nil {
+		return nil, err
+	}
+	var ext managed.ExternalClient
+	err := convertCall(ctx, c.scheme, c.gvk, mg, func(ctx context.Context, twin resource.Managed) (err error) {
+		ext, err = c.connector.Connect(ctx, twin)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &namespacedExternal{client: ext, scheme: c.scheme, gvk: c.gvk}, nil
+}
+
+// checkAPICredentials makes sure that a namespaced managed resource, which reads its own API credentials,
+// does not read them from the environment or filesystem of the provider.
+func checkAPICredentials(mg resource.Managed) error {
+	m, err := toMap(mg)
+	if err != nil {
+		return err
+	}
+	spec, _ := m["spec"].(map[string]any)
+	credentials, _ := spec["apiCredentials"].(map[string]any)
+	source, _ := credentials["source"].(string)
+	return checkNamespacedSource(xpv1.CredentialsSource(source))
+}
+
+// namespacedExternal calls the external client of the cluster scoped counterpart of a namespaced managed resource.
+type namespacedExternal struct {
+	client managed.ExternalClient
+	scheme *runtime.Scheme
+	gvk    schema.GroupVersionKind
+}
+
+func (e *namespacedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	var obs managed.ExternalObservation
+	err := convertCall(ctx, e.scheme, e.gvk, mg, func(ctx context.Context, twin resource.Managed) (err error) {
+		obs, err = e.client.Observe(ctx, twin)
+		return err
+	})
+	return obs, err
+}
+
+func (e *namespacedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	var creation managed.ExternalCreation
+	err := convertCall(ctx, e.scheme, e.gvk, mg, func(ctx context.Context, twin resource.Managed) (err error) {
+		creation, err = e.client.Create(ctx, twin)
+		return err
+	})
+	return creation, err
+}
+
+func (e *namespacedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	var update managed.ExternalUpdate
+	err := convertCall(ctx, e.scheme, e.gvk, mg, func(ctx context.Context, twin resource.Managed) (err error) {
+		update, err = e.client.Update(ctx, twin)
+		return err
+	})
+	return update, err
+}
+
+func (e *namespacedExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	var deletion managed.ExternalDelete
+	err := convertCall(ctx, e.scheme, e.gvk, mg, func(ctx context.Context, twin resource.Managed) (err error) {
+		deletion, err = e.client.Delete(ctx, twin)
+		return err
+	})
+	return deletion, err
+}
+
+func (e *namespacedExternal) Disconnect(ctx context.Context) error {
+	return e.client.Disconnect(ctx)
+}
+
+// convertCall calls fn with the cluster scoped twin of the namespaced managed resource mg,
+// and applies all changes fn made to the twin to mg afterwards.
+func convertCall(ctx context.Context, s *runtime.Scheme, gvk schema.GroupVersionKind, mg resource.Managed, fn func(ctx context.Context, twin resource.Managed) error) error {
+	nmg, ok := mg.(resource.ModernManaged)
+	if !ok {
+		return errors.New(errNotNamespaced)
+	}
+	obj, err := s.New(gvk)
+	if err != nil {
+		return errors.Wrap(err, errNoTwin)
+	}
+	twin, ok := obj.(resource.Managed)
+	if !ok {
+		return errors.New(errNoTwin)
+	}
+	if err := toClusterScoped(nmg, twin, gvk); err != nil {
+		return errors.Wrap(err, errConvertToCluster)
+	}
+
+	fnErr := fn(withNamespacedResource(ctx, nmg), twin)
+
+	if err := toNamespaced(twin, nmg); err != nil {
+		return errors.Wrap(err, errConvertToNamespaced)
+	}
+	return fnErr
+}
+
+// toClusterScoped converts the namespaced object from to its cluster scoped counterpart to of kind gvk.
+// All secrets referenced by the namespaced object are expected in its namespace.
+func toClusterScoped(from, to client.Object, gvk schema.GroupVersionKind) error {
+	m, err := toMap(from)
+	if err != nil {
+		return err
+	}
+	clusterScoped(m, gvk, from.GetNamespace())
+	return fromMap(m, to)
+}
+
+func clusterScoped(m map[string]any, gvk schema.GroupVersionKind, namespace string) {
+	m["apiVersion"], m["kind"] = gvk.ToAPIVersionAndKind()
+	if meta, ok := m["metadata"].(map[string]any); ok {
+		delete(meta, "namespace")
+	}
+	spec, ok := m["spec"].(map[string]any)
+	if !ok {
+		return
+	}
+	if ref, ok := spec["providerConfigRef"].(map[string]any); ok {
+		delete(ref, "kind")
+	}
+	if ref, ok := spec["writeConnectionSecretToRef"].(map[string]any); ok {
+		ref["namespace"] = namespace
+	}
+	localizeSecretReferences(spec, namespace)
+}
+
+// localizeSecretReferences points all secret references of a spec to the given namespace.
+func localizeSecretReferences(m map[string]any, namespace string) {
+	for k, v := range m {
+		// Parameters are passed to the external API as they are.
+		if k == "parameters" {
+			continue
+		}
+		switch val := v.(type) {
+		case map[string]any:
+			if k == "secretRef" || strings.HasSuffix(k, "SecretRef") {
+				val["namespace"] = namespace
+			}
+			localizeSecretReferences(val, namespace)
+		case []any:
+			for _, item := range val {
+				if im, ok := item.(map[string]any); ok {
+					if strings.HasSuffix(k, "SecretRefs") {
+						im["namespace"] = namespace
+					}
+					localizeSecretReferences(im, namespace)
+				}
+			}
+		case string:
+			switch {
+			case strings.HasSuffix(k, "SecretNamespace"):
+				m[k] = namespace
+			case strings.HasSuffix(k, "Secret") && val != "":
+				m[k+"Namespace"] = namespace
+			}
+		}
+	}
+}
+
+// toNamespaced applies the cluster scoped object from to its namespaced counterpart to.
+// The fields that only exist in the namespaced API are kept.
+func toNamespaced(from, to client.Object) error {
+	current, err := toMap(to)
+	if err != nil {
+		return err
+	}
+	m, err := toMap(from)
+	if err != nil {
+		return err
+	}
+	namespaced(m, current)
+	return fromMap(m, to)
+}
+
+func namespaced(m, current map[string]any) {
+	m["apiVersion"], m["kind"] = current["apiVersion"], current["kind"]
+	if meta, ok := m["metadata"].(map[string]any); ok {
+		if cm, ok := current["metadata"].(map[string]any); ok {
+			meta["namespace"] = cm["namespace"]
+		}
+	}
+	spec, ok := m["spec"].(map[string]any)
+	if !ok {
+		return
+	}
+	cs, _ := current["spec"].(map[string]any)
+	for _, k := range []string{"providerConfigRef", "writeConnectionSecretToRef", "managementPolicies"} {
+		if v, ok := cs[k]; ok {
+			spec[k] = v
+		} else {
+			delete(spec, k)
+		}
+	}
+	keepReferenceNamespaces(spec, cs)
+}
+
+// keepReferenceNamespaces copies the namespaces of references and selectors,
+// which are unknown to the cluster scoped API, from current to m.
+func keepReferenceNamespaces(m, current map[string]any) {
+	for k, cv := range current {
+		cm, ok := cv.(map[string]any)
+		if !ok {
+			continue
+		}
+		mm, ok := m[k].(map[string]any)
+		if !ok {
+			continue
+		}
+		if ns, ok := cm["namespace"]; ok {
+			if _, exists := mm["namespace"]; !exists {
+				mm["namespace"] = ns
+			}
+		}
+		keepReferenceNamespaces(mm, cm)
+	}
+}
+
+func toMap(obj runtime.Object) (map[string]any, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{}
+	return m, json.Unmarshal(b, &m)
+}
+
+// fromMap replaces the content of obj with m.
+func fromMap(m map[string]any, obj runtime.Object) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return json.Unmarshal(b, obj)
+}
+
+// namespacedUsageTracker tracks the ProviderConfig usage of the namespaced managed resource
+// a cluster scoped twin is reconciled for.
+type namespacedUsageTracker struct {
+	tracker *resource.ProviderConfigUsageTracker
+}
+
+func (t *namespacedUsageTracker) Track(ctx context.Context, _ LegacyManaged) error {
+	mg, ok := namespacedResourceFrom(ctx)
+	if !ok {
+		return errors.New(errNotNamespaced)
+	}
+	return t.tracker.Track(ctx, mg)
+}
+
+// noopResourceTracker skips the ResourceUsage tracking, which only supports cluster scoped resources.
+type noopResourceTracker struct{}
+
+var _ tracking.ReferenceResolverTracker = noopResourceTracker{}
+
+func (noopResourceTracker) Track(context.Context, resource.Managed) error { return nil }
+
+func (noopResourceTracker) SetConditions(context.Context, resource.Managed) {}
+
+func (noopResourceTracker) ResolveSource(context.Context, v1alpha1.ResourceUsage) (*metav1.PartialObjectMetadata, error) {
+	return nil, nil
+}
+
+func (noopResourceTracker) ResolveTarget(context.Context, v1alpha1.ResourceUsage) (*metav1.PartialObjectMetadata, error) {
+	return nil, nil
+}
+
+func (noopResourceTracker) DeleteShouldBeBlocked(resource.Managed) bool { return false }
@@ -0,0 +1,255 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+const errNamespacedCredentialsSource = "credentials source %q is not supported by namespaced ProviderConfigs and managed resources"
+
+// namespacedClient translates the Kubernetes API calls, which controllers of cluster scoped managed
+// resources make while reconciling a namespaced managed resource, to the namespaced counterparts of
+// the objects in the namespace of that resource.
+type namespacedClient struct {
+	client.Client
+}
+
+// twin returns the namespaced kind of obj, if obj is a cluster scoped object of a kind with a namespaced variant.
+func (c *namespacedClient) twin(obj client.Object) (schema.GroupVersionKind, schema.GroupVersionKind, bool) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return schema.GroupVersionKind{}, schema.GroupVersionKind{}, false
+	}
+	nsGVK, ok := namespacedGVK(c.Scheme(), gvk)
+	return gvk, nsGVK, ok
+}
+
+func (c *namespacedClient) newObject(gvk schema.GroupVersionKind) (client.Object, error) {
+	obj, err := c.Scheme().New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := obj.(client.Object)
+	if !ok {
+		return nil, errors.Errorf("%s is not an object", gvk)
+	}
+	return o, nil
+}
+
+func (c *namespacedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	mg, ok := namespacedResourceFrom(ctx)
+	if !ok {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+	if pc, ok := obj.(*v1alpha1.ProviderConfig); ok {
+		resolved, err := resolveNamespacedProviderConfig(ctx, c.Client, mg, key.Name)
+		if err != nil {
+			return err
+		}
+		resolved.DeepCopyInto(pc)
+		return nil
+	}
+	gvk, nsGVK, ok := c.twin(obj)
+	if !ok {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+	nsObj, err := c.newObject(nsGVK)
+	if err != nil {
+		return err
+	}
+	if err := c.Client.Get(ctx, types.NamespacedName{Namespace: mg.GetNamespace(), Name: key.Name}, nsObj, opts...); err != nil {
+		return err
+	}
+	return toClusterScoped(nsObj, obj, gvk)
+}
+
+func (c *namespacedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	mg, ok := namespacedResourceFrom(ctx)
+	if !ok {
+		return c.Client.List(ctx, list, opts...)
+	}
+	gvk, err := apiutil.GVKForObject(list, c.Scheme())
+	if err != nil {
+		return c.Client.List(ctx, list, opts...)
+	}
+	nsGVK, ok := namespacedGVK(c.Scheme(), gvk)
+	if !ok {
+		return c.Client.List(ctx, list, opts...)
+	}
+	obj, err := c.Scheme().New(nsGVK)
+	if err != nil {
+		return err
+	}
+	nsList, ok := obj.(client.ObjectList)
+	if !ok {
+		return errors.Errorf("%s is not a list", nsGVK)
+	}
+	if err := c.Client.List(ctx, nsList, append(opts, client.InNamespace(mg.GetNamespace()))...); err != nil {
+		return err
+	}
+
+	m, err := toMap(nsList)
+	if err != nil {
+		return err
+	}
+	m["apiVersion"], m["kind"] = gvk.ToAPIVersionAndKind()
+	itemGVK := gvk.GroupVersion().WithKind(strings.TrimSuffix(gvk.Kind, "List"))
+	items, _ := m["items"].([]any)
+	for _, item := range items {
+		if im, ok := item.(map[string]any); ok {
+			clusterScoped(im, itemGVK, mg.GetNamespace())
+		}
+	}
+	return fromMap(m, list)
+}
+
+func (c *namespacedClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.update(ctx, obj, func(nsObj client.Object) error {
+		return c.Client.Update(ctx, nsObj, opts...)
+	}, func() error {
+		return c.Client.Update(ctx, obj, opts...)
+	})
+}
+
+func (c *namespacedClient) Status() client.SubResourceWriter {
+	return &namespacedStatusWriter{SubResourceWriter: c.Client.Status(), client: c}
+}
+
+// update applies obj to its namespaced counterpart and writes it with fn. Objects without
+// namespaced counterpart are written with fallback.
+func (c *namespacedClient) update(ctx context.Context, obj client.Object, fn func(nsObj client.Object) error, fallback func() error) error {
+	mg, ok := namespacedResourceFrom(ctx)
+	if !ok {
+		return fallback()
+	}
+	_, nsGVK, ok := c.twin(obj)
+	if !ok {
+		return fallback()
+	}
+	nsObj, err := c.newObject(nsGVK)
+	if err != nil {
+		return err
+	}
+	if err := c.Client.Get(ctx, types.NamespacedName{Namespace: mg.GetNamespace(), Name: obj.GetName()}, nsObj); err != nil {
+		return err
+	}
+	if err := toNamespaced(obj, nsObj); err != nil {
+		return err
+	}
+	if err := fn(nsObj); err != nil {
+		return err
+	}
+	obj.SetResourceVersion(nsObj.GetResourceVersion())
+	return nil
+}
+
+type namespacedStatusWriter struct {
+	client.SubResourceWriter
+	client *namespacedClient
+}
+
+func (w *namespacedStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return w.client.update(ctx, obj, func(nsObj client.Object) error {
+		return w.SubResourceWriter.Update(ctx, nsObj, opts...)
+	}, func() error {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	})
+}
+
+// resolveNamespacedProviderConfig returns the ProviderConfig or ClusterProviderConfig referenced by a
+// namespaced managed resource in the form of the cluster scoped ProviderConfig. Credentials of a namespaced
+// ProviderConfig are always read from its own namespace.
+func resolveNamespacedProviderConfig(ctx context.Context, kube client.Reader, mg resource.ModernManaged, name string) (*v1alpha1.ProviderConfig, error) {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return nil, errors.New("managed resource has no ProviderConfigReference")
+	}
+	if name == "" {
+		name = ref.Name
+	}
+
+	var from client.Object
+	switch ref.Kind {
+	case namespacedv1alpha1.ClusterProviderConfigKind:
+		cpc := &namespacedv1alpha1.ClusterProviderConfig{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: name}, cpc); err != nil {
+			return nil, err
+		}
+		from = cpc
+	case namespacedv1alpha1.ProviderConfigKind:
+		pc := &namespacedv1alpha1.ProviderConfig{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: mg.GetNamespace(), Name: name}, pc); err != nil {
+			return nil, err
+		}
+		from = pc
+	default:
+		return nil, errors.Errorf("unsupported ProviderConfig kind %q", ref.Kind)
+	}
+	return asProviderConfig(from)
+}
+
+// asProviderConfig returns a ProviderConfig of any kind in the form of the cluster scoped ProviderConfig.
+// Credentials of a namespaced ProviderConfig are always read from its own namespace.
+func asProviderConfig(from client.Object) (*v1alpha1.ProviderConfig, error) {
+	if pc, ok := from.(*v1alpha1.ProviderConfig); ok {
+		return pc, nil
+	}
+	namespace := from.GetNamespace()
+	if npc, ok := from.(*namespacedv1alpha1.ProviderConfig); ok {
+		if err := checkNamespacedCredentials(npc.Spec); err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := json.Marshal(from)
+	if err != nil {
+		return nil, err
+	}
+	pc := &v1alpha1.ProviderConfig{}
+	if err := json.Unmarshal(b, pc); err != nil {
+		return nil, err
+	}
+	pc.SetGroupVersionKind(v1alpha1.ProviderConfigGroupVersionKind)
+	pc.SetNamespace("")
+	if namespace != "" {
+		if pc.Spec.CISSecret.SecretRef != nil {
+			pc.Spec.CISSecret.SecretRef.Namespace = namespace
+		}
+		if pc.Spec.ServiceAccountSecret.SecretRef != nil {
+			pc.Spec.ServiceAccountSecret.SecretRef.Namespace = namespace
+		}
+	}
+	return pc, nil
+}
+
+// checkNamespacedCredentials makes sure that a namespaced ProviderConfig does not use credentials
+// from the environment or filesystem of the provider, which belong to the cluster administrator.
+func checkNamespacedCredentials(spec v1alpha1.ProviderConfigSpec) error {
+	for _, cd := range []v1alpha1.ProviderCredentials{spec.CISSecret, spec.ServiceAccountSecret} {
+		if err := checkNamespacedSource(cd.Source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNamespacedSource makes sure that credentials of a namespaced object are read from a secret, if at all.
+func checkNamespacedSource(source xpv1.CredentialsSource) error {
+	switch source {
+	case xpv1.CredentialsSourceSecret, xpv1.CredentialsSourceNone, "":
+		return nil
+	}
+	return errors.Errorf(errNamespacedCredentialsSource, source)
+}
@@ -0,0 +1,279 @@
+package providerconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/sap/crossplane-provider-btp/apis"
+	accountv1alpha1 "github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	accountv1beta1 "github.com/sap/crossplane-provider-btp/apis/account/v1beta1"
+	nsaccountv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/account/v1alpha1"
+	nssecurityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/security/v1alpha1"
+	namespacedv1alpha1 "github.com/sap/crossplane-provider-btp/apis/namespaced/v1alpha1"
+	securityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	require.NoError(t, apis.AddToScheme(s))
+	return s
+}
+
+func TestNamespacedGVK(t *testing.T) {
+	s := newScheme(t)
+
+	tests := map[string]struct {
+		in     func() (string, bool)
+		wantOK bool
+		want   string
+	}{
+		"ServiceManager": {
+			in: func() (string, bool) {
+				gvk, ok := namespacedGVK(s, accountv1beta1.ServiceManagerGroupVersionKind)
+				return gvk.String(), ok
+			},
+			wantOK: true,
+			want:   nsaccountv1alpha1.ServiceManagerGroupVersionKind.String(),
+		},
+		"ServiceInstance": {
+			in: func() (string, bool) {
+				gvk, ok := namespacedGVK(s, accountv1alpha1.ServiceInstanceGroupVersionKind)
+				return gvk.String(), ok
+			},
+			wantOK: true,
+			want:   nsaccountv1alpha1.ServiceInstanceGroupVersionKind.String(),
+		},
+		"ProviderConfig": {
+			in: func() (string, bool) {
+				gvk, ok := namespacedGVK(s, v1alpha1.ProviderConfigGroupVersionKind)
+				return gvk.String(), ok
+			},
+		},
+		"KindWithoutNamespacedVariant": {
+			in: func() (string, bool) {
+				gvk, ok := namespacedGVK(s, securityv1alpha1.SubaccountApiCredential_GroupVersionKind)
+				return gvk.String(), ok
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := tc.in()
+			assert.Equal(t, tc.wantOK, ok)
+			if tc.wantOK {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func namespacedServiceInstance() *nsaccountv1alpha1.ServiceInstance {
+	return &nsaccountv1alpha1.ServiceInstance{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       nsaccountv1alpha1.ServiceInstanceKind,
+			APIVersion: nsaccountv1alpha1.CRDGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "team-a"},
+		Spec: nsaccountv1alpha1.ServiceInstanceSpec{
+			ManagedResourceSpec: xpv2.ManagedResourceSpec{
+				ProviderConfigReference:          &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "default"},
+				WriteConnectionSecretToReference: &xpv1.LocalSecretReference{Name: "instance-secret"},
+			},
+			ForProvider: nsaccountv1alpha1.ServiceInstanceParameters{
+				Name:                 "instance",
+				Parameters:           runtime.RawExtension{Raw: []byte(`{"secretRef":{"name":"external"}}`)},
+				ParameterSecretRefs:  []xpv1.LocalSecretKeySelector{{LocalSecretReference: xpv1.LocalSecretReference{Name: "params"}, Key: "json"}},
+				ServiceManagerRef:    &xpv1.NamespacedReference{Name: "sm", Namespace: "team-b"},
+				ServiceManagerSecret: "sm-secret",
+			},
+		},
+	}
+}
+
+func TestToClusterScoped(t *testing.T) {
+	nsObj := namespacedServiceInstance()
+	got := &accountv1alpha1.ServiceInstance{}
+
+	require.NoError(t, toClusterScoped(nsObj, got, accountv1alpha1.ServiceInstanceGroupVersionKind))
+
+	assert.Equal(t, accountv1alpha1.ServiceInstanceGroupVersionKind, got.GroupVersionKind())
+	assert.Empty(t, got.GetNamespace())
+	assert.Equal(t, "default", got.Spec.ProviderConfigReference.Name)
+	assert.Equal(t, &xpv1.SecretReference{Name: "instance-secret", Namespace: "team-a"}, got.Spec.WriteConnectionSecretToReference)
+	assert.Equal(t, "team-a", got.Spec.ForProvider.ServiceManagerSecretNamespace)
+	require.Len(t, got.Spec.ForProvider.ParameterSecretRefs, 1)
+	assert.Equal(t, "team-a", got.Spec.ForProvider.ParameterSecretRefs[0].Namespace)
+	assert.JSONEq(t, `{"secretRef":{"name":"external"}}`, string(got.Spec.ForProvider.Parameters.Raw))
+}
+
+func TestNamespacedConnectorRejectsProviderCredentials(t *testing.T) {
+	tests := map[string]struct {
+		mg      resource.Managed
+		wantErr string
+	}{
+		"Secret": {
+			mg: &nssecurityv1alpha1.RoleCollection{Spec: nssecurityv1alpha1.RoleCollectionSpec{
+				XSUAACredentialsReference: nssecurityv1alpha1.XSUAACredentialsReference{
+					APICredentials: nssecurityv1alpha1.APICredentials{Source: xpv1.CredentialsSourceSecret},
+				},
+			}},
+		},
+		"Filesystem": {
+			mg: &nssecurityv1alpha1.RoleCollection{Spec: nssecurityv1alpha1.RoleCollectionSpec{
+				XSUAACredentialsReference: nssecurityv1alpha1.XSUAACredentialsReference{
+					APICredentials: nssecurityv1alpha1.APICredentials{Source: xpv1.CredentialsSourceFilesystem},
+				},
+			}},
+			wantErr: `credentials source "Filesystem" is not supported by namespaced ProviderConfigs and managed resources`,
+		},
+		"NoAPICredentials": {
+			mg: namespacedServiceInstance(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkAPICredentials(tc.mg)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+			_, err = (&namespacedConnector{}).Connect(context.Background(), tc.mg)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestToNamespaced(t *testing.T) {
+	nsObj := namespacedServiceInstance()
+	twin := &accountv1alpha1.ServiceInstance{}
+	require.NoError(t, toClusterScoped(nsObj, twin, accountv1alpha1.ServiceInstanceGroupVersionKind))
+
+	twin.SetAnnotations(map[string]string{"crossplane.io/external-name": "guid"})
+	twin.Status.AtProvider.ID = "guid"
+
+	require.NoError(t, toNamespaced(twin, nsObj))
+
+	assert.Equal(t, nsaccountv1alpha1.ServiceInstanceGroupVersionKind, nsObj.GroupVersionKind())
+	assert.Equal(t, "team-a", nsObj.GetNamespace())
+	assert.Equal(t, "guid", nsObj.GetAnnotations()["crossplane.io/external-name"])
+	assert.Equal(t, "guid", nsObj.Status.AtProvider.ID)
+	assert.Equal(t, &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "default"}, nsObj.Spec.ProviderConfigReference)
+	assert.Equal(t, &xpv1.LocalSecretReference{Name: "instance-secret"}, nsObj.Spec.WriteConnectionSecretToReference)
+	assert.Equal(t, &xpv1.NamespacedReference{Name: "sm", Namespace: "team-b"}, nsObj.Spec.ForProvider.ServiceManagerRef)
+}
+
+func TestResolveNamespacedProviderConfig(t *testing.T) {
+	secretSpec := func(source xpv1.CredentialsSource) v1alpha1.ProviderConfigSpec {
+		return v1alpha1.ProviderConfigSpec{
+			CISSecret: v1alpha1.ProviderCredentials{
+				Source: source,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "cis", Namespace: "elsewhere"},
+					Key:             "data",
+				}},
+			},
+			ServiceAccountSecret: v1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "sa"},
+					Key:             "credentials",
+				}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		kind    string
+		objects []runtime.Object
+		wantErr bool
+		wantNS  string
+	}{
+		"NamespacedProviderConfig": {
+			kind: namespacedv1alpha1.ProviderConfigKind,
+			objects: []runtime.Object{&namespacedv1alpha1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team-a"},
+				Spec:       secretSpec(xpv1.CredentialsSourceSecret),
+			}},
+			wantNS: "team-a",
+		},
+		"NamespacedProviderConfigWithEnvironmentCredentials": {
+			kind: namespacedv1alpha1.ProviderConfigKind,
+			objects: []runtime.Object{&namespacedv1alpha1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team-a"},
+				Spec:       secretSpec(xpv1.CredentialsSourceEnvironment),
+			}},
+			wantErr: true,
+		},
+		"NamespacedProviderConfigInOtherNamespace": {
+			kind: namespacedv1alpha1.ProviderConfigKind,
+			objects: []runtime.Object{&namespacedv1alpha1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team-b"},
+				Spec:       secretSpec(xpv1.CredentialsSourceSecret),
+			}},
+			wantErr: true,
+		},
+		"ClusterProviderConfig": {
+			kind: namespacedv1alpha1.ClusterProviderConfigKind,
+			objects: []runtime.Object{&namespacedv1alpha1.ClusterProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       secretSpec(xpv1.CredentialsSourceSecret),
+			}},
+			wantNS: "elsewhere",
+		},
+		"UnknownKind": {
+			kind:    "SomethingElse",
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			kube := fake.NewClientBuilder().WithScheme(newScheme(t)).WithRuntimeObjects(tc.objects...).Build()
+			mg := namespacedServiceInstance()
+			mg.Spec.ProviderConfigReference = &xpv1.ProviderConfigReference{Kind: tc.kind, Name: "default"}
+
+			pc, err := resolveNamespacedProviderConfig(context.Background(), kube, mg, "")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v1alpha1.ProviderConfigGroupVersionKind, pc.GroupVersionKind())
+			assert.Equal(t, tc.wantNS, pc.Spec.CISSecret.SecretRef.Namespace)
+		})
+	}
+}
+
+func TestSubaccountCustomPropertiesRoundTrip(t *testing.T) {
+	nsObj := &nsaccountv1alpha1.Subaccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       nsaccountv1alpha1.SubaccountKind,
+			APIVersion: nsaccountv1alpha1.CRDGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "subaccount", Namespace: "team-a"},
+		Spec: nsaccountv1alpha1.SubaccountSpec{
+			ForProvider: nsaccountv1alpha1.SubaccountParameters{
+				DisplayName:      "dev",
+				CustomProperties: map[string]string{"costCenter": "4711"},
+			},
+		},
+	}
+	twin := &accountv1alpha1.Subaccount{}
+	require.NoError(t, toClusterScoped(nsObj, twin, accountv1alpha1.SubaccountGroupVersionKind))
+	assert.Equal(t, map[string]string{"costCenter": "4711"}, twin.Spec.ForProvider.CustomProperties)
+
+	twin.Status.AtProvider.CustomProperties = map[string]string{"costCenter": "4711", "owner": "jane"}
+	require.NoError(t, toNamespaced(twin, nsObj))
+	assert.Equal(t, map[string]string{"costCenter": "4711"}, nsObj.Spec.ForProvider.CustomProperties)
+	assert.Equal(t, map[string]string{"costCenter": "4711", "owner": "jane"}, nsObj.Status.AtProvider.CustomProperties)
+}
@@ -0,0 +1,87 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oidcv1alpha1 "github.com/sap/crossplane-provider-btp/apis/oidc/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+)
+
+const (
+	errOIDCTokenExchangeMissing = "oidcTokenExchange must be set for the credentials source OIDCTokenExchange"
+	errOIDCTokenSource          = "exactly one of serviceAccountTokenPath and certBasedOIDCLoginRef must be set in oidcTokenExchange"
+	errReadServiceAccountToken  = "cannot read projected service account token"
+	errGetCertBasedOIDCLogin    = "cannot get CertBasedOIDCLogin"
+	errNoConnectionSecret       = "CertBasedOIDCLogin %s does not write a connection secret"
+	errGetIDToken               = "cannot get ID token of CertBasedOIDCLogin"
+	errIDTokenEmpty             = "connection secret of CertBasedOIDCLogin %s does not contain an ID token yet"
+	errServiceAccountTokenEmpty = "projected service account token %s is empty"
+	errAddAssertion             = "cannot add OIDC token to CIS binding"
+)
+
+// loadOIDCToken returns the OIDC token that is exchanged for CIS access tokens. It is read on every
+// connect, so that the rotated projected service account token or ID token of a CertBasedOIDCLogin is used.
+func loadOIDCToken(ctx context.Context, kube client.Client, exchange *v1alpha1.OIDCTokenExchange) (string, error) {
+	if exchange == nil {
+		return "", errors.New(errOIDCTokenExchangeMissing)
+	}
+	switch {
+	case exchange.ServiceAccountTokenPath != "" && exchange.CertBasedOIDCLoginRef == nil:
+		token, err := os.ReadFile(exchange.ServiceAccountTokenPath)
+		if err != nil {
+			return "", errors.Wrap(err, errReadServiceAccountToken)
+		}
+		if len(strings.TrimSpace(string(token))) == 0 {
+			return "", errors.Errorf(errServiceAccountTokenEmpty, exchange.ServiceAccountTokenPath)
+		}
+		return strings.TrimSpace(string(token)), nil
+	case exchange.ServiceAccountTokenPath == "" && exchange.CertBasedOIDCLoginRef != nil:
+		return loadCertBasedOIDCLoginToken(ctx, kube, exchange.CertBasedOIDCLoginRef.Name)
+	default:
+		return "", errors.New(errOIDCTokenSource)
+	}
+}
+
+func loadCertBasedOIDCLoginToken(ctx context.Context, kube client.Client, name string) (string, error) {
+	login := &oidcv1alpha1.CertBasedOIDCLogin{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: name}, login); err != nil {
+		return "", errors.Wrap(err, errGetCertBasedOIDCLogin)
+	}
+	ref := login.Spec.WriteConnectionSecretToReference
+	if ref == nil {
+		return "", errors.Errorf(errNoConnectionSecret, name)
+	}
+	secret := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return "", errors.Wrap(err, errGetIDToken)
+	}
+	token := string(secret.Data[oidcv1alpha1.ConDetailsIDToken])
+	if token == "" {
+		return "", errors.Errorf(errIDTokenEmpty, name)
+	}
+	return token, nil
+}
+
+// withJWTBearerAssertion adds the OIDC token to the JSON of the CIS binding, from where the btp client picks it up.
+func withJWTBearerAssertion(binding []byte, assertion string) ([]byte, error) {
+	data := map[string]json.RawMessage{}
+	if err := json.Unmarshal(binding, &data); err != nil {
+		return nil, errors.Wrap(err, errAddAssertion)
+	}
+	raw, err := json.Marshal(assertion)
+	if err != nil {
+		return nil, errors.Wrap(err, errAddAssertion)
+	}
+	data[btp.JWTBearerAssertionKey] = raw
+	b, err := json.Marshal(data)
+	return b, errors.Wrap(err, errAddAssertion)
+}
@@ -0,0 +1,91 @@
+package providerconfig
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	oidcv1alpha1 "github.com/sap/crossplane-provider-btp/apis/oidc/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+)
+
+func TestLoadOIDCToken(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("sa-token\n"), 0o600))
+
+	login := &oidcv1alpha1.CertBasedOIDCLogin{ObjectMeta: metav1.ObjectMeta{Name: "login"}}
+	login.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "login-token", Namespace: "crossplane-system"}
+	noSecretLogin := &oidcv1alpha1.CertBasedOIDCLogin{ObjectMeta: metav1.ObjectMeta{Name: "no-secret"}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "login-token", Namespace: "crossplane-system"},
+		Data:       map[string][]byte{oidcv1alpha1.ConDetailsIDToken: []byte("id-token")},
+	}
+	scheme := newScheme(t)
+	require.NoError(t, corev1.AddToScheme(scheme))
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(login, noSecretLogin, secret).Build()
+
+	tests := map[string]struct {
+		exchange *v1alpha1.OIDCTokenExchange
+		want     string
+		wantErr  string
+	}{
+		"ServiceAccountToken": {
+			exchange: &v1alpha1.OIDCTokenExchange{ServiceAccountTokenPath: tokenPath},
+			want:     "sa-token",
+		},
+		"CertBasedOIDCLogin": {
+			exchange: &v1alpha1.OIDCTokenExchange{CertBasedOIDCLoginRef: &xpv1.Reference{Name: "login"}},
+			want:     "id-token",
+		},
+		"Missing": {
+			wantErr: errOIDCTokenExchangeMissing,
+		},
+		"BothSources": {
+			exchange: &v1alpha1.OIDCTokenExchange{ServiceAccountTokenPath: tokenPath, CertBasedOIDCLoginRef: &xpv1.Reference{Name: "login"}},
+			wantErr:  errOIDCTokenSource,
+		},
+		"MissingTokenFile": {
+			exchange: &v1alpha1.OIDCTokenExchange{ServiceAccountTokenPath: filepath.Join(t.TempDir(), "missing")},
+			wantErr:  errReadServiceAccountToken,
+		},
+		"LoginWithoutConnectionSecret": {
+			exchange: &v1alpha1.OIDCTokenExchange{CertBasedOIDCLoginRef: &xpv1.Reference{Name: "no-secret"}},
+			wantErr:  "does not write a connection secret",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := loadOIDCToken(context.Background(), kube, tc.exchange)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWithJWTBearerAssertion(t *testing.T) {
+	binding, err := withJWTBearerAssertion(btpCustomSecret["data"], "id-token")
+	require.NoError(t, err)
+
+	var cis btp.CISCredential
+	require.NoError(t, json.Unmarshal(binding, &cis))
+	assert.Equal(t, "id-token", cis.JWTBearerAssertion)
+	assert.Equal(t, "xxx", cis.Uaa.Clientid)
+	assert.Equal(t, "xxx", cis.Endpoints.AccountsServiceUrl)
+
+	_, err = withJWTBearerAssertion([]byte("no json"), "id-token")
+	assert.ErrorContains(t, err, errAddAssertion)
+}
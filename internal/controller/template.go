@@ -13,6 +13,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/resourceusage"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/servicemanager"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/subaccount"
+	"github.com/sap/crossplane-provider-btp/internal/controller/account/subaccountsettings"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/subscription"
 	"github.com/sap/crossplane-provider-btp/internal/controller/environment/cloudfoundry"
 
@@ -29,6 +30,7 @@ import (
 func CustomSetup(mgr ctrl.Manager, o internalopts.CrossplaneOptions) error {
 	for _, setup := range []func(ctrl.Manager, internalopts.CrossplaneOptions) error{
 		subaccount.Setup,
+		subaccountsettings.Setup,
 		cloudfoundry.Setup,
 		kyma.Setup,
 		entitlement.Setup,
@@ -0,0 +1,251 @@
+// Package dryrun previews what the provider would send to BTP for managed resources
+// with the btp.sap.crossplane.io/dry-run annotation.
+//
+// The connector of this package runs Observe as usual, but reports the external
+// resource as existing and up to date, so that the managed reconciler never calls
+// Create, Update or Delete. Instead, the ExternalClient, if it implements Planner,
+// computes the request it would send, which is written to status.dryRun of the
+// managed resource together with its difference to the external resource.
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+// Annotation enables the dry-run of a managed resource when set to "true".
+const Annotation = "btp.sap.crossplane.io/dry-run"
+
+const (
+	errPlan          = "cannot plan dry-run"
+	errMarshalPlan   = "cannot marshal dry-run payload"
+	errUnmarshalPlan = "cannot unmarshal dry-run payload"
+)
+
+// Enabled returns whether the dry-run of o is enabled.
+func Enabled(o metav1.Object) bool {
+	return o.GetAnnotations()[Annotation] == "true"
+}
+
+// Plan is a request the provider would send to BTP.
+type Plan struct {
+	// Desired is the payload that would be sent.
+	Desired any
+	// Observed is the external resource in the shape of Desired, or nil if it would be created.
+	Observed any
+}
+
+// Planner is implemented by ExternalClients that can preview their requests. Plan is called
+// after Observe with the operation the managed reconciler would perform next.
+type Planner interface {
+	Plan(ctx context.Context, mg resource.Managed, op v1alpha1.DryRunOperation) (Plan, error)
+}
+
+// resultSetter is implemented by managed resources that report dry-run results in their status.
+type resultSetter interface {
+	SetDryRun(r *v1alpha1.DryRunResult)
+}
+
+// NewConnector wraps c, so that the ExternalClients it connects do not change external
+// resources of managed resources with the dry-run annotation.
+func NewConnector(c managed.ExternalConnector) managed.ExternalConnector {
+	return &connector{connector: c}
+}
+
+type connector struct {
+	connector managed.ExternalConnector
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{external: ext}, nil
+}
+
+type external struct {
+	external managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.external.Observe(ctx, mg)
+	setter, reports := mg.(resultSetter)
+	if !Enabled(mg) {
+		if reports {
+			setter.SetDryRun(nil)
+		}
+		return o, err
+	}
+	if err != nil {
+		return o, err
+	}
+	// A deleted resource is released without deleting its external resource.
+	if meta.WasDeleted(mg) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if reports {
+		result, err := e.plan(ctx, mg, o)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errPlan)
+		}
+		setter.SetDryRun(result)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: o.ConnectionDetails,
+	}, nil
+}
+
+func (e *external) plan(ctx context.Context, mg resource.Managed, o managed.ExternalObservation) (*v1alpha1.DryRunResult, error) {
+	result := &v1alpha1.DryRunResult{Operation: v1alpha1.DryRunNone}
+	switch {
+	case !o.ResourceExists:
+		result.Operation = v1alpha1.DryRunCreate
+	case !o.ResourceUpToDate:
+		result.Operation = v1alpha1.DryRunUpdate
+	}
+	planner, ok := e.external.(Planner)
+	if !ok || result.Operation == v1alpha1.DryRunNone {
+		return result, nil
+	}
+
+	p, err := planner.Plan(ctx, mg, result.Operation)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := json.Marshal(p.Desired)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalPlan)
+	}
+	result.Payload = &runtime.RawExtension{Raw: desired}
+	result.Changes, err = Diff(p.Desired, p.Observed)
+	return result, err
+}
+
+// Create is not called for resources with the dry-run annotation, since Observe reports
+// them as existing; it is a no-op for them nevertheless.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if Enabled(mg) {
+		return managed.ExternalCreation{}, nil
+	}
+	return e.external.Create(ctx, mg)
+}
+
+// Update is a no-op for resources with the dry-run annotation.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if Enabled(mg) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return e.external.Update(ctx, mg)
+}
+
+// Delete is a no-op for resources with the dry-run annotation.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	if Enabled(mg) {
+		return managed.ExternalDelete{}, nil
+	}
+	return e.external.Delete(ctx, mg)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.external.Disconnect(ctx)
+}
+
+// Diff returns the fields of desired that differ from observed, after encoding both as JSON.
+// Nested objects and arrays are compared field by field, null fields are treated as unset.
+func Diff(desired, observed any) ([]v1alpha1.DryRunChange, error) {
+	want, err := flatten(desired)
+	if err != nil {
+		return nil, err
+	}
+	got := map[string]string{}
+	if observed != nil {
+		if got, err = flatten(observed); err != nil {
+			return nil, err
+		}
+	}
+
+	paths := make([]string, 0, len(want)+len(got))
+	for path := range want {
+		paths = append(paths, path)
+	}
+	for path := range got {
+		if _, ok := want[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var changes []v1alpha1.DryRunChange
+	for _, path := range paths {
+		if want[path] != got[path] {
+			changes = append(changes, v1alpha1.DryRunChange{Path: path, Observed: got[path], Desired: want[path]})
+		}
+	}
+	return changes, nil
+}
+
+func flatten(v any) (map[string]string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalPlan)
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalPlan)
+	}
+	fields := map[string]string{}
+	return fields, flattenInto(fields, "", generic)
+}
+
+func flattenInto(fields map[string]string, path string, v any) error {
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		if len(value) == 0 && path != "" {
+			fields[path] = "{}"
+		}
+		for key, field := range value {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			if err := flattenInto(fields, child, field); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if len(value) == 0 {
+			fields[path] = "[]"
+		}
+		for i, item := range value {
+			if err := flattenInto(fields, path+"["+strconv.Itoa(i)+"]", item); err != nil {
+				return err
+			}
+		}
+	case string:
+		fields[path] = value
+	default:
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return errors.Wrap(err, errMarshalPlan)
+		}
+		fields[path] = string(raw)
+	}
+	return nil
+}
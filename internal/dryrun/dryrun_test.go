@@ -0,0 +1,209 @@
+package dryrun
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+type payload struct {
+	DisplayName string            `json:"displayName"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Admins      []string          `json:"admins,omitempty"`
+	Beta        *bool             `json:"betaEnabled,omitempty"`
+}
+
+// planningClient is an ExternalClient that implements Planner.
+type planningClient struct {
+	managed.ExternalClientFns
+	plan Plan
+}
+
+func (c *planningClient) Plan(_ context.Context, _ resource.Managed, _ providerv1alpha1.DryRunOperation) (Plan, error) {
+	return c.plan, nil
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	beta := true
+
+	tests := map[string]struct {
+		annotations map[string]string
+		deleted     bool
+		observation managed.ExternalObservation
+		observeErr  error
+		plan        Plan
+		want        managed.ExternalObservation
+		wantErr     error
+		wantResult  *providerv1alpha1.DryRunResult
+	}{
+		"Disabled": {
+			observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			want:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		"ObserveError": {
+			annotations: map[string]string{Annotation: "true"},
+			observeErr:  errBoom,
+			wantErr:     errBoom,
+		},
+		"Create": {
+			annotations: map[string]string{Annotation: "true"},
+			observation: managed.ExternalObservation{ResourceExists: false},
+			plan:        Plan{Desired: payload{DisplayName: "dev", Admins: []string{"admin@example.com"}}},
+			want:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantResult: &providerv1alpha1.DryRunResult{
+				Operation: providerv1alpha1.DryRunCreate,
+				Changes: []providerv1alpha1.DryRunChange{
+					{Path: "admins[0]", Desired: "admin@example.com"},
+					{Path: "displayName", Desired: "dev"},
+				},
+			},
+		},
+		"Update": {
+			annotations: map[string]string{Annotation: "true"},
+			observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			plan: Plan{
+				Desired:  payload{DisplayName: "dev", Labels: map[string]string{"team": "a"}, Beta: &beta},
+				Observed: payload{DisplayName: "dev", Labels: map[string]string{"team": "b", "cost": "1"}},
+			},
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantResult: &providerv1alpha1.DryRunResult{
+				Operation: providerv1alpha1.DryRunUpdate,
+				Changes: []providerv1alpha1.DryRunChange{
+					{Path: "betaEnabled", Desired: "true"},
+					{Path: "labels.cost", Observed: "1"},
+					{Path: "labels.team", Observed: "b", Desired: "a"},
+				},
+			},
+		},
+		"UpToDate": {
+			annotations: map[string]string{Annotation: "true"},
+			observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantResult:  &providerv1alpha1.DryRunResult{Operation: providerv1alpha1.DryRunNone},
+		},
+		"Deleted": {
+			annotations: map[string]string{Annotation: "true"},
+			deleted:     true,
+			observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want:        managed.ExternalObservation{ResourceExists: false},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var mutated []string
+			inner := &planningClient{
+				ExternalClientFns: managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						return tc.observation, tc.observeErr
+					},
+					CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+						mutated = append(mutated, "Create")
+						return managed.ExternalCreation{}, nil
+					},
+					UpdateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+						mutated = append(mutated, "Update")
+						return managed.ExternalUpdate{}, nil
+					},
+					DeleteFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+						mutated = append(mutated, "Delete")
+						return managed.ExternalDelete{}, nil
+					},
+				},
+				plan: tc.plan,
+			}
+			connector := NewConnector(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				return inner, nil
+			}))
+
+			cr := &v1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "my-subaccount", Annotations: tc.annotations}}
+			// a stale result is removed or replaced
+			cr.Status.DryRun = &providerv1alpha1.DryRunResult{Operation: providerv1alpha1.DryRunUpdate}
+			if tc.deleted {
+				cr.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			}
+
+			ext, err := connector.Connect(context.Background(), cr)
+			require.NoError(t, err)
+			got, err := ext.Observe(context.Background(), cr)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+
+			switch {
+			case tc.deleted:
+			case tc.wantResult == nil:
+				assert.Nil(t, cr.Status.DryRun)
+			default:
+				require.NotNil(t, cr.Status.DryRun)
+				assert.Equal(t, tc.wantResult.Operation, cr.Status.DryRun.Operation)
+				assert.Equal(t, tc.wantResult.Changes, cr.Status.DryRun.Changes)
+				if tc.plan.Desired != nil {
+					require.NotNil(t, cr.Status.DryRun.Payload)
+					assert.Contains(t, string(cr.Status.DryRun.Payload.Raw), `"displayName":"dev"`)
+				}
+			}
+
+			_, _ = ext.Create(context.Background(), cr)
+			_, _ = ext.Update(context.Background(), cr)
+			_, _ = ext.Delete(context.Background(), cr)
+			if Enabled(cr) {
+				assert.Empty(t, mutated)
+			} else {
+				assert.Equal(t, []string{"Create", "Update", "Delete"}, mutated)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := map[string]struct {
+		desired  any
+		observed any
+		want     []providerv1alpha1.DryRunChange
+	}{
+		"Equal": {
+			desired:  map[string]any{"amount": 2, "enable": true},
+			observed: map[string]any{"amount": 2, "enable": true},
+		},
+		"NestedArrays": {
+			desired:  map[string]any{"plans": []any{map[string]any{"amount": 3}}},
+			observed: map[string]any{"plans": []any{map[string]any{"amount": 2}}},
+			want:     []providerv1alpha1.DryRunChange{{Path: "plans[0].amount", Observed: "2", Desired: "3"}},
+		},
+		"EmptyCollections": {
+			desired:  map[string]any{"labels": map[string]any{}, "admins": []any{}},
+			observed: map[string]any{"labels": map[string]any{"team": "a"}, "admins": []any{"a@example.com"}},
+			want: []providerv1alpha1.DryRunChange{
+				{Path: "admins", Desired: "[]"},
+				{Path: "admins[0]", Observed: "a@example.com"},
+				{Path: "labels", Desired: "{}"},
+				{Path: "labels.team", Observed: "a"},
+			},
+		},
+		"NullIsUnset": {
+			desired:  map[string]any{"description": nil},
+			observed: map[string]any{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Diff(tc.desired, tc.observed)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
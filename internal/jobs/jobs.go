@@ -0,0 +1,367 @@
+// Package jobs tracks the asynchronous BTP jobs the provider starts for managed resources.
+//
+// BTP creates, updates and deletes subaccounts, directories, entitlements and subscriptions
+// asynchronously and returns the ID of the job doing so in the Location header of the response.
+// ExternalClients record that job in the status of the managed resource with Start. The connector
+// of this package then polls the job through the Job Management API of the ExternalClient's service,
+// and reports its progress and failure reason in the Ready and Synced conditions and in events.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+	saas "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+)
+
+// Reasons of the events emitted for asynchronous BTP jobs.
+const (
+	ReasonJobStarted         event.Reason = "JobStarted"
+	ReasonJobCompleted       event.Reason = "JobCompleted"
+	ReasonJobFailed          event.Reason = "JobFailed"
+	ReasonCannotGetJobStatus event.Reason = "CannotGetJobStatus"
+	ReasonCannotSaveJob      event.Reason = "CannotSaveJob"
+)
+
+const (
+	errGetJobStatus    = "cannot get status of BTP job %s"
+	errDecodeJobStatus = "cannot decode status of BTP job"
+	errSaveJob         = "cannot save BTP job %s in status"
+)
+
+// Object is a managed resource that records the asynchronous BTP jobs started for it.
+type Object interface {
+	resource.Managed
+	GetJob() *v1alpha1.AsyncJob
+	SetJob(j *v1alpha1.AsyncJob)
+}
+
+// Status of an asynchronous BTP job.
+type Status struct {
+	State       v1alpha1.JobState
+	Description string
+	// Error is the reason the job failed.
+	Error string
+}
+
+// StatusReader is implemented by ExternalClients that can read the status of the jobs they start.
+type StatusReader interface {
+	JobStatus(ctx context.Context, id string) (Status, error)
+}
+
+// Start records the job with the given ID, which performs op on the external resource of mg,
+// as its last job. Nothing is recorded if id is empty, e.g. because BTP finished synchronously.
+func Start(mg Object, op v1alpha1.JobOperation, id string) {
+	if id == "" {
+		return
+	}
+	now := metav1.Now()
+	mg.SetJob(&v1alpha1.AsyncJob{
+		ID:         id,
+		Operation:  op,
+		State:      v1alpha1.JobInProgress,
+		Generation: mg.GetGeneration(),
+		StartTime:  &now,
+	})
+}
+
+// IDFromResponse returns the ID of the job in the Location header of resp, which
+// points to /jobs-management/v1/jobs/{jobInstanceIdOrUniqueId}/status.
+func IDFromResponse(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	location, err := resp.Location()
+	if err != nil {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(location.Path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == "jobs" {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// AccountsJobStatus reads the status of a job of the accounts service.
+func AccountsJobStatus(ctx context.Context, api accountclient.JobManagementAPI, id string) (Status, error) {
+	// the generated client expects a string, so the job is decoded from the response body
+	_, resp, err := api.GetStatus(ctx, id).Execute()
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		return errorStatus(resp, errors.Wrapf(err, errGetJobStatus, id))
+	}
+	var job struct {
+		Description   string         `json:"description"`
+		Status        string         `json:"status"`
+		StatusDetails map[string]any `json:"statusDetails"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+		return Status{}, errors.Wrap(err, errDecodeJobStatus)
+	}
+	return newStatus(job.Status, job.Description, job.StatusDetails), nil
+}
+
+// EntitlementsJobStatus reads the status of a job of the entitlements service.
+func EntitlementsJobStatus(ctx context.Context, api *entclient.JobManagementAPIService, id string) (Status, error) {
+	job, resp, err := api.GetStatus(ctx, id).Execute()
+	if err != nil {
+		return errorStatus(resp, errors.Wrapf(err, errGetJobStatus, id))
+	}
+	return newStatus(job.Status, job.Description, detailsOf(job.StatusDetails)), nil
+}
+
+// SaaSJobStatus reads the status of a job of the SaaS provisioning service.
+func SaaSJobStatus(ctx context.Context, api saas.JobManagementAPI, id string) (Status, error) {
+	job, resp, err := api.GetStatus(ctx, id).Execute()
+	if err != nil {
+		return errorStatus(resp, errors.Wrapf(err, errGetJobStatus, id))
+	}
+	return newStatus(job.Status, job.Description, detailsOf(job.StatusDetails)), nil
+}
+
+// errorStatus returns err, unless BTP does not know the job anymore.
+func errorStatus(resp *http.Response, err error) (Status, error) {
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return Status{State: v1alpha1.JobUnknown}, nil
+	}
+	return Status{}, err
+}
+
+func newStatus(state, description string, details map[string]any) Status {
+	s := Status{State: v1alpha1.JobState(state), Description: description}
+	switch s.State {
+	case v1alpha1.JobCompleted:
+	case v1alpha1.JobFailed:
+		s.Error = failureReason(details)
+		if s.Error == "" {
+			s.Error = description
+		}
+	default:
+		// PENDING and IN_PROGRESS, as well as states unknown to the provider, are still running
+		s.State = v1alpha1.JobInProgress
+	}
+	return s
+}
+
+func detailsOf(details map[string]map[string]any) map[string]any {
+	generic := make(map[string]any, len(details))
+	for key, value := range details {
+		generic[key] = value
+	}
+	return generic
+}
+
+// failureReason joins the messages in the status details of a failed job.
+func failureReason(details map[string]any) string {
+	var messages []string
+	var collect func(v any)
+	collect = func(v any) {
+		switch value := v.(type) {
+		case string:
+			if value != "" {
+				messages = append(messages, value)
+			}
+		case map[string]any:
+			for _, field := range value {
+				collect(field)
+			}
+		case []any:
+			for _, item := range value {
+				collect(item)
+			}
+		}
+	}
+	collect(details)
+	sort.Strings(messages)
+	unique := messages[:0]
+	for i, m := range messages {
+		if i == 0 || m != messages[i-1] {
+			unique = append(unique, m)
+		}
+	}
+	return strings.Join(unique, "; ")
+}
+
+// NewConnector wraps c, so that the jobs recorded by the ExternalClients it connects are
+// tracked through kube and reported through recorder.
+func NewConnector(c managed.ExternalConnector, kube client.Client, recorder event.Recorder) managed.ExternalConnector {
+	return &connector{connector: c, kube: kube, recorder: recorder}
+}
+
+type connector struct {
+	connector managed.ExternalConnector
+	kube      client.Client
+	recorder  event.Recorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{external: ext, kube: c.kube, recorder: c.recorder}, nil
+}
+
+type external struct {
+	external managed.ExternalClient
+	kube     client.Client
+	recorder event.Recorder
+}
+
+// Observe polls the last job of mg until it finished. While a job creating or updating
+// the external resource is in progress, the external resource is reported as existing and
+// up to date, so that the request is not sent again. A failed update is reported as error
+// until the spec of mg changes.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, ok := mg.(Object)
+	reader, reads := e.external.(StatusReader)
+	if !ok || !reads || o.GetJob() == nil {
+		return e.external.Observe(ctx, mg)
+	}
+
+	job := o.GetJob()
+	if !job.Finished() {
+		s, err := reader.JobStatus(ctx, job.ID)
+		if err != nil {
+			// fall back to the observation of the external resource, the job is polled again next time
+			e.recorder.Event(o, event.Warning(ReasonCannotGetJobStatus, err))
+			return e.external.Observe(ctx, mg)
+		}
+		e.refresh(o, job, s)
+	}
+
+	if meta.WasDeleted(mg) && job.Operation != v1alpha1.JobDelete {
+		return e.external.Observe(ctx, mg)
+	}
+	switch {
+	case job.State == v1alpha1.JobInProgress && job.Operation == v1alpha1.JobCreate:
+		o.SetConditions(xpv1.Creating().WithMessage(message(job)))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case job.State == v1alpha1.JobInProgress && job.Operation == v1alpha1.JobUpdate:
+		obs, err := e.external.Observe(ctx, mg)
+		if err != nil || !obs.ResourceExists {
+			return obs, err
+		}
+		ready := o.GetCondition(xpv1.TypeReady)
+		if ready.Reason == "" {
+			ready = xpv1.Available()
+		}
+		o.SetConditions(ready.WithMessage(message(job)))
+		obs.ResourceUpToDate = true
+		return obs, nil
+	case job.State == v1alpha1.JobInProgress && job.Operation == v1alpha1.JobDelete:
+		obs, err := e.external.Observe(ctx, mg)
+		if err == nil && obs.ResourceExists {
+			o.SetConditions(xpv1.Deleting().WithMessage(message(job)))
+		}
+		return obs, err
+	case job.State == v1alpha1.JobFailed && job.Generation == mg.GetGeneration() && job.Operation == v1alpha1.JobUpdate:
+		return managed.ExternalObservation{}, errors.New(message(job))
+	case job.State == v1alpha1.JobFailed && job.Generation == mg.GetGeneration() && job.Operation == v1alpha1.JobCreate:
+		obs, err := e.external.Observe(ctx, mg)
+		if err == nil && obs.ResourceExists {
+			o.SetConditions(xpv1.Unavailable().WithMessage(message(job)))
+		}
+		return obs, err
+	}
+	return e.external.Observe(ctx, mg)
+}
+
+// refresh records s as the status of job and emits an event if it finished.
+func (e *external) refresh(o Object, job *v1alpha1.AsyncJob, s Status) {
+	job.State, job.Description, job.Error = s.State, s.Description, s.Error
+	switch job.State {
+	case v1alpha1.JobCompleted:
+		e.recorder.Event(o, event.Normal(ReasonJobCompleted, message(job)))
+	case v1alpha1.JobFailed:
+		e.recorder.Event(o, event.Warning(ReasonJobFailed, errors.New(message(job))))
+	}
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	previous := jobOf(mg)
+	c, err := e.external.Create(ctx, mg)
+	e.started(ctx, mg, previous)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	previous := jobOf(mg)
+	u, err := e.external.Update(ctx, mg)
+	e.started(ctx, mg, previous)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	previous := jobOf(mg)
+	d, err := e.external.Delete(ctx, mg)
+	e.started(ctx, mg, previous)
+	return d, err
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.external.Disconnect(ctx)
+}
+
+// started emits an event if the ExternalClient started a new job for mg. Jobs creating the
+// external resource are saved right away, since the managed reconciler overwrites the status
+// when it saves the external name after Create.
+func (e *external) started(ctx context.Context, mg resource.Managed, previous *v1alpha1.AsyncJob) {
+	job := jobOf(mg)
+	if job == nil || job == previous {
+		return
+	}
+	e.recorder.Event(mg, event.Normal(ReasonJobStarted, message(job)))
+	if job.Operation != v1alpha1.JobCreate {
+		return
+	}
+	saved, ok := mg.DeepCopyObject().(client.Object)
+	if !ok {
+		return
+	}
+	if err := e.kube.Status().Update(ctx, saved); err != nil {
+		// Create succeeded, so failing it would lose the external name
+		e.recorder.Event(mg, event.Warning(ReasonCannotSaveJob, errors.Wrapf(err, errSaveJob, job.ID)))
+		return
+	}
+	mg.SetResourceVersion(saved.GetResourceVersion())
+}
+
+func jobOf(mg resource.Managed) *v1alpha1.AsyncJob {
+	if o, ok := mg.(Object); ok {
+		return o.GetJob()
+	}
+	return nil
+}
+
+// message describes job for conditions and events.
+func message(job *v1alpha1.AsyncJob) string {
+	verb := strings.ToLower(string(job.Operation))
+	switch job.State {
+	case v1alpha1.JobCompleted:
+		return fmt.Sprintf("BTP job %s to %s the resource completed", job.ID, verb)
+	case v1alpha1.JobFailed:
+		return fmt.Sprintf("BTP job %s to %s the resource failed: %s", job.ID, verb, job.Error)
+	}
+	if job.Description != "" {
+		return fmt.Sprintf("BTP job %s to %s the resource is in progress: %s", job.ID, verb, job.Description)
+	}
+	return fmt.Sprintf("BTP job %s to %s the resource is in progress", job.ID, verb)
+}
@@ -0,0 +1,248 @@
+package jobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+// trackingClient is an ExternalClient that implements StatusReader.
+type trackingClient struct {
+	managed.ExternalClientFns
+	status    Status
+	statusErr error
+}
+
+func (c *trackingClient) JobStatus(_ context.Context, _ string) (Status, error) {
+	return c.status, c.statusErr
+}
+
+type recordedEvents struct {
+	event.NopRecorder
+	reasons []event.Reason
+}
+
+func (r *recordedEvents) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}
+
+	tests := map[string]struct {
+		job        *providerv1alpha1.AsyncJob
+		generation int64
+		status     Status
+		statusErr  error
+		want       managed.ExternalObservation
+		wantErr    string
+		wantState  providerv1alpha1.JobState
+		wantReady  xpv1.ConditionReason
+		wantEvents []event.Reason
+	}{
+		"NoJob": {
+			want: exists,
+		},
+		"CreateInProgress": {
+			job:       &providerv1alpha1.AsyncJob{ID: "job-1", Operation: providerv1alpha1.JobCreate, State: providerv1alpha1.JobInProgress},
+			status:    Status{State: providerv1alpha1.JobInProgress, Description: "Creating subaccount"},
+			want:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantState: providerv1alpha1.JobInProgress,
+			wantReady: xpv1.ReasonCreating,
+		},
+		"UpdateInProgress": {
+			job:       &providerv1alpha1.AsyncJob{ID: "job-1", Operation: providerv1alpha1.JobUpdate, State: providerv1alpha1.JobInProgress},
+			status:    Status{State: providerv1alpha1.JobInProgress},
+			want:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantState: providerv1alpha1.JobInProgress,
+			wantReady: xpv1.ReasonAvailable,
+		},
+		"CreateCompleted": {
+			job:        &providerv1alpha1.AsyncJob{ID: "job-1", Operation: providerv1alpha1.JobCreate, State: providerv1alpha1.JobInProgress},
+			status:     Status{State: providerv1alpha1.JobCompleted},
+			want:       exists,
+			wantState:  providerv1alpha1.JobCompleted,
+			wantEvents: []event.Reason{ReasonJobCompleted},
+		},
+		"CreateFailed": {
+			job:        &providerv1alpha1.AsyncJob{ID: "job-1", Operation: providerv1alpha1.JobCreate, State: providerv1alpha1.JobInProgress},
+			status:     Status{State: providerv1alpha1.JobFailed, Error: "region not available"},
+			want:       exists,
+			wantState:  providerv1alpha1.JobFailed,
+			wantReady:  xpv1.ReasonUnavailable,
+			wantEvents: []event.Reason{ReasonJobFailed},
+		},
+		"UpdateFailed": {
+			job:        &providerv1alpha1.AsyncJob{ID: "job-1", Operation: providerv1alpha1.JobUpdate, State: providerv1alpha1.JobInProgress},
+			status:     Status{State: providerv1alpha1.JobFailed, Error: "quota exceeded"},
+			wantErr:    "BTP job job-1 to update the resource failed: quota exceeded",
+			wantState:  providerv1alpha1.JobFailed,
+			wantEvents: []event.Reason{ReasonJobFailed},
+		},
+		"UpdateFailedForPreviousGeneration": {
+			job:        &providerv1alpha1.AsyncJob{ID: "job-1", Operation: providerv1alpha1.JobUpdate, State: providerv1alpha1.JobFailed, Error: "quota exceeded"},
+			generation: 2,
+			want:       exists,
+			wantState:  providerv1alpha1.JobFailed,
+		},
+		"StatusUnavailable": {
+			job:        &providerv1alpha1.AsyncJob{ID: "job-1", Operation: providerv1alpha1.JobCreate, State: providerv1alpha1.JobInProgress},
+			statusErr:  errBoom,
+			want:       exists,
+			wantState:  providerv1alpha1.JobInProgress,
+			wantEvents: []event.Reason{ReasonCannotGetJobStatus},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inner := &trackingClient{
+				ExternalClientFns: managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						mg.SetConditions(xpv1.Available())
+						return exists, nil
+					},
+				},
+				status:    tc.status,
+				statusErr: tc.statusErr,
+			}
+			recorder := &recordedEvents{}
+			connector := NewConnector(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				return inner, nil
+			}), nil, recorder)
+
+			cr := &v1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "my-subaccount", Generation: tc.generation}}
+			cr.Status.Job = tc.job
+
+			ext, err := connector.Connect(context.Background(), cr)
+			require.NoError(t, err)
+			got, err := ext.Observe(context.Background(), cr)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			}
+			if tc.job != nil {
+				assert.Equal(t, tc.wantState, cr.Status.Job.State)
+			}
+			if tc.wantReady != "" {
+				ready := cr.GetCondition(xpv1.TypeReady)
+				assert.Equal(t, tc.wantReady, ready.Reason)
+				assert.Contains(t, ready.Message, "job-1")
+			}
+			assert.Equal(t, tc.wantEvents, recorder.reasons)
+		})
+	}
+}
+
+func TestCreateSavesJob(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	cr := &v1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "my-subaccount"}}
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr).WithStatusSubresource(cr).Build()
+
+	inner := &trackingClient{ExternalClientFns: managed.ExternalClientFns{
+		CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+			Start(mg.(Object), providerv1alpha1.JobCreate, "job-1")
+			return managed.ExternalCreation{}, nil
+		},
+	}}
+	recorder := &recordedEvents{}
+	connector := NewConnector(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		return inner, nil
+	}), kube, recorder)
+
+	ext, err := connector.Connect(context.Background(), cr)
+	require.NoError(t, err)
+	_, err = ext.Create(context.Background(), cr)
+	require.NoError(t, err)
+	assert.Equal(t, []event.Reason{ReasonJobStarted}, recorder.reasons)
+
+	saved := &v1alpha1.Subaccount{}
+	require.NoError(t, kube.Get(context.Background(), client.ObjectKeyFromObject(cr), saved))
+	require.NotNil(t, saved.Status.Job)
+	assert.Equal(t, "job-1", saved.Status.Job.ID)
+	assert.Equal(t, providerv1alpha1.JobInProgress, saved.Status.Job.State)
+	assert.Equal(t, saved.GetResourceVersion(), cr.GetResourceVersion())
+}
+
+func TestIDFromResponse(t *testing.T) {
+	tests := map[string]struct {
+		resp *http.Response
+		want string
+	}{
+		"NoResponse": {},
+		"NoLocation": {resp: &http.Response{Header: http.Header{}}},
+		"Location": {
+			resp: &http.Response{Header: http.Header{"Location": {"/jobs-management/v1/jobs/0bcd7fe6-5f5a-4ac5-b9a4-1a1b2f0f3f1c/status"}}},
+			want: "0bcd7fe6-5f5a-4ac5-b9a4-1a1b2f0f3f1c",
+		},
+		"OtherLocation": {resp: &http.Response{Header: http.Header{"Location": {"/accounts/v1/subaccounts/guid"}}}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IDFromResponse(tc.resp))
+		})
+	}
+}
+
+func TestAccountsJobStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/jobs-management/v1/jobs/failed/status":
+			_, _ = w.Write([]byte(`{"description":"Create subaccount","status":"FAILED","statusDetails":{"error":{"message":"Subdomain is already taken"},"code":"30004"}}`))
+		case "/jobs-management/v1/jobs/running/status":
+			_, _ = w.Write([]byte(`{"description":"Create subaccount","status":"PENDING"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	config := accountclient.NewConfiguration()
+	config.Servers = accountclient.ServerConfigurations{{URL: server.URL}}
+	api := accountclient.NewAPIClient(config).JobManagementAPI
+
+	tests := map[string]struct {
+		id   string
+		want Status
+	}{
+		"Failed": {
+			id:   "failed",
+			want: Status{State: providerv1alpha1.JobFailed, Description: "Create subaccount", Error: "30004; Subdomain is already taken"},
+		},
+		"Pending": {
+			id:   "running",
+			want: Status{State: providerv1alpha1.JobInProgress, Description: "Create subaccount"},
+		},
+		"Unknown": {
+			id:   "expired",
+			want: Status{State: providerv1alpha1.JobUnknown},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := AccountsJobStatus(context.Background(), api, tc.id)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
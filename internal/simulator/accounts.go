@@ -0,0 +1,523 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+)
+
+// States of the simulated accounts, environments and entitlements.
+const (
+	stateOK               = "OK"
+	stateCreating         = "CREATING"
+	stateUpdating         = "UPDATING"
+	stateMoving           = "MOVING"
+	stateDeleting         = "DELETING"
+	stateCreationFailed   = "CREATION_FAILED"
+	stateUpdateFailed     = "UPDATE_FAILED"
+	stateMoveFailed       = "MOVE_FAILED"
+	stateDeletionFailed   = "DELETION_FAILED"
+	stateProcessing       = "PROCESSING"
+	stateProcessingFailed = "PROCESSING_FAILED"
+
+	createdBy = "simulator"
+)
+
+func (s *Server) registerAccounts() {
+	s.mux.HandleFunc("GET /accounts/v1/globalAccount", s.getGlobalAccount)
+
+	s.mux.HandleFunc("GET /accounts/v1/subaccounts", s.listSubaccounts)
+	s.mux.HandleFunc("POST /accounts/v1/subaccounts", s.createSubaccount)
+	s.mux.HandleFunc("GET /accounts/v1/subaccounts/{guid}", s.getSubaccount)
+	s.mux.HandleFunc("PATCH /accounts/v1/subaccounts/{guid}", s.updateSubaccount)
+	s.mux.HandleFunc("DELETE /accounts/v1/subaccounts/{guid}", s.deleteSubaccount)
+	s.mux.HandleFunc("POST /accounts/v1/subaccounts/{guid}/move", s.moveSubaccount)
+	s.mux.HandleFunc("GET /accounts/v1/subaccounts/{guid}/settings", s.getSettings)
+	s.mux.HandleFunc("PUT /accounts/v1/subaccounts/{guid}/settings", s.setSettings)
+	s.mux.HandleFunc("DELETE /accounts/v1/subaccounts/{guid}/settings", s.deleteSettings)
+
+	s.mux.HandleFunc("POST /accounts/v1/directories", s.createDirectory)
+	s.mux.HandleFunc("GET /accounts/v1/directories/{guid}", s.getDirectory)
+	s.mux.HandleFunc("PATCH /accounts/v1/directories/{guid}", s.updateDirectory)
+	s.mux.HandleFunc("DELETE /accounts/v1/directories/{guid}", s.deleteDirectory)
+}
+
+func (s *Server) getGlobalAccount(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.globalAccount)
+}
+
+func (s *Server) listSubaccounts(w http.ResponseWriter, r *http.Request) {
+	directory := r.URL.Query().Get("directoryGUID")
+	var list []accountclient.SubaccountResponseObject
+	for _, sa := range s.subaccounts {
+		if directory == "" || sa.ParentGUID == directory {
+			list = append(list, *sa)
+		}
+	}
+	slices.SortFunc(list, func(a, b accountclient.SubaccountResponseObject) int {
+		return strings.Compare(a.Subdomain, b.Subdomain)
+	})
+	writeJSON(w, http.StatusOK, accountclient.ResponseCollection{Value: list})
+}
+
+func (s *Server) createSubaccount(w http.ResponseWriter, r *http.Request) {
+	var p accountclient.CreateSubaccountRequestPayload
+	properties, ok := decodeWithCustomProperties(w, r, &p)
+	if !ok {
+		return
+	}
+	subdomain := internal.Val(p.Subdomain)
+	if subdomain == "" {
+		subdomain = strings.ToLower(p.DisplayName)
+	}
+	for _, sa := range s.subaccounts {
+		if sa.Subdomain == subdomain && sa.Region == p.Region {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("subdomain %s is already taken in region %s", subdomain, p.Region))
+			return
+		}
+	}
+	parent := internal.Val(p.ParentGUID)
+	if parent == "" {
+		parent = s.globalAccount.Guid
+	} else if !s.isAccount(parent) {
+		writeError(w, r, http.StatusBadRequest, fmt.Sprintf(errNotFound, "parent", parent))
+		return
+	}
+
+	guid := uuid.NewString()
+	sa := &accountclient.SubaccountResponseObject{
+		BetaEnabled:       internal.Val(p.BetaEnabled),
+		CreatedBy:         internal.Ptr(createdBy),
+		CreatedDate:       s.millis(),
+		Description:       internal.Val(p.Description),
+		DisplayName:       p.DisplayName,
+		GlobalAccountGUID: s.globalAccount.Guid,
+		Guid:              guid,
+		Labels:            p.Labels,
+		ParentGUID:        parent,
+		Region:            p.Region,
+		State:             stateCreating,
+		StateMessage:      internal.Ptr("Subaccount is being created"),
+		Subdomain:         subdomain,
+		TechnicalName:     guid,
+		UsedForProduction: withDefault(internal.Val(p.UsedForProduction), "UNSET"),
+	}
+	setCustomProperties(sa, properties)
+	s.subaccounts[guid] = sa
+	j := s.startJob(r, "Create subaccount "+p.DisplayName, "", func(failure string) {
+		if failure != "" {
+			sa.State, sa.StateMessage = stateCreationFailed, internal.Ptr(failure)
+			return
+		}
+		sa.State, sa.StateMessage = stateOK, internal.Ptr("Subaccount created")
+	})
+	accepted(w, j, http.StatusCreated, sa)
+}
+
+func (s *Server) getSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, ok := s.subaccount(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, sa)
+}
+
+func (s *Server) updateSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, ok := s.subaccount(w, r)
+	if !ok {
+		return
+	}
+	var p accountclient.UpdateSubaccountRequestPayload
+	properties, ok := decodeWithCustomProperties(w, r, &p)
+	if !ok {
+		return
+	}
+	sa.State, sa.StateMessage = stateUpdating, internal.Ptr("Subaccount is being updated")
+	j := s.startJob(r, "Update subaccount "+sa.DisplayName, "", func(failure string) {
+		if failure != "" {
+			sa.State, sa.StateMessage = stateUpdateFailed, internal.Ptr(failure)
+			return
+		}
+		sa.DisplayName = p.DisplayName
+		if p.BetaEnabled != nil {
+			sa.BetaEnabled = *p.BetaEnabled
+		}
+		if p.Description != nil {
+			sa.Description = *p.Description
+		}
+		if p.Labels != nil {
+			sa.Labels = p.Labels
+		}
+		setCustomProperties(sa, properties)
+		if p.UsedForProduction != nil {
+			sa.UsedForProduction = *p.UsedForProduction
+		}
+		sa.ModifiedDate = internal.Ptr(s.millis())
+		sa.State, sa.StateMessage = stateOK, internal.Ptr("Subaccount updated")
+	})
+	accepted(w, j, http.StatusOK, sa)
+}
+
+func (s *Server) moveSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, ok := s.subaccount(w, r)
+	if !ok {
+		return
+	}
+	var p accountclient.MoveSubaccountRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	if !s.isAccount(p.TargetAccountGUID) {
+		writeError(w, r, http.StatusBadRequest, fmt.Sprintf(errNotFound, "target account", p.TargetAccountGUID))
+		return
+	}
+	sa.State, sa.StateMessage = stateMoving, internal.Ptr("Subaccount is being moved")
+	j := s.startJob(r, "Move subaccount "+sa.DisplayName, "", func(failure string) {
+		if failure != "" {
+			sa.State, sa.StateMessage = stateMoveFailed, internal.Ptr(failure)
+			return
+		}
+		sa.ParentGUID = p.TargetAccountGUID
+		sa.State, sa.StateMessage = stateOK, internal.Ptr("Subaccount moved")
+	})
+	accepted(w, j, http.StatusOK, sa)
+}
+
+func (s *Server) deleteSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, ok := s.subaccount(w, r)
+	if !ok {
+		return
+	}
+	sa.State, sa.StateMessage = stateDeleting, internal.Ptr("Subaccount is being deleted")
+	j := s.startJob(r, "Delete subaccount "+sa.DisplayName, "", func(failure string) {
+		if failure != "" {
+			sa.State, sa.StateMessage = stateDeletionFailed, internal.Ptr(failure)
+			return
+		}
+		s.removeSubaccount(sa.Guid)
+	})
+	accepted(w, j, http.StatusOK, sa)
+}
+
+// removeSubaccount removes the subaccount with guid and everything that exists in it.
+func (s *Server) removeSubaccount(guid string) {
+	delete(s.subaccounts, guid)
+	delete(s.settings, guid)
+	for k := range s.assignments {
+		if k.subaccount == guid {
+			delete(s.assignments, k)
+		}
+	}
+	for id, env := range s.environments {
+		if internal.Val(env.SubaccountGUID) == guid {
+			delete(s.environments, id)
+		}
+	}
+	for k := range s.subscriptions {
+		if k.scope == guid {
+			delete(s.subscriptions, k)
+		}
+	}
+	for id, si := range s.instances {
+		if (*si.Context)[contextSubaccount] == guid {
+			delete(s.instances, id)
+		}
+	}
+	for id, sb := range s.bindings {
+		if (*sb.Context)[contextSubaccount] == guid {
+			delete(s.bindings, id)
+		}
+	}
+	for k := range s.roleCollections {
+		if k.scope == guid {
+			delete(s.roleCollections, k)
+		}
+	}
+	for k := range s.users {
+		if k.scope == guid {
+			delete(s.users, k)
+		}
+	}
+}
+
+func (s *Server) subaccount(w http.ResponseWriter, r *http.Request) (*accountclient.SubaccountResponseObject, bool) {
+	guid := r.PathValue("guid")
+	sa, ok := s.subaccounts[guid]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "subaccount", guid))
+	}
+	return sa, ok
+}
+
+// customProperty is a custom property in subaccount requests.
+type customProperty struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Delete bool   `json:"delete"`
+}
+
+// decodeWithCustomProperties decodes a subaccount request into v and returns its custom
+// properties separately, since the generated payloads type their values as objects.
+func decodeWithCustomProperties(w http.ResponseWriter, r *http.Request, v any) ([]customProperty, bool) {
+	var raw map[string]json.RawMessage
+	if !decode(w, r, &raw) {
+		return nil, false
+	}
+	var properties []customProperty
+	if p, ok := raw["customProperties"]; ok {
+		if err := json.Unmarshal(p, &properties); err != nil {
+			writeError(w, r, http.StatusBadRequest, errInvalidBody+": "+err.Error())
+			return nil, false
+		}
+		delete(raw, "customProperties")
+	}
+	body, err := json.Marshal(raw)
+	if err == nil {
+		err = json.Unmarshal(body, v)
+	}
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, errInvalidBody+": "+err.Error())
+		return nil, false
+	}
+	return properties, true
+}
+
+// setCustomProperties applies the custom properties to the labels of the subaccount, which
+// BTP keeps custom properties in, and lists its labels as custom properties.
+func setCustomProperties(sa *accountclient.SubaccountResponseObject, properties []customProperty) {
+	labels := maps.Clone(internal.Val(sa.Labels))
+	if labels == nil {
+		labels = map[string][]string{}
+	}
+	for _, p := range properties {
+		if p.Delete {
+			delete(labels, p.Key)
+		} else {
+			labels[p.Key] = []string{p.Value}
+		}
+	}
+	if len(labels) > 0 || sa.Labels != nil {
+		sa.Labels = &labels
+	}
+	sa.CustomProperties = nil
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		for _, v := range labels[k] {
+			sa.CustomProperties = append(sa.CustomProperties, accountclient.PropertyResponseObject{AccountGUID: sa.Guid, Key: k, Value: v})
+		}
+	}
+}
+
+// setting is a setting of a subaccount in settings requests and responses. BTP keeps the values
+// of settings as strings, although the generated models type them as objects.
+type setting struct {
+	EntityType string `json:"entityType,omitempty"`
+	Key        string `json:"key"`
+	Value      string `json:"value"`
+}
+
+func (s *Server) getSettings(w http.ResponseWriter, r *http.Request) {
+	sa, ok := s.subaccount(w, r)
+	if !ok {
+		return
+	}
+	keys := r.URL.Query()["keys"]
+	if len(keys) == 0 {
+		keys = slices.Collect(maps.Keys(s.settings[sa.Guid]))
+	}
+	writeSettings(w, s.settings[sa.Guid], keys)
+}
+
+func (s *Server) setSettings(w http.ResponseWriter, r *http.Request) {
+	sa, ok := s.subaccount(w, r)
+	if !ok {
+		return
+	}
+	var p struct {
+		EntitySettings []setting `json:"entitySettings"`
+	}
+	if !decode(w, r, &p) {
+		return
+	}
+	if len(p.EntitySettings) == 0 {
+		writeError(w, r, http.StatusBadRequest, "entitySettings must not be empty")
+		return
+	}
+	settings := s.settings[sa.Guid]
+	if settings == nil {
+		settings = map[string]string{}
+		s.settings[sa.Guid] = settings
+	}
+	var keys []string
+	for _, e := range p.EntitySettings {
+		settings[e.Key] = e.Value
+		keys = append(keys, e.Key)
+	}
+	writeSettings(w, settings, keys)
+}
+
+func (s *Server) deleteSettings(w http.ResponseWriter, r *http.Request) {
+	sa, ok := s.subaccount(w, r)
+	if !ok {
+		return
+	}
+	keys := r.URL.Query()["keys"]
+	if len(keys) == 0 {
+		writeError(w, r, http.StatusBadRequest, "keys must be specified")
+		return
+	}
+	settings := s.settings[sa.Guid]
+	deleted := map[string]string{}
+	for _, k := range keys {
+		if v, ok := settings[k]; ok {
+			deleted[k] = v
+			delete(settings, k)
+		}
+	}
+	writeSettings(w, deleted, keys)
+}
+
+// writeSettings responds with the settings with keys, sorted by key.
+func writeSettings(w http.ResponseWriter, settings map[string]string, keys []string) {
+	values := []setting{}
+	for _, k := range slices.Sorted(slices.Values(keys)) {
+		if v, ok := settings[k]; ok {
+			values = append(values, setting{EntityType: "SUBACCOUNT", Key: k, Value: v})
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"values": values})
+}
+
+// isAccount returns whether guid is the global account or one of its directories.
+func (s *Server) isAccount(guid string) bool {
+	_, ok := s.directories[guid]
+	return ok || guid == s.globalAccount.Guid
+}
+
+func (s *Server) createDirectory(w http.ResponseWriter, r *http.Request) {
+	var p accountclient.CreateDirectoryRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	parent := withDefault(r.URL.Query().Get("parentGUID"), s.globalAccount.Guid)
+	if !s.isAccount(parent) {
+		writeError(w, r, http.StatusBadRequest, fmt.Sprintf(errNotFound, "parent", parent))
+		return
+	}
+	features := p.DirectoryFeatures
+	if len(features) == 0 {
+		features = []string{"DEFAULT"}
+	}
+	dir := &accountclient.DirectoryResponseObject{
+		CreatedBy:         internal.Ptr(createdBy),
+		CreatedDate:       s.millis(),
+		Description:       p.Description,
+		DirectoryFeatures: features,
+		DisplayName:       p.DisplayName,
+		EntityState:       internal.Ptr(stateCreating),
+		GlobalAccountGUID: s.globalAccount.Guid,
+		Guid:              uuid.NewString(),
+		Labels:            p.Labels,
+		ParentGUID:        parent,
+		StateMessage:      internal.Ptr("Directory is being created"),
+		Subdomain:         p.Subdomain,
+	}
+	s.directories[dir.Guid] = dir
+	j := s.startJob(r, "Create directory "+p.DisplayName, "", func(failure string) {
+		if failure != "" {
+			dir.EntityState, dir.StateMessage = internal.Ptr(stateCreationFailed), internal.Ptr(failure)
+			return
+		}
+		dir.EntityState, dir.StateMessage = internal.Ptr(stateOK), internal.Ptr("Directory created")
+	})
+	accepted(w, j, http.StatusCreated, dir)
+}
+
+func (s *Server) getDirectory(w http.ResponseWriter, r *http.Request) {
+	dir, ok := s.directory(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, dir)
+}
+
+func (s *Server) updateDirectory(w http.ResponseWriter, r *http.Request) {
+	dir, ok := s.directory(w, r)
+	if !ok {
+		return
+	}
+	var p accountclient.UpdateDirectoryRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	dir.EntityState, dir.StateMessage = internal.Ptr(stateUpdating), internal.Ptr("Directory is being updated")
+	j := s.startJob(r, "Update directory "+dir.DisplayName, "", func(failure string) {
+		if failure != "" {
+			dir.EntityState, dir.StateMessage = internal.Ptr(stateUpdateFailed), internal.Ptr(failure)
+			return
+		}
+		if p.DisplayName != nil {
+			dir.DisplayName = *p.DisplayName
+		}
+		if p.Description != nil {
+			dir.Description = p.Description
+		}
+		if p.Labels != nil {
+			dir.Labels = p.Labels
+		}
+		dir.ModifiedDate = internal.Ptr(s.millis())
+		dir.EntityState, dir.StateMessage = internal.Ptr(stateOK), internal.Ptr("Directory updated")
+	})
+	accepted(w, j, http.StatusOK, dir)
+}
+
+func (s *Server) deleteDirectory(w http.ResponseWriter, r *http.Request) {
+	dir, ok := s.directory(w, r)
+	if !ok {
+		return
+	}
+	for _, sa := range s.subaccounts {
+		if sa.ParentGUID == dir.Guid {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("directory %s is not empty", dir.Guid))
+			return
+		}
+	}
+	for _, child := range s.directories {
+		if child.ParentGUID == dir.Guid {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("directory %s is not empty", dir.Guid))
+			return
+		}
+	}
+	dir.EntityState, dir.StateMessage = internal.Ptr(stateDeleting), internal.Ptr("Directory is being deleted")
+	j := s.startJob(r, "Delete directory "+dir.DisplayName, "", func(failure string) {
+		if failure != "" {
+			dir.EntityState, dir.StateMessage = internal.Ptr(stateDeletionFailed), internal.Ptr(failure)
+			return
+		}
+		delete(s.directories, dir.Guid)
+	})
+	accepted(w, j, http.StatusOK, dir)
+}
+
+func (s *Server) directory(w http.ResponseWriter, r *http.Request) (*accountclient.DirectoryResponseObject, bool) {
+	guid := r.PathValue("guid")
+	dir, ok := s.directories[guid]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "directory", guid))
+	}
+	return dir, ok
+}
+
+func withDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,196 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+)
+
+const errQuotaExceeded = "quota of service plan %s of service %s exceeded: %v requested, %v of %v remaining"
+
+// assignmentKey identifies the assignment of a service plan to a subaccount.
+type assignmentKey struct {
+	subaccount string
+	service    string
+	plan       string
+}
+
+func (s *Server) registerEntitlements() {
+	s.mux.HandleFunc("GET /entitlements/v1/assignments", s.getAssignments)
+	s.mux.HandleFunc("PUT /entitlements/v1/subaccountServicePlans", s.setServicePlans)
+}
+
+// entitlement returns the entitlement of the global account for the plan of service.
+func (s *Server) entitlement(service, plan string) (Entitlement, bool) {
+	for _, e := range s.opts.Entitlements {
+		if e.Service == service && e.Plan == plan {
+			return e, true
+		}
+	}
+	return Entitlement{}, false
+}
+
+// assigned returns the amount of the plan of service assigned to subaccounts other than subaccount.
+func (s *Server) assigned(service, plan, subaccount string) float32 {
+	var sum float32
+	for k, a := range s.assignments {
+		if k.service == service && k.plan == plan && k.subaccount != subaccount {
+			sum += internal.Val(a.Amount)
+		}
+	}
+	return sum
+}
+
+func (s *Server) getAssignments(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	subaccount, service, plan := q.Get("subaccountGUID"), q.Get("serviceName"), q.Get("planName")
+	matches := func(e Entitlement) bool {
+		return (service == "" || e.Service == service) && (plan == "" || e.Plan == plan)
+	}
+
+	var resp entclient.EntitledAndAssignedServicesResponseObject
+	for _, e := range s.opts.Entitlements {
+		if !matches(e) {
+			continue
+		}
+		entitled := entclient.ServicePlanResponseObject{
+			Name:             internal.Ptr(e.Plan),
+			DisplayName:      internal.Ptr(e.Plan),
+			UniqueIdentifier: internal.Ptr(e.Service + "-" + e.Plan),
+			Category:         internal.Ptr("SERVICE"),
+			Unlimited:        internal.Ptr(e.Quota == 0),
+		}
+		if e.Quota > 0 {
+			entitled.Amount = internal.Ptr(e.Quota)
+			entitled.RemainingAmount = internal.Ptr(e.Quota - s.assigned(e.Service, e.Plan, ""))
+		}
+		svc := entitledService(&resp, e.Service)
+		svc.ServicePlans = append(svc.ServicePlans, entitled)
+
+		var infos []entclient.AssignedServicePlanSubaccountDTO
+		for k, a := range s.assignments {
+			if k.service == e.Service && k.plan == e.Plan && (subaccount == "" || k.subaccount == subaccount) {
+				infos = append(infos, *a)
+			}
+		}
+		if len(infos) == 0 {
+			continue
+		}
+		slices.SortFunc(infos, func(a, b entclient.AssignedServicePlanSubaccountDTO) int {
+			return strings.Compare(internal.Val(a.EntityId), internal.Val(b.EntityId))
+		})
+		assigned := assignedService(&resp, e.Service)
+		assigned.ServicePlans = append(assigned.ServicePlans, entclient.AssignedServicePlanResponseObject{
+			Name:             entitled.Name,
+			DisplayName:      entitled.DisplayName,
+			UniqueIdentifier: entitled.UniqueIdentifier,
+			Category:         entitled.Category,
+			Unlimited:        entitled.Unlimited,
+			AssignmentInfo:   infos,
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func entitledService(resp *entclient.EntitledAndAssignedServicesResponseObject, name string) *entclient.EntitledServicesResponseObject {
+	for i := range resp.EntitledServices {
+		if internal.Val(resp.EntitledServices[i].Name) == name {
+			return &resp.EntitledServices[i]
+		}
+	}
+	resp.EntitledServices = append(resp.EntitledServices, entclient.EntitledServicesResponseObject{Name: internal.Ptr(name), DisplayName: internal.Ptr(name)})
+	return &resp.EntitledServices[len(resp.EntitledServices)-1]
+}
+
+func assignedService(resp *entclient.EntitledAndAssignedServicesResponseObject, name string) *entclient.AssignedServiceResponseObject {
+	for i := range resp.AssignedServices {
+		if internal.Val(resp.AssignedServices[i].Name) == name {
+			return &resp.AssignedServices[i]
+		}
+	}
+	resp.AssignedServices = append(resp.AssignedServices, entclient.AssignedServiceResponseObject{Name: internal.Ptr(name), DisplayName: internal.Ptr(name)})
+	return &resp.AssignedServices[len(resp.AssignedServices)-1]
+}
+
+// setServicePlans assigns the requested amounts of service plans to subaccounts, or enables
+// them. Assignments that exceed the quota of the global account fail in the job.
+func (s *Server) setServicePlans(w http.ResponseWriter, r *http.Request) {
+	var p entclient.SubaccountServicePlansRequestPayloadCollection
+	if !decode(w, r, &p) {
+		return
+	}
+	type change struct {
+		key    assignmentKey
+		amount float32
+	}
+	var changes []change
+	var failure string
+	for _, plan := range p.SubaccountServicePlans {
+		e, ok := s.entitlement(plan.ServiceName, plan.ServicePlanName)
+		if !ok {
+			writeError(w, r, http.StatusBadRequest, fmt.Sprintf("service plan %s of service %s is not entitled to the global account", plan.ServicePlanName, plan.ServiceName))
+			return
+		}
+		for _, info := range plan.AssignmentInfo {
+			if _, ok := s.subaccounts[info.SubaccountGUID]; !ok {
+				writeError(w, r, http.StatusBadRequest, fmt.Sprintf(errNotFound, "subaccount", info.SubaccountGUID))
+				return
+			}
+			key := assignmentKey{subaccount: info.SubaccountGUID, service: e.Service, plan: e.Plan}
+			amount := internal.Val(info.Amount)
+			if e.Quota == 0 {
+				amount = 0
+				if internal.Val(info.Enable) {
+					amount = 1
+				}
+			} else if remaining := e.Quota - s.assigned(e.Service, e.Plan, key.subaccount); amount > remaining && failure == "" {
+				failure = fmt.Sprintf(errQuotaExceeded, e.Plan, e.Service, amount, remaining, e.Quota)
+			}
+			changes = append(changes, change{key: key, amount: amount})
+		}
+	}
+
+	for _, c := range changes {
+		a, ok := s.assignments[c.key]
+		if !ok {
+			a = &entclient.AssignedServicePlanSubaccountDTO{
+				Amount:       internal.Ptr(float32(0)),
+				CreatedDate:  internal.Ptr(float64(s.millis())),
+				EntityId:     internal.Ptr(c.key.subaccount),
+				EntityType:   internal.Ptr("SUBACCOUNT"),
+				ParentId:     internal.Ptr(s.subaccounts[c.key.subaccount].ParentGUID),
+				AutoAssign:   internal.Ptr(false),
+				AutoAssigned: internal.Ptr(false),
+			}
+			s.assignments[c.key] = a
+		}
+		a.RequestedAmount = internal.Ptr(c.amount)
+		a.EntityState, a.StateMessage = internal.Ptr(stateProcessing), internal.Ptr("Assignment is being processed")
+	}
+	j := s.startJob(r, "Assign service plans", failure, func(failure string) {
+		for _, c := range changes {
+			a, ok := s.assignments[c.key]
+			if !ok {
+				continue
+			}
+			if failure != "" {
+				a.EntityState, a.StateMessage = internal.Ptr(stateProcessingFailed), internal.Ptr(failure)
+				continue
+			}
+			if c.amount == 0 {
+				delete(s.assignments, c.key)
+				continue
+			}
+			a.Amount = internal.Ptr(c.amount)
+			a.ModifiedDate = internal.Ptr(float64(s.millis()))
+			a.EntityState, a.StateMessage = internal.Ptr(stateOK), internal.Ptr("Assignment processed")
+		}
+	})
+	w.Header().Set("Location", jobLocation(j))
+	w.WriteHeader(http.StatusAccepted)
+	_, _ = w.Write([]byte(j.id))
+}
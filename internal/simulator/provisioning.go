@@ -0,0 +1,187 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+const (
+	environmentCloudFoundry = "cloudfoundry"
+	environmentKyma         = "kyma"
+)
+
+func (s *Server) registerProvisioning() {
+	s.mux.HandleFunc("GET /provisioning/v1/environments", s.listEnvironments)
+	s.mux.HandleFunc("POST /provisioning/v1/environments", s.createEnvironment)
+	s.mux.HandleFunc("GET /provisioning/v1/environments/{id}", s.getEnvironment)
+	s.mux.HandleFunc("PATCH /provisioning/v1/environments/{id}", s.updateEnvironment)
+	s.mux.HandleFunc("DELETE /provisioning/v1/environments/{id}", s.deleteEnvironment)
+}
+
+func (s *Server) listEnvironments(w http.ResponseWriter, r *http.Request) {
+	scope := s.scope(r)
+	var list []provisioningclient.BusinessEnvironmentInstanceResponseObject
+	for _, env := range s.environments {
+		if internal.Val(env.SubaccountGUID) == scope {
+			list = append(list, *env)
+		}
+	}
+	slices.SortFunc(list, func(a, b provisioningclient.BusinessEnvironmentInstanceResponseObject) int {
+		return strings.Compare(internal.Val(a.Id), internal.Val(b.Id))
+	})
+	writeJSON(w, http.StatusOK, provisioningclient.BusinessEnvironmentInstancesResponseCollection{EnvironmentInstances: list})
+}
+
+// createEnvironment creates an environment instance in the subaccount of the client. If the plan of
+// the environment is entitled to the global account, it must be assigned to the subaccount.
+func (s *Server) createEnvironment(w http.ResponseWriter, r *http.Request) {
+	var p provisioningclient.CreateEnvironmentInstanceRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	scope := s.scope(r)
+	if _, ok := s.entitlement(p.ServiceName, p.PlanName); ok {
+		if a, ok := s.assignments[assignmentKey{subaccount: scope, service: p.ServiceName, plan: p.PlanName}]; !ok || internal.Val(a.Amount) == 0 {
+			writeError(w, r, http.StatusBadRequest, fmt.Sprintf("service plan %s of service %s is not assigned to the subaccount", p.PlanName, p.ServiceName))
+			return
+		}
+	}
+	name := internal.Val(p.Name)
+	for _, env := range s.environments {
+		if internal.Val(env.SubaccountGUID) == scope && internal.Val(env.EnvironmentType) == p.EnvironmentType && name != "" && internal.Val(env.Name) == name {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("environment instance %s already exists", name))
+			return
+		}
+	}
+	parameters, err := json.Marshal(p.Parameters)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, errInvalidBody)
+		return
+	}
+
+	id := uuid.NewString()
+	env := &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+		CreatedBy:         internal.Ptr(createdBy),
+		CreatedDate:       internal.Ptr(float32(s.millis())),
+		Description:       p.Description,
+		EnvironmentType:   internal.Ptr(p.EnvironmentType),
+		GlobalAccountGUID: internal.Ptr(s.globalAccount.Guid),
+		Id:                internal.Ptr(id),
+		LandscapeLabel:    p.LandscapeLabel,
+		Name:              p.Name,
+		Operation:         internal.Ptr("provision"),
+		Parameters:        internal.Ptr(string(parameters)),
+		PlanName:          internal.Ptr(p.PlanName),
+		ServiceName:       internal.Ptr(p.ServiceName),
+		State:             internal.Ptr(stateCreating),
+		StateMessage:      internal.Ptr("Environment instance is being created"),
+		SubaccountGUID:    internal.Ptr(scope),
+		TenantId:          internal.Ptr(id),
+		Type:              internal.Ptr("Provision"),
+	}
+	s.environments[id] = env
+	j := s.startJob(r, "Create environment instance", "", func(failure string) {
+		if failure != "" {
+			env.State, env.StateMessage = internal.Ptr(stateCreationFailed), internal.Ptr(failure)
+			return
+		}
+		env.Labels = internal.Ptr(environmentLabels(env, p.Parameters))
+		env.State, env.StateMessage = internal.Ptr(stateOK), internal.Ptr("Environment instance created")
+	})
+	accepted(w, j, http.StatusAccepted, provisioningclient.CreatedEnvironmentInstanceResponseObject{Id: env.Id})
+}
+
+// environmentLabels returns the labels of a created environment instance, which describe how to access it.
+func environmentLabels(env *provisioningclient.BusinessEnvironmentInstanceResponseObject, parameters map[string]any) string {
+	landscape := internal.Default(env.LandscapeLabel, "cf-eu10")
+	var labels map[string]string
+	switch internal.Val(env.EnvironmentType) {
+	case environmentCloudFoundry:
+		labels = map[string]string{
+			"Org Name":     fmt.Sprint(parameters["instance_name"]),
+			"Org ID":       uuid.NewString(),
+			"API Endpoint": "https://api." + strings.TrimPrefix(landscape, "cf-") + ".simulator.local",
+		}
+	case environmentKyma:
+		labels = map[string]string{
+			"Name":          fmt.Sprint(parameters["name"]),
+			"APIServerURL":  "https://api." + internal.Val(env.Id) + ".kyma.simulator.local",
+			"KubeconfigURL": "https://kyma.simulator.local/kubeconfig/" + internal.Val(env.Id),
+		}
+	}
+	raw, _ := json.Marshal(labels)
+	return string(raw)
+}
+
+func (s *Server) getEnvironment(w http.ResponseWriter, r *http.Request) {
+	env, ok := s.environment(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, env)
+}
+
+func (s *Server) updateEnvironment(w http.ResponseWriter, r *http.Request) {
+	env, ok := s.environment(w, r)
+	if !ok {
+		return
+	}
+	var p provisioningclient.UpdateEnvironmentInstanceRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	parameters, err := json.Marshal(p.Parameters)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, errInvalidBody)
+		return
+	}
+	env.State, env.StateMessage, env.Operation = internal.Ptr(stateUpdating), internal.Ptr("Environment instance is being updated"), internal.Ptr("update")
+	j := s.startJob(r, "Update environment instance", "", func(failure string) {
+		if failure != "" {
+			env.State, env.StateMessage = internal.Ptr(stateUpdateFailed), internal.Ptr(failure)
+			return
+		}
+		env.PlanName = internal.Ptr(p.PlanName)
+		if p.Parameters != nil {
+			env.Parameters = internal.Ptr(string(parameters))
+		}
+		env.ModifiedDate = internal.Ptr(float32(s.millis()))
+		env.State, env.StateMessage = internal.Ptr(stateOK), internal.Ptr("Environment instance updated")
+	})
+	accepted(w, j, http.StatusAccepted, map[string]any{})
+}
+
+func (s *Server) deleteEnvironment(w http.ResponseWriter, r *http.Request) {
+	env, ok := s.environment(w, r)
+	if !ok {
+		return
+	}
+	env.State, env.StateMessage, env.Operation = internal.Ptr(stateDeleting), internal.Ptr("Environment instance is being deleted"), internal.Ptr("deprovision")
+	j := s.startJob(r, "Delete environment instance", "", func(failure string) {
+		if failure != "" {
+			env.State, env.StateMessage = internal.Ptr(stateDeletionFailed), internal.Ptr(failure)
+			return
+		}
+		delete(s.environments, internal.Val(env.Id))
+	})
+	accepted(w, j, http.StatusAccepted, env)
+}
+
+// environment returns the environment instance of the path of r, if it exists in the subaccount of the client.
+func (s *Server) environment(w http.ResponseWriter, r *http.Request) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool) {
+	id := r.PathValue("id")
+	env, ok := s.environments[id]
+	if !ok || internal.Val(env.SubaccountGUID) != s.scope(r) {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "environment instance", id))
+		return nil, false
+	}
+	return env, true
+}
@@ -0,0 +1,149 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	saas "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+)
+
+// States of the simulated subscriptions.
+const (
+	subscriptionSubscribed        = "SUBSCRIBED"
+	subscriptionNotSubscribed     = "NOT_SUBSCRIBED"
+	subscriptionInProcess         = "IN_PROCESS"
+	subscriptionSubscribeFailed   = "SUBSCRIBE_FAILED"
+	subscriptionUnsubscribeFailed = "UNSUBSCRIBE_FAILED"
+)
+
+func (s *Server) registerSaaS() {
+	s.mux.HandleFunc("GET /saas-manager/v1/applications/{appName}", s.getApplication)
+	s.mux.HandleFunc("POST /saas-manager/v1/applications/{appName}/subscription", s.subscribe)
+	s.mux.HandleFunc("PATCH /saas-manager/v1/applications/{appName}/subscription", s.updateSubscription)
+	s.mux.HandleFunc("DELETE /saas-manager/v1/applications/{appName}/subscription", s.unsubscribe)
+}
+
+// application returns the application of the path of r and the state of its subscription
+// in the subaccount of the client. An application that was never subscribed to is
+// NOT_SUBSCRIBED, like in BTP.
+func (s *Server) application(w http.ResponseWriter, r *http.Request, plan string) (*saas.EntitledApplicationsResponseObject, bool) {
+	name := r.PathValue("appName")
+	key := scopedName{scope: s.scope(r), name: name}
+	if app, ok := s.subscriptions[key]; ok {
+		return app, true
+	}
+	for _, a := range s.opts.Applications {
+		if a.Name != name || (plan != "" && a.Plan != plan) {
+			continue
+		}
+		app := &saas.EntitledApplicationsResponseObject{
+			AppId:           internal.Ptr(name + "!t1"),
+			AppName:         internal.Ptr(name),
+			DisplayName:     internal.Ptr(name),
+			GlobalAccountId: internal.Ptr(s.globalAccount.Guid),
+			PlanName:        internal.Ptr(a.Plan),
+			State:           internal.Ptr(subscriptionNotSubscribed),
+		}
+		s.subscriptions[key] = app
+		return app, true
+	}
+	writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "application", name))
+	return nil, false
+}
+
+func (s *Server) getApplication(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.application(w, r, r.URL.Query().Get("planName"))
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) subscribe(w http.ResponseWriter, r *http.Request) {
+	var p saas.CreateSubscriptionRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	app, ok := s.application(w, r, internal.Val(p.PlanName))
+	if !ok {
+		return
+	}
+	switch internal.Val(app.State) {
+	case subscriptionSubscribed, subscriptionInProcess:
+		writeError(w, r, http.StatusConflict, fmt.Sprintf("application %s is already subscribed", internal.Val(app.AppName)))
+		return
+	}
+	scope := s.scope(r)
+	app.State, app.SubscriptionError = internal.Ptr(subscriptionInProcess), nil
+	if p.PlanName != nil {
+		app.PlanName = p.PlanName
+	}
+	j := s.startJob(r, "Subscribe to application "+internal.Val(app.AppName), "", func(failure string) {
+		if failure != "" {
+			app.State = internal.Ptr(subscriptionSubscribeFailed)
+			app.SubscriptionError = &saas.EntitledApplicationsErrorResponseObject{ErrorMessage: internal.Ptr(failure)}
+			return
+		}
+		tenant := uuid.NewString()
+		app.State = internal.Ptr(subscriptionSubscribed)
+		app.CreatedDate = internal.Ptr(float32(s.millis()))
+		app.SubscribedSubaccountId = internal.Ptr(scope)
+		app.SubscribedTenantId = internal.Ptr(tenant)
+		app.SubscriptionGUID = internal.Ptr(uuid.NewString())
+		app.SubscriptionUrl = internal.Ptr("https://" + tenant + "." + internal.Val(app.AppName) + ".simulator.local")
+	})
+	accepted(w, j, http.StatusAccepted, nil)
+}
+
+func (s *Server) updateSubscription(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.application(w, r, "")
+	if !ok {
+		return
+	}
+	var p saas.UpdateSubscriptionRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	if internal.Val(app.State) != subscriptionSubscribed {
+		writeError(w, r, http.StatusConflict, fmt.Sprintf("application %s is not subscribed", internal.Val(app.AppName)))
+		return
+	}
+	app.State = internal.Ptr(subscriptionInProcess)
+	j := s.startJob(r, "Update subscription to application "+internal.Val(app.AppName), "", func(failure string) {
+		app.State = internal.Ptr(subscriptionSubscribed)
+		if failure != "" {
+			app.SubscriptionError = &saas.EntitledApplicationsErrorResponseObject{ErrorMessage: internal.Ptr(failure)}
+			return
+		}
+		if p.PlanName != nil {
+			app.PlanName = p.PlanName
+		}
+		app.ModifiedDate = internal.Ptr(float32(s.millis()))
+	})
+	accepted(w, j, http.StatusAccepted, nil)
+}
+
+func (s *Server) unsubscribe(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.application(w, r, "")
+	if !ok {
+		return
+	}
+	if internal.Val(app.State) == subscriptionNotSubscribed {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf("application %s is not subscribed", internal.Val(app.AppName)))
+		return
+	}
+	app.State = internal.Ptr(subscriptionInProcess)
+	j := s.startJob(r, "Unsubscribe from application "+internal.Val(app.AppName), "", func(failure string) {
+		if failure != "" {
+			app.State = internal.Ptr(subscriptionUnsubscribeFailed)
+			app.SubscriptionError = &saas.EntitledApplicationsErrorResponseObject{ErrorMessage: internal.Ptr(failure)}
+			return
+		}
+		app.State = internal.Ptr(subscriptionNotSubscribed)
+		app.SubscribedSubaccountId, app.SubscribedTenantId, app.SubscriptionGUID, app.SubscriptionUrl = nil, nil, nil, nil
+	})
+	accepted(w, j, http.StatusAccepted, nil)
+}
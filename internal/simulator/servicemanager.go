@@ -0,0 +1,346 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	smclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+// contextSubaccount is the key of the context of service instances and bindings that holds their subaccount.
+const contextSubaccount = "subaccount_id"
+
+func (s *Server) registerServiceManager() {
+	s.mux.HandleFunc("GET /v1/service_offerings", s.listServiceOfferings)
+	s.mux.HandleFunc("GET /v1/service_plans", s.listServicePlans)
+
+	s.mux.HandleFunc("GET /v1/service_instances", s.listServiceInstances)
+	s.mux.HandleFunc("POST /v1/service_instances", s.createServiceInstance)
+	s.mux.HandleFunc("GET /v1/service_instances/{id}", s.getServiceInstance)
+	s.mux.HandleFunc("DELETE /v1/service_instances/{id}", s.deleteServiceInstance)
+
+	s.mux.HandleFunc("GET /v1/service_bindings", s.listServiceBindings)
+	s.mux.HandleFunc("POST /v1/service_bindings", s.createServiceBinding)
+	s.mux.HandleFunc("GET /v1/service_bindings/{id}", s.getServiceBinding)
+	s.mux.HandleFunc("DELETE /v1/service_bindings/{id}", s.deleteServiceBinding)
+}
+
+// catalogID returns a stable ID for a service offering or plan of the catalog, so that
+// IDs stay the same when the simulator is restarted.
+func catalogID(kind string, names ...string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(kind+"/"+strings.Join(names, "/"))).String()
+}
+
+// fieldQuery parses a field query of the service manager. Only conditions of the form
+// field eq 'value', joined by and, are supported.
+func fieldQuery(q string) (map[string]string, error) {
+	conditions := map[string]string{}
+	if q == "" {
+		return conditions, nil
+	}
+	for _, c := range strings.Split(q, " and ") {
+		field, value, ok := strings.Cut(strings.TrimSpace(c), " eq ")
+		if !ok || len(value) < 2 || !strings.HasPrefix(value, "'") || !strings.HasSuffix(value, "'") {
+			return nil, fmt.Errorf("unsupported field query %q", q)
+		}
+		conditions[strings.TrimSpace(field)] = value[1 : len(value)-1]
+	}
+	return conditions, nil
+}
+
+// matchesQuery returns whether fields satisfy all conditions. Conditions on fields the
+// simulator does not know, e.g. data_center, are satisfied by every resource.
+func matchesQuery(conditions, fields map[string]string) bool {
+	for field, value := range conditions {
+		if v, ok := fields[field]; ok && v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// query returns the conditions of the field query of r, or responds with an error.
+func query(w http.ResponseWriter, r *http.Request) (map[string]string, bool) {
+	conditions, err := fieldQuery(r.URL.Query().Get("fieldQuery"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return conditions, true
+}
+
+func (s *Server) listServiceOfferings(w http.ResponseWriter, r *http.Request) {
+	conditions, ok := query(w, r)
+	if !ok {
+		return
+	}
+	var items []smclient.ServiceOfferingResponseObject
+	for _, e := range s.opts.Entitlements {
+		id := catalogID("offering", e.Service)
+		if !matchesQuery(conditions, map[string]string{"id": id, "name": e.Service, "catalog_name": e.Service}) ||
+			slices.ContainsFunc(items, func(o smclient.ServiceOfferingResponseObject) bool { return internal.Val(o.Id) == id }) {
+			continue
+		}
+		items = append(items, smclient.ServiceOfferingResponseObject{
+			Bindable:    internal.Ptr(true),
+			CatalogId:   internal.Ptr(id),
+			CatalogName: internal.Ptr(e.Service),
+			Id:          internal.Ptr(id),
+			Name:        internal.Ptr(e.Service),
+			Ready:       internal.Ptr(true),
+		})
+	}
+	writeJSON(w, http.StatusOK, smclient.ServiceOfferingResponseList{Items: items, NumItems: internal.Ptr(int32(len(items)))})
+}
+
+func (s *Server) listServicePlans(w http.ResponseWriter, r *http.Request) {
+	conditions, ok := query(w, r)
+	if !ok {
+		return
+	}
+	var items []smclient.ServicePlanResponseObject
+	for _, e := range s.opts.Entitlements {
+		id, offering := catalogID("plan", e.Service, e.Plan), catalogID("offering", e.Service)
+		if !matchesQuery(conditions, map[string]string{"id": id, "name": e.Plan, "catalog_name": e.Plan, "service_offering_id": offering}) {
+			continue
+		}
+		items = append(items, smclient.ServicePlanResponseObject{
+			Bindable:          internal.Ptr(true),
+			CatalogId:         internal.Ptr(id),
+			CatalogName:       internal.Ptr(e.Plan),
+			Free:              internal.Ptr(e.Quota == 0),
+			Id:                internal.Ptr(id),
+			Name:              internal.Ptr(e.Plan),
+			Ready:             internal.Ptr(true),
+			ServiceOfferingId: internal.Ptr(offering),
+		})
+	}
+	writeJSON(w, http.StatusOK, smclient.ServicePlanResponseList{Items: items, NumItems: internal.Ptr(int32(len(items)))})
+}
+
+// planByName returns the ID of the plan of the catalog with the names of its offering and itself.
+func (s *Server) planByName(offering, plan string) (string, bool) {
+	if _, ok := s.entitlement(offering, plan); !ok {
+		return "", false
+	}
+	return catalogID("plan", offering, plan), true
+}
+
+// inCatalog returns whether the catalog has a plan with id.
+func (s *Server) inCatalog(id string) bool {
+	return slices.ContainsFunc(s.opts.Entitlements, func(e Entitlement) bool { return catalogID("plan", e.Service, e.Plan) == id })
+}
+
+func (s *Server) listServiceInstances(w http.ResponseWriter, r *http.Request) {
+	conditions, ok := query(w, r)
+	if !ok {
+		return
+	}
+	scope := s.scope(r)
+	var items []smclient.ListedServiceInstanceResponseObject
+	for _, si := range s.instances {
+		if (*si.Context)[contextSubaccount] != scope || !matchesQuery(conditions, map[string]string{
+			"id":              internal.Val(si.Id),
+			"name":            internal.Val(si.Name),
+			"service_plan_id": internal.Val(si.ServicePlanId),
+		}) {
+			continue
+		}
+		items = append(items, smclient.ListedServiceInstanceResponseObject{
+			Context:       si.Context,
+			CreatedAt:     si.CreatedAt,
+			Id:            si.Id,
+			Labels:        si.Labels,
+			Name:          si.Name,
+			PlatformId:    si.PlatformId,
+			Ready:         si.Ready,
+			ServicePlanId: si.ServicePlanId,
+			UpdatedAt:     si.UpdatedAt,
+			Usable:        si.Usable,
+		})
+	}
+	slices.SortFunc(items, func(a, b smclient.ListedServiceInstanceResponseObject) int {
+		return a.CreatedAt.Compare(*b.CreatedAt)
+	})
+	writeJSON(w, http.StatusOK, smclient.ServiceInstanceResponseList{Items: items, NumItems: internal.Ptr(int32(len(items)))})
+}
+
+// createServiceInstance creates a service instance synchronously. The request refers to
+// the plan either by its ID or by the names of the plan and its offering.
+func (s *Server) createServiceInstance(w http.ResponseWriter, r *http.Request) {
+	var p struct {
+		Name                string               `json:"name"`
+		ServicePlanID       string               `json:"service_plan_id"`
+		ServiceOfferingName string               `json:"service_offering_name"`
+		ServicePlanName     string               `json:"service_plan_name"`
+		Labels              *map[string][]string `json:"labels"`
+	}
+	if !decode(w, r, &p) {
+		return
+	}
+	plan := p.ServicePlanID
+	if plan == "" {
+		plan, _ = s.planByName(p.ServiceOfferingName, p.ServicePlanName)
+	}
+	if !s.inCatalog(plan) {
+		writeError(w, r, http.StatusBadRequest, "service plan not found")
+		return
+	}
+	scope := s.scope(r)
+	for _, si := range s.instances {
+		if (*si.Context)[contextSubaccount] == scope && internal.Val(si.Name) == p.Name {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("service instance %s already exists", p.Name))
+			return
+		}
+	}
+	if failure, ok := r.Context().Value(failureKey).(string); ok {
+		writeError(w, r, http.StatusBadGateway, failure)
+		return
+	}
+	now := s.opts.Now().UTC().Truncate(time.Millisecond)
+	si := &smclient.ServiceInstanceResponseObject{
+		Context:       &map[string]string{contextSubaccount: scope},
+		CreatedAt:     &now,
+		Id:            internal.Ptr(uuid.NewString()),
+		Labels:        p.Labels,
+		Name:          internal.Ptr(p.Name),
+		PlatformId:    internal.Ptr("service-manager"),
+		Ready:         internal.Ptr(true),
+		ServicePlanId: internal.Ptr(plan),
+		UpdatedAt:     &now,
+		Usable:        internal.Ptr(true),
+	}
+	s.instances[*si.Id] = si
+	writeJSON(w, http.StatusCreated, si)
+}
+
+func (s *Server) getServiceInstance(w http.ResponseWriter, r *http.Request) {
+	si, ok := s.serviceInstance(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, si)
+}
+
+func (s *Server) deleteServiceInstance(w http.ResponseWriter, r *http.Request) {
+	si, ok := s.serviceInstance(w, r)
+	if !ok {
+		return
+	}
+	for _, sb := range s.bindings {
+		if internal.Val(sb.ServiceInstanceId) == internal.Val(si.Id) {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("service instance %s has bindings", internal.Val(si.Name)))
+			return
+		}
+	}
+	delete(s.instances, internal.Val(si.Id))
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) serviceInstance(w http.ResponseWriter, r *http.Request) (*smclient.ServiceInstanceResponseObject, bool) {
+	id := r.PathValue("id")
+	si, ok := s.instances[id]
+	if !ok || (*si.Context)[contextSubaccount] != s.scope(r) {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "service instance", id))
+		return nil, false
+	}
+	return si, true
+}
+
+func (s *Server) listServiceBindings(w http.ResponseWriter, r *http.Request) {
+	conditions, ok := query(w, r)
+	if !ok {
+		return
+	}
+	scope := s.scope(r)
+	var items []smclient.ListedServiceBindingResponseObject
+	for _, sb := range s.bindings {
+		if (*sb.Context)[contextSubaccount] != scope || !matchesQuery(conditions, map[string]string{
+			"id":                  internal.Val(sb.Id),
+			"name":                internal.Val(sb.Name),
+			"service_instance_id": internal.Val(sb.ServiceInstanceId),
+		}) {
+			continue
+		}
+		items = append(items, *sb)
+	}
+	slices.SortFunc(items, func(a, b smclient.ListedServiceBindingResponseObject) int {
+		return a.CreatedAt.Compare(*b.CreatedAt)
+	})
+	writeJSON(w, http.StatusOK, smclient.ServiceBindingResponseList{Items: items, NumItems: internal.Ptr(int32(len(items)))})
+}
+
+// createServiceBinding creates a service binding synchronously. Its credentials are random.
+func (s *Server) createServiceBinding(w http.ResponseWriter, r *http.Request) {
+	var p smclient.CreateServiceBindingRequestPayload
+	if !decode(w, r, &p) {
+		return
+	}
+	scope := s.scope(r)
+	si, ok := s.instances[p.ServiceInstanceId]
+	if !ok || (*si.Context)[contextSubaccount] != scope {
+		writeError(w, r, http.StatusBadRequest, fmt.Sprintf(errNotFound, "service instance", p.ServiceInstanceId))
+		return
+	}
+	for _, sb := range s.bindings {
+		if internal.Val(sb.ServiceInstanceId) == p.ServiceInstanceId && internal.Val(sb.Name) == p.Name {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("service binding %s already exists", p.Name))
+			return
+		}
+	}
+	if failure, ok := r.Context().Value(failureKey).(string); ok {
+		writeError(w, r, http.StatusBadGateway, failure)
+		return
+	}
+	now := s.opts.Now().UTC().Truncate(time.Millisecond)
+	sb := &smclient.ListedServiceBindingResponseObject{
+		Context:   &map[string]string{contextSubaccount: scope},
+		CreatedAt: &now,
+		Credentials: map[string]any{
+			"clientid":     uuid.NewString(),
+			"clientsecret": uuid.NewString(),
+			"url":          "https://" + scope + ".authentication.simulator.local",
+		},
+		Id:                internal.Ptr(uuid.NewString()),
+		Labels:            p.Labels,
+		Name:              internal.Ptr(p.Name),
+		Ready:             internal.Ptr(true),
+		ServiceInstanceId: internal.Ptr(p.ServiceInstanceId),
+		UpdatedAt:         &now,
+	}
+	s.bindings[*sb.Id] = sb
+	writeJSON(w, http.StatusCreated, sb)
+}
+
+func (s *Server) getServiceBinding(w http.ResponseWriter, r *http.Request) {
+	sb, ok := s.serviceBinding(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, sb)
+}
+
+func (s *Server) deleteServiceBinding(w http.ResponseWriter, r *http.Request) {
+	sb, ok := s.serviceBinding(w, r)
+	if !ok {
+		return
+	}
+	delete(s.bindings, internal.Val(sb.Id))
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) serviceBinding(w http.ResponseWriter, r *http.Request) (*smclient.ListedServiceBindingResponseObject, bool) {
+	id := r.PathValue("id")
+	sb, ok := s.bindings[id]
+	if !ok || (*sb.Context)[contextSubaccount] != s.scope(r) {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "service binding", id))
+		return nil, false
+	}
+	return sb, true
+}
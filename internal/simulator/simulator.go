@@ -0,0 +1,440 @@
+// Package simulator simulates the BTP APIs the controllers of the provider use, so
+// that they can be run and tested without a global account and without network.
+//
+// A Server serves the subset of the accounts, entitlements, provisioning, SaaS
+// provisioning, service manager and XSUAA APIs the controllers use, and the OAuth
+// token endpoint of their credentials, on a single address. Its state is kept in
+// memory. Operations that are asynchronous in BTP start a job that completes after
+// Options.Delay, and the resource they change is in a transitional state, e.g.
+// CREATING, until then. Entitlements are limited by the quota of the global account,
+// and Faults inject errors into requests and jobs.
+//
+// Subaccount scoped APIs, i.e. the provisioning, SaaS provisioning, service manager
+// and XSUAA APIs, act in the subaccount whose GUID or subdomain is the client ID of
+// the credentials the request was authorized with, and in a shared scope otherwise.
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+	saas "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+	smclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+)
+
+// States of the jobs of asynchronous operations.
+const (
+	JobInProgress = "IN_PROGRESS"
+	JobCompleted  = "COMPLETED"
+	JobFailed     = "FAILED"
+)
+
+const (
+	// DefaultDelay is the duration of asynchronous operations if Options.Delay is not set.
+	DefaultDelay = 5 * time.Second
+
+	// FaultsPath is the path of the endpoint that lists, adds and removes faults.
+	FaultsPath = "/simulator/v1/faults"
+
+	tokenPath       = "/oauth/token"
+	jobStatusFormat = "/jobs-management/v1/jobs/%s/status"
+
+	errUnauthorized  = "missing or unknown access token"
+	errInvalidBody   = "invalid request body"
+	errNotFound      = "%s %s not found"
+	errInjectedFault = "injected fault"
+)
+
+// Options configure a Server.
+type Options struct {
+	// Delay is how long asynchronous operations take. DefaultDelay is used if it is 0,
+	// and operations complete with the next request if it is negative.
+	Delay time.Duration
+	// GlobalAccountSubdomain is the subdomain of the simulated global account.
+	GlobalAccountSubdomain string
+	// Entitlements are the service plans entitled to the global account. They are also
+	// the catalog of the service manager.
+	Entitlements []Entitlement
+	// Applications are the SaaS applications subaccounts can subscribe to.
+	Applications []Application
+	// Faults are injected from the start.
+	Faults []Fault
+	// Now returns the current time, time.Now if not set.
+	Now func() time.Time
+}
+
+// Entitlement is a service plan entitled to the global account.
+type Entitlement struct {
+	Service string `json:"service"`
+	Plan    string `json:"plan"`
+	// Quota is the amount of the plan the global account can distribute to its
+	// subaccounts. Plans without quota are enabled in subaccounts instead.
+	Quota float32 `json:"quota,omitempty"`
+}
+
+// Application is a SaaS application subaccounts can subscribe to.
+type Application struct {
+	Name string `json:"name"`
+	Plan string `json:"plan"`
+}
+
+// A Fault makes matching requests fail.
+type Fault struct {
+	// Method of the matching requests, any method if empty.
+	Method string `json:"method,omitempty"`
+	// Path is the prefix of the path of the matching requests.
+	Path string `json:"path"`
+	// Status is the status the matching requests are rejected with. If it is 0,
+	// matching requests are accepted, but the jobs they start fail.
+	Status int `json:"status,omitempty"`
+	// Message describes the error.
+	Message string `json:"message,omitempty"`
+	// Times is how many requests the fault applies to, all requests if 0.
+	Times int `json:"times,omitempty"`
+}
+
+func (f Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || strings.EqualFold(f.Method, r.Method)) && strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+func (f Fault) message() string {
+	if f.Message != "" {
+		return f.Message
+	}
+	return errInjectedFault
+}
+
+// job is an asynchronous operation. Its done function changes the state of the
+// resource the operation was requested for once the job is due.
+type job struct {
+	id          string
+	description string
+	due         time.Time
+	status      string
+	failure     string
+	done        func(failure string)
+}
+
+// Server is a stateful simulation of the BTP APIs. It is an http.Handler.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+
+	// mu serializes the requests, so that the handlers access the state without further locking.
+	mu     sync.Mutex
+	tokens map[string]string
+	faults []Fault
+	jobs   map[string]*job
+	// pending are the jobs that are not done yet, in the order they are due.
+	pending []*job
+
+	globalAccount   accountclient.GlobalAccountResponseObject
+	subaccounts     map[string]*accountclient.SubaccountResponseObject
+	settings        map[string]map[string]string
+	directories     map[string]*accountclient.DirectoryResponseObject
+	assignments     map[assignmentKey]*entclient.AssignedServicePlanSubaccountDTO
+	environments    map[string]*provisioningclient.BusinessEnvironmentInstanceResponseObject
+	subscriptions   map[scopedName]*saas.EntitledApplicationsResponseObject
+	instances       map[string]*smclient.ServiceInstanceResponseObject
+	bindings        map[string]*smclient.ListedServiceBindingResponseObject
+	roleCollections map[scopedName]*xsuaa.RoleCollection
+	users           map[scopedName]*xsuaa.XSUser
+}
+
+// scopedName identifies a resource of a subaccount scoped API.
+type scopedName struct {
+	scope string
+	name  string
+}
+
+type contextKey int
+
+const (
+	clientIDKey contextKey = iota
+	failureKey
+)
+
+// New returns a Server with an empty global account.
+func New(o Options) *Server {
+	if o.Delay == 0 {
+		o.Delay = DefaultDelay
+	}
+	if o.Now == nil {
+		o.Now = time.Now
+	}
+	if o.GlobalAccountSubdomain == "" {
+		o.GlobalAccountSubdomain = "simulated-global-account"
+	}
+	s := &Server{
+		opts:            o,
+		tokens:          map[string]string{},
+		faults:          slices.Clone(o.Faults),
+		jobs:            map[string]*job{},
+		subaccounts:     map[string]*accountclient.SubaccountResponseObject{},
+		settings:        map[string]map[string]string{},
+		directories:     map[string]*accountclient.DirectoryResponseObject{},
+		assignments:     map[assignmentKey]*entclient.AssignedServicePlanSubaccountDTO{},
+		environments:    map[string]*provisioningclient.BusinessEnvironmentInstanceResponseObject{},
+		subscriptions:   map[scopedName]*saas.EntitledApplicationsResponseObject{},
+		instances:       map[string]*smclient.ServiceInstanceResponseObject{},
+		bindings:        map[string]*smclient.ListedServiceBindingResponseObject{},
+		roleCollections: map[scopedName]*xsuaa.RoleCollection{},
+		users:           map[scopedName]*xsuaa.XSUser{},
+	}
+	guid := uuid.NewString()
+	s.globalAccount = accountclient.GlobalAccountResponseObject{
+		CommercialModel:   "Subscription",
+		CreatedDate:       s.millis(),
+		DisplayName:       o.GlobalAccountSubdomain,
+		EntityState:       internal.Ptr(stateOK),
+		GeoAccess:         "STANDARD",
+		GlobalAccountGUID: guid,
+		Guid:              guid,
+		LicenseType:       "TEST",
+		ParentType:        "ROOT",
+		Subdomain:         internal.Ptr(o.GlobalAccountSubdomain),
+	}
+
+	s.mux = http.NewServeMux()
+	s.registerAccounts()
+	s.registerEntitlements()
+	s.registerProvisioning()
+	s.registerSaaS()
+	s.registerServiceManager()
+	s.registerXsuaa()
+	s.mux.HandleFunc("GET /jobs-management/v1/jobs/{id}/status", s.getJobStatus)
+	return s
+}
+
+// ServeHTTP serves the token endpoint and the endpoint of the faults without authorization,
+// and all other requests if they carry an access token issued by the token endpoint.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+
+	switch r.URL.Path {
+	case tokenPath:
+		s.issueToken(w, r)
+		return
+	case FaultsPath:
+		s.serveFaults(w, r)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	clientID, known := s.tokens[token]
+	if !ok || !known {
+		writeError(w, r, http.StatusUnauthorized, errUnauthorized)
+		return
+	}
+	ctx := context.WithValue(r.Context(), clientIDKey, clientID)
+	if f, ok := s.fault(r); ok {
+		if f.Status != 0 {
+			writeError(w, r, f.Status, f.message())
+			return
+		}
+		ctx = context.WithValue(ctx, failureKey, f.message())
+	}
+	s.mux.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddFault injects f into the following requests.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, f)
+}
+
+// ClearFaults removes all faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// fault returns the first fault that matches r, and counts r against its Times.
+func (s *Server) fault(r *http.Request) (Fault, bool) {
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			if f.Times == 1 {
+				s.faults = slices.Delete(s.faults, i, i+1)
+			} else {
+				s.faults[i].Times--
+			}
+		}
+		return f, true
+	}
+	return Fault{}, false
+}
+
+func (s *Server) serveFaults(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.faults)
+	case http.MethodPost:
+		var f Fault
+		if err := json.NewDecoder(r.Body).Decode(&f); err != nil || f.Path == "" {
+			writeError(w, r, http.StatusBadRequest, errInvalidBody)
+			return
+		}
+		s.faults = append(s.faults, f)
+		writeJSON(w, http.StatusCreated, f)
+	case http.MethodDelete:
+		s.faults = nil
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// issueToken issues an access token for any client credentials, password or JWT bearer grant.
+func (s *Server) issueToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, r, http.StatusBadRequest, errInvalidBody)
+		return
+	}
+	clientID, _, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+	}
+	if clientID == "" {
+		writeError(w, r, http.StatusUnauthorized, "missing client ID")
+		return
+	}
+	token := uuid.NewString()
+	s.tokens[token] = clientID
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"token_type":   "bearer",
+		"expires_in":   int((12 * time.Hour).Seconds()),
+	})
+}
+
+// scope returns the GUID of the subaccount the client of r acts in, or an empty string for the shared scope.
+func (s *Server) scope(r *http.Request) string {
+	clientID, _ := r.Context().Value(clientIDKey).(string)
+	for guid, sa := range s.subaccounts {
+		if clientID == guid || clientID == sa.Subdomain {
+			return guid
+		}
+	}
+	return ""
+}
+
+// startJob starts the job of an asynchronous operation requested by r. The job fails
+// with failure, or with the fault injected into r if failure is empty. done is called
+// with the reason of the failure once the job is due.
+func (s *Server) startJob(r *http.Request, description, failure string, done func(failure string)) *job {
+	if failure == "" {
+		failure, _ = r.Context().Value(failureKey).(string)
+	}
+	delay := s.opts.Delay
+	if delay < 0 {
+		delay = 0
+	}
+	j := &job{
+		id:          uuid.NewString(),
+		description: description,
+		due:         s.opts.Now().Add(delay),
+		status:      JobInProgress,
+		failure:     failure,
+		done:        done,
+	}
+	s.jobs[j.id] = j
+	s.pending = append(s.pending, j)
+	return j
+}
+
+// advance completes the jobs that are due.
+func (s *Server) advance() {
+	now := s.opts.Now()
+	for len(s.pending) > 0 && !s.pending[0].due.After(now) {
+		j := s.pending[0]
+		s.pending = s.pending[1:]
+		j.status = JobCompleted
+		if j.failure != "" {
+			j.status = JobFailed
+		}
+		j.done(j.failure)
+	}
+}
+
+func (s *Server) getJobStatus(w http.ResponseWriter, r *http.Request) {
+	j, ok := s.jobs[r.PathValue("id")]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "job not found")
+		return
+	}
+	status := entclient.JobStatusResponseObject{Description: j.description, Status: j.status}
+	if j.status == JobFailed {
+		status.StatusDetails = map[string]map[string]any{"error": {"message": j.failure}}
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// accepted responds that the operation of j was accepted, and references j in the Location header.
+func accepted(w http.ResponseWriter, j *job, status int, body any) {
+	w.Header().Set("Location", jobLocation(j))
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	writeJSON(w, status, body)
+}
+
+func jobLocation(j *job) string {
+	return fmt.Sprintf(jobStatusFormat, j.id)
+}
+
+// millis returns the current time in milliseconds since the epoch, the format of the dates of BTP.
+func (s *Server) millis() int64 {
+	return s.opts.Now().UnixMilli()
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError responds with an error in the format of the API of r.
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	if strings.HasPrefix(r.URL.Path, "/v1/") {
+		writeJSON(w, status, smclient.Error{Error: internal.Ptr(http.StatusText(status)), Description: internal.Ptr(message)})
+		return
+	}
+	writeJSON(w, status, map[string]any{"error": map[string]any{
+		"code":          status,
+		"message":       message,
+		"correlationID": uuid.NewString(),
+	}})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, r, http.StatusBadRequest, errInvalidBody+": "+err.Error())
+		return false
+	}
+	return true
+}
@@ -0,0 +1,493 @@
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	accountclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-accounts-service-api-go/pkg"
+	entclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-entitlements-service-api-go/pkg"
+	saas "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
+	smclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+)
+
+// clock is a fake clock the tests advance to complete jobs.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestServer(t *testing.T, o Options) (*Server, string, *clock) {
+	t.Helper()
+	c := &clock{now: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	o.Now = c.Now
+	s := New(o)
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	return s, server.URL, c
+}
+
+// token requests an access token for clientID with the client credentials grant.
+func token(t *testing.T, serverURL, clientID string) string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, serverURL+tokenPath, strings.NewReader(url.Values{"grant_type": {"client_credentials"}}.Encode()))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, "secret")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		AccessToken string `json:"access_token"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body.AccessToken
+}
+
+func accountsClient(serverURL, token string) *accountclient.APIClient {
+	cfg := accountclient.NewConfiguration()
+	cfg.Servers = accountclient.ServerConfigurations{{URL: serverURL}}
+	cfg.AddDefaultHeader("Authorization", "Bearer "+token)
+	return accountclient.NewAPIClient(cfg)
+}
+
+func entitlementsClient(serverURL, token string) *entclient.APIClient {
+	cfg := entclient.NewConfiguration()
+	cfg.Servers = entclient.ServerConfigurations{{URL: serverURL}}
+	cfg.AddDefaultHeader("Authorization", "Bearer "+token)
+	return entclient.NewAPIClient(cfg)
+}
+
+// createSubaccount creates a subaccount and waits for its creation.
+func createSubaccount(t *testing.T, serverURL, token string, c *clock, subdomain string) *accountclient.SubaccountResponseObject {
+	t.Helper()
+	ctx := context.Background()
+	client := accountsClient(serverURL, token)
+	sa, _, err := client.SubaccountOperationsAPI.CreateSubaccount(ctx).
+		CreateSubaccountRequestPayload(accountclient.CreateSubaccountRequestPayload{DisplayName: subdomain, Region: "eu10", Subdomain: internal.Ptr(subdomain)}).
+		Execute()
+	require.NoError(t, err)
+	c.Advance(DefaultDelay)
+	sa, _, err = client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	require.Equal(t, stateOK, sa.State)
+	return sa
+}
+
+func TestUnauthorized(t *testing.T) {
+	_, serverURL, _ := newTestServer(t, Options{})
+	tests := map[string]string{
+		"NoToken":      "",
+		"UnknownToken": "unknown",
+	}
+	for name, tok := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, resp, err := accountsClient(serverURL, tok).GlobalAccountOperationsAPI.GetGlobalAccount(context.Background()).Execute()
+			require.Error(t, err)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		})
+	}
+}
+
+func TestSubaccountLifecycle(t *testing.T) {
+	_, serverURL, c := newTestServer(t, Options{})
+	ctx := context.Background()
+	client := accountsClient(serverURL, token(t, serverURL, "admin"))
+
+	ga, _, err := client.GlobalAccountOperationsAPI.GetGlobalAccount(ctx).Execute()
+	require.NoError(t, err)
+
+	sa, resp, err := client.SubaccountOperationsAPI.CreateSubaccount(ctx).
+		CreateSubaccountRequestPayload(accountclient.CreateSubaccountRequestPayload{DisplayName: "dev", Region: "eu10", Subdomain: internal.Ptr("dev")}).
+		Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, stateCreating, sa.State)
+	assert.Equal(t, ga.Guid, sa.ParentGUID)
+	location := resp.Header.Get("Location")
+	assert.True(t, strings.HasPrefix(location, "/jobs-management/v1/jobs/"), location)
+
+	_, resp, err = client.SubaccountOperationsAPI.CreateSubaccount(ctx).
+		CreateSubaccountRequestPayload(accountclient.CreateSubaccountRequestPayload{DisplayName: "dev", Region: "eu10", Subdomain: internal.Ptr("dev")}).
+		Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	got, _, err := client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, stateCreating, got.State)
+
+	c.Advance(DefaultDelay)
+	got, _, err = client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, stateOK, got.State)
+
+	_, _, err = client.SubaccountOperationsAPI.UpdateSubaccount(ctx, sa.Guid).
+		UpdateSubaccountRequestPayload(accountclient.UpdateSubaccountRequestPayload{DisplayName: "development", Description: internal.Ptr("for developers")}).
+		Execute()
+	require.NoError(t, err)
+	c.Advance(DefaultDelay)
+	got, _, err = client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "development", got.DisplayName)
+	assert.Equal(t, "for developers", got.Description)
+
+	got, _, err = client.SubaccountOperationsAPI.DeleteSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, stateDeleting, got.State)
+	c.Advance(DefaultDelay)
+	_, resp, err = client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestSubaccountCustomProperties(t *testing.T) {
+	_, serverURL, c := newTestServer(t, Options{})
+	ctx := context.Background()
+	client := accountsClient(serverURL, token(t, serverURL, "admin"))
+
+	sa, _, err := client.SubaccountOperationsAPI.CreateSubaccount(ctx).
+		CreateSubaccountRequestPayload(accountclient.CreateSubaccountRequestPayload{
+			DisplayName: "dev",
+			Region:      "eu10",
+			Subdomain:   internal.Ptr("dev"),
+			Labels:      &map[string][]string{"team": {"a", "b"}},
+			AdditionalProperties: map[string]interface{}{
+				"customProperties": []customProperty{{Key: "costCenter", Value: "4711"}},
+			},
+		}).
+		Execute()
+	require.NoError(t, err)
+	c.Advance(DefaultDelay)
+	got, _, err := client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"costCenter": {"4711"}, "team": {"a", "b"}}, internal.Val(got.Labels))
+	var properties []string
+	for _, p := range got.CustomProperties {
+		assert.Equal(t, sa.Guid, p.AccountGUID)
+		properties = append(properties, p.Key+"="+p.Value)
+	}
+	assert.Equal(t, []string{"costCenter=4711", "team=a", "team=b"}, properties)
+
+	_, _, err = client.SubaccountOperationsAPI.UpdateSubaccount(ctx, sa.Guid).
+		UpdateSubaccountRequestPayload(accountclient.UpdateSubaccountRequestPayload{
+			DisplayName: "dev",
+			AdditionalProperties: map[string]interface{}{
+				"customProperties": []customProperty{{Key: "costCenter", Delete: true}, {Key: "owner", Value: "jane"}},
+			},
+		}).
+		Execute()
+	require.NoError(t, err)
+	c.Advance(DefaultDelay)
+	got, _, err = client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"owner": {"jane"}, "team": {"a", "b"}}, internal.Val(got.Labels))
+}
+
+func TestSubaccountSettings(t *testing.T) {
+	_, serverURL, c := newTestServer(t, Options{})
+	ctx := context.Background()
+	tok := token(t, serverURL, "admin")
+	sa := createSubaccount(t, serverURL, tok, c, "dev")
+	client := accountsClient(serverURL, tok)
+
+	settings := func() map[string]string {
+		t.Helper()
+		_, resp, err := client.SubaccountOperationsAPI.GetSubaccountSettings(ctx, sa.Guid).Execute()
+		require.NotNil(t, resp)
+		require.Equal(t, http.StatusOK, resp.StatusCode, err)
+		var body struct {
+			Values []setting `json:"values"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		got := map[string]string{}
+		for _, v := range body.Values {
+			assert.Equal(t, "SUBACCOUNT", v.EntityType)
+			got[v.Key] = v.Value
+		}
+		return got
+	}
+
+	_, resp, _ := client.SubaccountOperationsAPI.CreateOrUpdateSubaccountSettings(ctx, sa.Guid).
+		EntitySettingsRequestPayload(accountclient.EntitySettingsRequestPayload{
+			AdditionalProperties: map[string]interface{}{
+				"entitySettings": []setting{{Key: "costCenter", Value: "4711"}, {Key: "owner", Value: "jane"}},
+			},
+		}).
+		Execute()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, map[string]string{"costCenter": "4711", "owner": "jane"}, settings())
+
+	_, resp, _ = client.SubaccountOperationsAPI.DeleteSubaccountSettings(ctx, sa.Guid).Keys([]string{"owner", "unknown"}).Execute()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, map[string]string{"costCenter": "4711"}, settings())
+
+	_, resp, _ = client.SubaccountOperationsAPI.GetSubaccountSettings(ctx, "unknown").Execute()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestEntitlementQuota(t *testing.T) {
+	_, serverURL, c := newTestServer(t, Options{Entitlements: []Entitlement{{Service: "hana-cloud", Plan: "hana", Quota: 3}}})
+	ctx := context.Background()
+	tok := token(t, serverURL, "admin")
+	dev := createSubaccount(t, serverURL, tok, c, "dev")
+	prod := createSubaccount(t, serverURL, tok, c, "prod")
+	client := entitlementsClient(serverURL, tok)
+
+	assign := func(subaccount string, amount float32) string {
+		t.Helper()
+		id, resp, err := client.ManageAssignedEntitlementsAPI.SetServicePlans(ctx).
+			SubaccountServicePlansRequestPayloadCollection(entclient.SubaccountServicePlansRequestPayloadCollection{
+				SubaccountServicePlans: []entclient.ServicePlanAssignmentRequestPayload{{
+					ServiceName:     "hana-cloud",
+					ServicePlanName: "hana",
+					AssignmentInfo:  []entclient.SubaccountServicePlanRequestPayload{{SubaccountGUID: subaccount, Amount: internal.Ptr(amount)}},
+				}},
+			}).Execute()
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+		c.Advance(DefaultDelay)
+		return id
+	}
+	jobStatus := func(id string) *entclient.JobStatusResponseObject {
+		t.Helper()
+		status, _, err := client.JobManagementAPI.GetStatus(ctx, id).Execute()
+		require.NoError(t, err)
+		return status
+	}
+
+	assert.Equal(t, JobCompleted, jobStatus(assign(dev.Guid, 2)).Status)
+
+	failed := jobStatus(assign(prod.Guid, 2))
+	assert.Equal(t, JobFailed, failed.Status)
+	assert.Contains(t, failed.StatusDetails["error"]["message"], "quota of service plan hana of service hana-cloud exceeded")
+
+	assignments, _, err := client.ManageAssignedEntitlementsAPI.GetDirectoryAssignments(ctx).ServiceName("hana-cloud").PlanName("hana").Execute()
+	require.NoError(t, err)
+	plan := assignments.EntitledServices[0].ServicePlans[0]
+	assert.Equal(t, float32(3), internal.Val(plan.Amount))
+	assert.Equal(t, float32(1), internal.Val(plan.RemainingAmount))
+	infos := assignments.AssignedServices[0].ServicePlans[0].AssignmentInfo
+	require.Len(t, infos, 2)
+	byGUID := map[string]entclient.AssignedServicePlanSubaccountDTO{}
+	for _, info := range infos {
+		byGUID[internal.Val(info.EntityId)] = info
+	}
+	assert.Equal(t, stateOK, internal.Val(byGUID[dev.Guid].EntityState))
+	assert.Equal(t, float32(2), internal.Val(byGUID[dev.Guid].Amount))
+	assert.Equal(t, stateProcessingFailed, internal.Val(byGUID[prod.Guid].EntityState))
+
+	assert.Equal(t, JobCompleted, jobStatus(assign(prod.Guid, 1)).Status)
+}
+
+func TestFaults(t *testing.T) {
+	s, serverURL, c := newTestServer(t, Options{})
+	ctx := context.Background()
+	client := accountsClient(serverURL, token(t, serverURL, "admin"))
+	create := func() (*accountclient.SubaccountResponseObject, *http.Response, error) {
+		return client.SubaccountOperationsAPI.CreateSubaccount(ctx).
+			CreateSubaccountRequestPayload(accountclient.CreateSubaccountRequestPayload{DisplayName: "dev", Region: "eu10"}).
+			Execute()
+	}
+
+	s.AddFault(Fault{Method: http.MethodPost, Path: "/accounts/v1/subaccounts", Status: http.StatusTooManyRequests, Times: 1})
+	_, resp, err := create()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	body, err := json.Marshal(Fault{Method: http.MethodPost, Path: "/accounts/v1/subaccounts", Message: "region unavailable"})
+	require.NoError(t, err)
+	resp, err = http.Post(serverURL+FaultsPath, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	sa, _, err := create()
+	require.NoError(t, err)
+	c.Advance(DefaultDelay)
+	sa, _, err = client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, stateCreationFailed, sa.State)
+	assert.Equal(t, "region unavailable", internal.Val(sa.StateMessage))
+
+	s.ClearFaults()
+	_, resp, err = client.SubaccountOperationsAPI.GetSubaccount(ctx, sa.Guid).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestFieldQuery(t *testing.T) {
+	tests := map[string]struct {
+		query   string
+		want    map[string]string
+		wantErr bool
+	}{
+		"Empty":       {want: map[string]string{}},
+		"Single":      {query: "name eq 'my-instance'", want: map[string]string{"name": "my-instance"}},
+		"Conjunction": {query: "catalog_name eq 'hana' and data_center eq 'cf-eu10'", want: map[string]string{"catalog_name": "hana", "data_center": "cf-eu10"}},
+		"Unsupported": {query: "name in ('a', 'b')", wantErr: true},
+		"Unquoted":    {query: "name eq a", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := fieldQuery(tc.query)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestServiceManager(t *testing.T) {
+	_, serverURL, c := newTestServer(t, Options{Entitlements: []Entitlement{{Service: "xsuaa", Plan: "application"}}})
+	ctx := context.Background()
+	dev := createSubaccount(t, serverURL, token(t, serverURL, "admin"), c, "dev")
+
+	clientFor := func(clientID string) *smclient.APIClient {
+		cfg := smclient.NewConfiguration()
+		cfg.Servers = smclient.ServerConfigurations{{URL: serverURL}}
+		cfg.AddDefaultHeader("Authorization", "Bearer "+token(t, serverURL, clientID))
+		return smclient.NewAPIClient(cfg)
+	}
+	client := clientFor(dev.Subdomain)
+
+	offerings, _, err := client.ServiceOfferingsAPI.GetServiceOfferings(ctx).FieldQuery("catalog_name eq 'xsuaa' and data_center eq 'cf-eu10'").Execute()
+	require.NoError(t, err)
+	require.Len(t, offerings.Items, 1)
+	plans, _, err := client.ServicePlansAPI.GetAllServicePlans(ctx).FieldQuery("catalog_name eq 'application' and service_offering_id eq '" + internal.Val(offerings.Items[0].Id) + "'").Execute()
+	require.NoError(t, err)
+	require.Len(t, plans.Items, 1)
+
+	si, _, err := client.ServiceInstancesAPI.CreateServiceInstance(ctx).CreateServiceInstanceRequestPayload(smclient.CreateServiceInstanceRequestPayload{
+		CreateByPlanID: &smclient.CreateByPlanID{Name: "my-instance", ServicePlanId: internal.Val(plans.Items[0].Id)},
+	}).Execute()
+	require.NoError(t, err)
+	_, _, err = client.ServiceBindingsAPI.CreateServiceBinding(ctx).CreateServiceBindingRequestPayload(smclient.CreateServiceBindingRequestPayload{
+		Name: "my-binding", ServiceInstanceId: internal.Val(si.Id),
+	}).Execute()
+	require.NoError(t, err)
+
+	instances, _, err := client.ServiceInstancesAPI.GetAllServiceInstances(ctx).FieldQuery("name eq 'my-instance'").Execute()
+	require.NoError(t, err)
+	assert.Len(t, instances.Items, 1)
+	bindings, _, err := client.ServiceBindingsAPI.GetAllServiceBindings(ctx).FieldQuery("service_instance_id eq '" + internal.Val(si.Id) + "' and name eq 'my-binding'").Execute()
+	require.NoError(t, err)
+	assert.Len(t, bindings.Items, 1)
+
+	other, _, err := clientFor("other").ServiceInstancesAPI.GetAllServiceInstances(ctx).FieldQuery("name eq 'my-instance'").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, other.Items, "instances must be scoped to their subaccount")
+
+	_, resp, err := client.ServiceInstancesAPI.DeleteServiceInstance(ctx, internal.Val(si.Id)).Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+func TestSubscription(t *testing.T) {
+	_, serverURL, c := newTestServer(t, Options{Applications: []Application{{Name: "feature-flags-dashboard", Plan: "dashboard"}}})
+	ctx := context.Background()
+	dev := createSubaccount(t, serverURL, token(t, serverURL, "admin"), c, "dev")
+
+	cfg := saas.NewConfiguration()
+	cfg.Servers = saas.ServerConfigurations{{URL: serverURL}}
+	cfg.AddDefaultHeader("Authorization", "Bearer "+token(t, serverURL, dev.Guid))
+	client := saas.NewAPIClient(cfg)
+	get := func() string {
+		t.Helper()
+		app, _, err := client.SubscriptionOperationsForAppConsumersAPI.GetEntitledApplication(ctx, "feature-flags-dashboard").PlanName("dashboard").Execute()
+		require.NoError(t, err)
+		return internal.Val(app.State)
+	}
+
+	assert.Equal(t, subscriptionNotSubscribed, get())
+	resp, err := client.SubscriptionOperationsForAppConsumersAPI.CreateSubscriptionAsync(ctx, "feature-flags-dashboard").
+		CreateSubscriptionRequestPayload(saas.CreateSubscriptionRequestPayload{PlanName: internal.Ptr("dashboard")}).
+		Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, subscriptionInProcess, get())
+	c.Advance(DefaultDelay)
+	assert.Equal(t, subscriptionSubscribed, get())
+
+	_, err = client.SubscriptionOperationsForAppConsumersAPI.DeleteSubscriptionAsync(ctx, "feature-flags-dashboard").Execute()
+	require.NoError(t, err)
+	c.Advance(DefaultDelay)
+	assert.Equal(t, subscriptionNotSubscribed, get())
+
+	_, resp, err = client.SubscriptionOperationsForAppConsumersAPI.GetEntitledApplication(ctx, "unknown").Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestRoleCollectionAssignments(t *testing.T) {
+	_, serverURL, _ := newTestServer(t, Options{})
+	ctx := context.Background()
+	cfg := xsuaa.NewConfiguration()
+	cfg.Servers = xsuaa.ServerConfigurations{{URL: serverURL}}
+	cfg.AddDefaultHeader("Authorization", "Bearer "+token(t, serverURL, "xsuaa"))
+	client := xsuaa.NewAPIClient(cfg)
+
+	_, _, err := client.RolecollectionsAPI.CreateRoleCollection(ctx).RoleCollection(xsuaa.RoleCollection{Name: "viewers"}).Execute()
+	require.NoError(t, err)
+	_, _, err = client.RolecollectionsAPI.AddRolesToRoleCollection(ctx, "viewers").
+		RoleReference([]xsuaa.RoleReference{{Name: internal.Ptr("Viewer"), RoleTemplateAppId: internal.Ptr("app!t1"), RoleTemplateName: internal.Ptr("Viewer")}}).
+		Execute()
+	require.NoError(t, err)
+	rc, _, err := client.RolecollectionsAPI.GetRoleCollectionByName(ctx, "viewers").Execute()
+	require.NoError(t, err)
+	assert.Len(t, rc.RoleReferences, 1)
+
+	_, _, err = client.UsercontrollerAPI.AddRoleCollection(ctx, "sap.default", "jane@example.com", "viewers").CreateUserIfMissing(true).Execute()
+	require.NoError(t, err)
+	user, _, err := client.UsercontrollerAPI.GetUserByName(ctx, "jane@example.com", "sap.default").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"viewers"}, user.RoleCollections)
+
+	_, _, err = client.IdpRoleCollectionAPI.AddIdpAttributeToRoleCollection(ctx, "sap.default").IdentityProviderMapping(xsuaa.IdentityProviderMapping{
+		RoleCollectionName: internal.Ptr("viewers"),
+		AttributeName:      internal.Ptr("Groups"),
+		AttributeValue:     internal.Ptr("readers"),
+		Operator:           internal.Ptr("equals"),
+	}).Execute()
+	require.NoError(t, err)
+	attrs, _, err := client.IdpRoleCollectionAPI.GetIdpAttributeValuesFromRoleCollectionByAttribute(ctx, "sap.default", "Groups", "viewers").Execute()
+	require.NoError(t, err)
+	require.Len(t, attrs, 1)
+	assert.Equal(t, "readers", internal.Val(attrs[0].AttributeValue))
+
+	_, _, err = client.IdpRoleCollectionAPI.DeleteIdpAttributeToRoleCollection(ctx, "sap.default", "Groups", "equals", "readers", "viewers").Execute()
+	require.NoError(t, err)
+	_, _, err = client.RolecollectionsAPI.DeleteRoleCollectionByName(ctx, "viewers").Execute()
+	require.NoError(t, err)
+	user, _, err = client.UsercontrollerAPI.GetUserByName(ctx, "jane@example.com", "sap.default").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, user.RoleCollections)
+}
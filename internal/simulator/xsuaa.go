@@ -0,0 +1,258 @@
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+)
+
+const (
+	rolecollectionsPath = "/sap/rest/authorization/v2/rolecollections"
+	attributesPath      = "/sap/rest/authorization/v2/identity-providers/{origin}/attributes"
+)
+
+func (s *Server) registerXsuaa() {
+	s.mux.HandleFunc("POST "+rolecollectionsPath, s.createRoleCollection)
+	s.mux.HandleFunc("GET "+rolecollectionsPath+"/{name}", s.getRoleCollection)
+	s.mux.HandleFunc("PUT "+rolecollectionsPath+"/{name}", s.changeRoleCollectionDescription)
+	s.mux.HandleFunc("DELETE "+rolecollectionsPath+"/{name}", s.deleteRoleCollection)
+	s.mux.HandleFunc("PUT "+rolecollectionsPath+"/{name}/roles", s.addRoles)
+	s.mux.HandleFunc("DELETE "+rolecollectionsPath+"/{name}/roles", s.deleteRoles)
+
+	s.mux.HandleFunc("GET /sap/rest/user/origin/{origin}/name/{name}", s.getUser)
+	s.mux.HandleFunc("PUT /sap/rest/user/origin/{origin}/name/{name}/rolecollections/{roleCollection}", s.assignUser)
+	s.mux.HandleFunc("DELETE /sap/rest/user/origin/{origin}/name/{name}/rolecollections/{roleCollection}", s.revokeUser)
+
+	s.mux.HandleFunc("POST "+attributesPath, s.assignAttribute)
+	s.mux.HandleFunc("GET "+attributesPath+"/{attribute}/rolecollections/{roleCollection}", s.getAttributeValues)
+	s.mux.HandleFunc("DELETE "+attributesPath+"/{attribute}/{operator}/{value}/rolecollections/{roleCollection}", s.revokeAttribute)
+}
+
+func (s *Server) createRoleCollection(w http.ResponseWriter, r *http.Request) {
+	var p xsuaa.RoleCollection
+	if !decode(w, r, &p) {
+		return
+	}
+	key := scopedName{scope: s.scope(r), name: p.Name}
+	if _, ok := s.roleCollections[key]; ok {
+		writeError(w, r, http.StatusConflict, fmt.Sprintf("role collection %s already exists", p.Name))
+		return
+	}
+	rc := &xsuaa.RoleCollection{
+		Name:           p.Name,
+		Description:    p.Description,
+		RoleReferences: p.RoleReferences,
+		IsReadOnly:     internal.Ptr(false),
+	}
+	s.roleCollections[key] = rc
+	writeJSON(w, http.StatusCreated, rc)
+}
+
+func (s *Server) getRoleCollection(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, rc)
+}
+
+func (s *Server) changeRoleCollectionDescription(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	var p xsuaa.RoleCollectionDescription
+	if !decode(w, r, &p) {
+		return
+	}
+	rc.Description = p.Description
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// deleteRoleCollection deletes a role collection and revokes it from users and groups.
+func (s *Server) deleteRoleCollection(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	scope := s.scope(r)
+	delete(s.roleCollections, scopedName{scope: scope, name: rc.Name})
+	for key, u := range s.users {
+		if key.scope == scope {
+			u.RoleCollections = slices.DeleteFunc(u.RoleCollections, func(name string) bool { return name == rc.Name })
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) addRoles(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	var roles []xsuaa.RoleReference
+	if !decode(w, r, &roles) {
+		return
+	}
+	for _, role := range roles {
+		if !slices.ContainsFunc(rc.RoleReferences, sameRole(role)) {
+			rc.RoleReferences = append(rc.RoleReferences, role)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) deleteRoles(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	var roles []xsuaa.RoleReference
+	if !decode(w, r, &roles) {
+		return
+	}
+	for _, role := range roles {
+		rc.RoleReferences = slices.DeleteFunc(rc.RoleReferences, sameRole(role))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// sameRole returns a function that reports whether a role reference refers to the same role as role.
+func sameRole(role xsuaa.RoleReference) func(xsuaa.RoleReference) bool {
+	return func(other xsuaa.RoleReference) bool {
+		return internal.Val(other.Name) == internal.Val(role.Name) &&
+			internal.Val(other.RoleTemplateAppId) == internal.Val(role.RoleTemplateAppId) &&
+			internal.Val(other.RoleTemplateName) == internal.Val(role.RoleTemplateName)
+	}
+}
+
+func (s *Server) roleCollection(w http.ResponseWriter, r *http.Request, name string) (*xsuaa.RoleCollection, bool) {
+	rc, ok := s.roleCollections[scopedName{scope: s.scope(r), name: name}]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "role collection", name))
+	}
+	return rc, ok
+}
+
+// userKey returns the key of the user of the path of r. Users are identified by their origin and name.
+func (s *Server) userKey(r *http.Request) scopedName {
+	return scopedName{scope: s.scope(r), name: r.PathValue("origin") + "/" + r.PathValue("name")}
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.users[s.userKey(r)]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "user", r.PathValue("name")))
+		return
+	}
+	writeJSON(w, http.StatusOK, u)
+}
+
+// assignUser assigns a role collection to a user. The user is created if it does not exist and
+// createUserIfMissing is set, like in XSUAA.
+func (s *Server) assignUser(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("roleCollection"))
+	if !ok {
+		return
+	}
+	key := s.userKey(r)
+	u, ok := s.users[key]
+	if !ok {
+		if r.URL.Query().Get("createUserIfMissing") != "true" {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "user", r.PathValue("name")))
+			return
+		}
+		now := s.opts.Now().UTC()
+		u = &xsuaa.XSUser{
+			Active:   internal.Ptr(true),
+			Created:  &now,
+			Email:    internal.Ptr(r.PathValue("name")),
+			Id:       internal.Ptr(uuid.NewString()),
+			Origin:   internal.Ptr(r.PathValue("origin")),
+			Username: internal.Ptr(r.PathValue("name")),
+			Verified: internal.Ptr(true),
+		}
+		s.users[key] = u
+	}
+	if !slices.Contains(u.RoleCollections, rc.Name) {
+		u.RoleCollections = append(u.RoleCollections, rc.Name)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) revokeUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := s.users[s.userKey(r)]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf(errNotFound, "user", r.PathValue("name")))
+		return
+	}
+	u.RoleCollections = slices.DeleteFunc(u.RoleCollections, func(name string) bool { return name == r.PathValue("roleCollection") })
+	writeJSON(w, http.StatusOK, u)
+}
+
+// assignAttribute maps the values of an attribute of an identity provider, e.g. a group, to a role
+// collection. The mappings are kept in the group references of the role collection.
+func (s *Server) assignAttribute(w http.ResponseWriter, r *http.Request) {
+	var p xsuaa.IdentityProviderMapping
+	if !decode(w, r, &p) {
+		return
+	}
+	rc, ok := s.roleCollection(w, r, internal.Val(p.RoleCollectionName))
+	if !ok {
+		return
+	}
+	attr := xsuaa.RoleCollectionAttribute{
+		RoleCollectionName: p.RoleCollectionName,
+		AttributeName:      p.AttributeName,
+		AttributeValue:     p.AttributeValue,
+		ComparisonOperator: p.Operator,
+		IdpId:              internal.Ptr(r.PathValue("origin")),
+	}
+	if !slices.ContainsFunc(rc.GroupReferences, sameAttribute(attr)) {
+		rc.GroupReferences = append(rc.GroupReferences, attr)
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{})
+}
+
+func (s *Server) getAttributeValues(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("roleCollection"))
+	if !ok {
+		return
+	}
+	attrs := []xsuaa.RoleCollectionAttribute{}
+	for _, a := range rc.GroupReferences {
+		if internal.Val(a.IdpId) == r.PathValue("origin") && internal.Val(a.AttributeName) == r.PathValue("attribute") {
+			attrs = append(attrs, a)
+		}
+	}
+	writeJSON(w, http.StatusOK, attrs)
+}
+
+func (s *Server) revokeAttribute(w http.ResponseWriter, r *http.Request) {
+	rc, ok := s.roleCollection(w, r, r.PathValue("roleCollection"))
+	if !ok {
+		return
+	}
+	rc.GroupReferences = slices.DeleteFunc(rc.GroupReferences, sameAttribute(xsuaa.RoleCollectionAttribute{
+		AttributeName:      internal.Ptr(r.PathValue("attribute")),
+		AttributeValue:     internal.Ptr(r.PathValue("value")),
+		ComparisonOperator: internal.Ptr(r.PathValue("operator")),
+		IdpId:              internal.Ptr(r.PathValue("origin")),
+	}))
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// sameAttribute returns a function that reports whether an attribute mapping maps the same value as attr.
+func sameAttribute(attr xsuaa.RoleCollectionAttribute) func(xsuaa.RoleCollectionAttribute) bool {
+	return func(other xsuaa.RoleCollectionAttribute) bool {
+		return internal.Val(other.IdpId) == internal.Val(attr.IdpId) &&
+			internal.Val(other.AttributeName) == internal.Val(attr.AttributeName) &&
+			internal.Val(other.AttributeValue) == internal.Val(attr.AttributeValue) &&
+			internal.Val(other.ComparisonOperator) == internal.Val(attr.ComparisonOperator)
+	}
+}
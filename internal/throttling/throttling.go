@@ -0,0 +1,158 @@
+// Package throttling reports throttled BTP API requests on the managed resources.
+//
+// The managed reconciler of crossplane-runtime marks every error of an
+// ExternalClient as ReconcileError. When the error is a btp.ThrottledError,
+// the wrapped reconciler of this package replaces that condition with a
+// Synced condition of reason Throttled, and requeues the resource once the
+// Retry-After of the BTP API has passed.
+package throttling
+
+import (
+	"context"
+	"sync"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sap/crossplane-provider-btp/btp"
+)
+
+// ReasonThrottled is the reason of the Synced condition of resources whose BTP API requests were throttled.
+const ReasonThrottled xpv1.ConditionReason = "Throttled"
+
+const (
+	errNewManaged   = "cannot create managed resource of kind"
+	errNotManaged   = "kind is not a managed resource"
+	errGetManaged   = "cannot get managed resource"
+	errUpdateStatus = "cannot update status of managed resource"
+)
+
+// Throttled returns a condition that indicates that the managed resource could not be synced,
+// because BTP throttled the requests of its credentials.
+func Throttled(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonThrottled,
+		Message:            err.Error(),
+	}
+}
+
+type recorderKey struct{}
+
+// recorder holds the last ThrottledError returned by the ExternalClient during a reconcile.
+type recorder struct {
+	mu  sync.Mutex
+	err *btp.ThrottledError
+}
+
+func record(ctx context.Context, err error) {
+	rec, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return
+	}
+	var throttled *btp.ThrottledError
+	if errors.As(err, &throttled) {
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		rec.err = throttled
+	}
+}
+
+func (r *recorder) throttled() *btp.ThrottledError {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// NewReconciler wraps r, so that managed resources of kind gvk whose ExternalClient, wrapped with NewConnector,
+// returned a btp.ThrottledError get the Throttled condition instead of ReconcileError.
+func NewReconciler(kube client.Client, gvk schema.GroupVersionKind, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		rec := &recorder{}
+		result, err := r.Reconcile(context.WithValue(ctx, recorderKey{}, rec), req)
+		throttled := rec.throttled()
+		if throttled == nil {
+			return result, err
+		}
+
+		obj, nerr := kube.Scheme().New(gvk)
+		if nerr != nil {
+			return result, errors.Wrapf(nerr, "%s %s", errNewManaged, gvk)
+		}
+		mg, ok := obj.(resource.Managed)
+		if !ok {
+			return result, errors.Errorf("%s: %s", errNotManaged, gvk)
+		}
+		if err := kube.Get(ctx, req.NamespacedName, mg); err != nil {
+			return result, errors.Wrap(client.IgnoreNotFound(err), errGetManaged)
+		}
+		mg.SetConditions(Throttled(throttled))
+		if err := kube.Status().Update(ctx, mg); err != nil {
+			return result, errors.Wrap(err, errUpdateStatus)
+		}
+		if throttled.RetryAfter > 0 {
+			return reconcile.Result{RequeueAfter: throttled.RetryAfter}, nil
+		}
+		return result, err
+	})
+}
+
+// NewConnector wraps c, so that errors of Connect and of the methods of the connected ExternalClient
+// are recorded for the reconciler returned by NewReconciler.
+func NewConnector(c managed.ExternalConnector) managed.ExternalConnector {
+	return &connector{connector: c}
+}
+
+type connector struct {
+	connector managed.ExternalConnector
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		record(ctx, err)
+		return nil, err
+	}
+	return &external{external: ext}, nil
+}
+
+type external struct {
+	external managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.external.Observe(ctx, mg)
+	record(ctx, err)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.external.Create(ctx, mg)
+	record(ctx, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.external.Update(ctx, mg)
+	record(ctx, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := e.external.Delete(ctx, mg)
+	record(ctx, err)
+	return d, err
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.external.Disconnect(ctx)
+}
@@ -0,0 +1,85 @@
+package throttling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sap/crossplane-provider-btp/apis"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+)
+
+func TestThrottledReconcile(t *testing.T) {
+	errThrottled := &btp.ThrottledError{API: btp.APIAccounts, RetryAfter: 30 * time.Second}
+
+	tests := map[string]struct {
+		observeErr error
+		wantResult reconcile.Result
+		wantReason xpv1.ConditionReason
+	}{
+		"Throttled": {
+			observeErr: errors.Wrap(errThrottled, "cannot get subaccount"),
+			wantResult: reconcile.Result{RequeueAfter: 30 * time.Second},
+			wantReason: ReasonThrottled,
+		},
+		"OtherError": {
+			observeErr: errors.New("boom"),
+			wantResult: reconcile.Result{Requeue: true},
+			wantReason: xpv1.ReasonReconcileError,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, apis.AddToScheme(scheme))
+
+			cr := &v1alpha1.Subaccount{ObjectMeta: metav1.ObjectMeta{Name: "my-subaccount"}}
+			kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr).WithStatusSubresource(cr).Build()
+
+			connector := NewConnector(managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.observeErr
+					},
+				}, nil
+			}))
+
+			// Stands in for the managed reconciler, which marks every error as ReconcileError.
+			inner := reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				mg := &v1alpha1.Subaccount{}
+				require.NoError(t, kube.Get(ctx, req.NamespacedName, mg))
+				ext, err := connector.Connect(ctx, mg)
+				require.NoError(t, err)
+				if _, err := ext.Observe(ctx, mg); err != nil {
+					mg.SetConditions(xpv1.ReconcileError(err))
+				}
+				return reconcile.Result{Requeue: true}, kube.Status().Update(ctx, mg)
+			})
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: cr.GetName()}}
+			result, err := NewReconciler(kube, v1alpha1.SubaccountGroupVersionKind, inner).Reconcile(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+
+			got := &v1alpha1.Subaccount{}
+			require.NoError(t, kube.Get(context.Background(), req.NamespacedName, got))
+			synced := got.GetCondition(xpv1.TypeSynced)
+			assert.Equal(t, corev1.ConditionFalse, synced.Status)
+			assert.Equal(t, tc.wantReason, synced.Reason)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewReconciler wraps r, so that every reconcile of a managed resource of kind gvk opens a span,
+// which becomes the parent of the spans of the ExternalClient.
+func NewReconciler(gvk schema.GroupVersionKind, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+		ctx, span := Start(ctx, "Reconcile "+gvk.Kind,
+			AttributeGroupVersionKind.String(gvk.String()),
+			AttributeName.String(req.Name),
+			AttributeNamespace.String(req.Namespace),
+		)
+		defer func() { End(span, err) }()
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// NewConnector wraps c, so that Connect and the methods of the connected ExternalClient open a span.
+func NewConnector(gvk schema.GroupVersionKind, c managed.ExternalConnector) managed.ExternalConnector {
+	return &connector{connector: c, gvk: gvk}
+}
+
+type connector struct {
+	connector managed.ExternalConnector
+	gvk       schema.GroupVersionKind
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (ext managed.ExternalClient, err error) {
+	ctx, span := Start(ctx, "Connect "+c.gvk.Kind, attributes(c.gvk, mg)...)
+	defer func() { End(span, err) }()
+	ext, err = c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{external: ext, gvk: c.gvk}, nil
+}
+
+type external struct {
+	external managed.ExternalClient
+	gvk      schema.GroupVersionKind
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (o managed.ExternalObservation, err error) {
+	ctx, span := Start(ctx, "Observe "+e.gvk.Kind, attributes(e.gvk, mg)...)
+	defer func() {
+		span.SetAttributes(
+			attribute.Bool("crossplane.observation.resource_exists", o.ResourceExists),
+			attribute.Bool("crossplane.observation.resource_up_to_date", o.ResourceUpToDate),
+		)
+		End(span, err)
+	}()
+	return e.external.Observe(ctx, mg)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (c managed.ExternalCreation, err error) {
+	ctx, span := Start(ctx, "Create "+e.gvk.Kind, attributes(e.gvk, mg)...)
+	defer func() { End(span, err) }()
+	return e.external.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (u managed.ExternalUpdate, err error) {
+	ctx, span := Start(ctx, "Update "+e.gvk.Kind, attributes(e.gvk, mg)...)
+	defer func() { End(span, err) }()
+	return e.external.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (d managed.ExternalDelete, err error) {
+	ctx, span := Start(ctx, "Delete "+e.gvk.Kind, attributes(e.gvk, mg)...)
+	defer func() { End(span, err) }()
+	return e.external.Delete(ctx, mg)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.external.Disconnect(ctx)
+}
+
+func attributes(gvk schema.GroupVersionKind, mg resource.Managed) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttributeGroupVersionKind.String(gvk.String()),
+		AttributeName.String(mg.GetName()),
+		AttributeNamespace.String(mg.GetNamespace()),
+		AttributeExternalName.String(meta.GetExternalName(mg)),
+	}
+}
@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+func TestTracedReconcile(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	cr := &v1alpha1.Subaccount{}
+	cr.SetName("my-subaccount")
+	meta.SetExternalName(cr, "guid")
+
+	errBoom := errors.New("boom")
+	connector := NewConnector(v1alpha1.SubaccountGroupVersionKind, managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			},
+			UpdateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+				return managed.ExternalUpdate{}, errBoom
+			},
+		}, nil
+	}))
+
+	r := NewReconciler(v1alpha1.SubaccountGroupVersionKind, reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		ext, err := connector.Connect(ctx, cr)
+		require.NoError(t, err)
+		_, err = ext.Observe(ctx, cr)
+		require.NoError(t, err)
+		_, err = ext.Update(ctx, cr)
+		return reconcile.Result{}, err
+	}))
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: cr.GetName()}})
+	assert.ErrorIs(t, err, errBoom)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+	byName := map[string]sdktrace.ReadOnlySpan{}
+	for _, s := range spans {
+		byName[s.Name()] = s
+	}
+	root := byName["Reconcile Subaccount"]
+	require.NotNil(t, root)
+	assert.Equal(t, codes.Error, root.Status().Code)
+
+	for _, name := range []string{"Connect Subaccount", "Observe Subaccount", "Update Subaccount"} {
+		s := byName[name]
+		require.NotNil(t, s, name)
+		assert.Equal(t, root.SpanContext().SpanID(), s.Parent().SpanID(), name)
+		assert.Contains(t, s.Attributes(), AttributeExternalName.String("guid"), name)
+		assert.Contains(t, s.Attributes(), AttributeName.String("my-subaccount"), name)
+	}
+	assert.Equal(t, codes.Error, byName["Update Subaccount"].Status().Code)
+	assert.Equal(t, codes.Unset, byName["Observe Subaccount"].Status().Code)
+}
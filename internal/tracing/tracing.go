@@ -0,0 +1,91 @@
+// Package tracing contains the optional OpenTelemetry tracing of the provider.
+//
+// When enabled, every reconcile of a hand-written controller opens a span, which
+// is the parent of the spans of the ExternalClient methods. The HTTP requests
+// to the BTP APIs, which are sent by the instrumented clients of the btp
+// package, become child spans of those. Spans are exported with OTLP over HTTP.
+//
+// Without Setup, the global no-op TracerProvider of OpenTelemetry is used and
+// all spans are discarded.
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	serviceName = "crossplane-provider-btp"
+	scopeName   = "github.com/sap/crossplane-provider-btp/internal/tracing"
+
+	errCreateExporter = "cannot create OTLP trace exporter"
+)
+
+// Attributes of the spans of managed resources.
+const (
+	AttributeGroupVersionKind = attribute.Key("crossplane.resource.gvk")
+	AttributeName             = attribute.Key("crossplane.resource.name")
+	AttributeNamespace        = attribute.Key("crossplane.resource.namespace")
+	AttributeExternalName     = attribute.Key("crossplane.resource.external_name")
+)
+
+// Options configure the export of spans.
+type Options struct {
+	// Endpoint is the host and port of the OTLP HTTP receiver, e.g. otel-collector:4318.
+	Endpoint string
+	// Insecure disables TLS for the connection to the endpoint.
+	Insecure bool
+	// SampleRatio is the fraction of traces that are sampled, between 0 and 1.
+	SampleRatio float64
+	// Version is the version of the provider, which is recorded as service version.
+	Version string
+}
+
+// Setup registers a global TracerProvider that exports spans to the OTLP endpoint of o.
+// The returned function flushes and stops the export and should be called on shutdown.
+func Setup(ctx context.Context, o Options) (func(context.Context) error, error) {
+	exporterOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(o.Endpoint)}
+	if o.Insecure {
+		exporterOpts = append(exporterOpts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateExporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(o.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(o.Version),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return tp.Shutdown, nil
+}
+
+// Start opens a span as child of the span in ctx, using the global TracerProvider.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(scopeName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err, if any, on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}